@@ -25,11 +25,13 @@ import (
 
 	"time"
 
+	"github.com/nebulasio/go-nebulas/account/signer"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/cipher"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -50,6 +52,9 @@ var (
 	// ErrBlockAddressLocked from address locked.
 	ErrBlockAddressLocked = errors.New("block signer's address locked")
 
+	// ErrHashAddressLocked hash signer's address locked.
+	ErrHashAddressLocked = errors.New("hash signer's address locked")
+
 	// ErrTxSignFrom sign addr not from
 	ErrTxSignFrom = errors.New("transaction sign not use from addr")
 )
@@ -76,6 +81,9 @@ type Manager struct {
 
 	// account slice
 	accounts []*account
+
+	// external block signer
+	signer *signer.Client
 }
 
 // NewManager new a account manager
@@ -102,6 +110,17 @@ func NewManager(neblet Neblet) *Manager {
 				m.signatureAlg = keystore.Algorithm(EccSecp256K1Value)
 			}
 		}
+
+		if len(conf.Signer) > 0 {
+			client, err := signer.NewClient(conf.Signer)
+			if err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"signer": conf.Signer,
+					"err":    err,
+				}).Fatal("Failed to connect to the block signer.")
+			}
+			m.signer = client
+		}
 	}
 	m.refreshAccounts()
 	return m
@@ -278,6 +297,19 @@ func (m *Manager) SignTransaction(addr *core.Address, tx *core.Transaction) erro
 
 // SignBlock sign block with the specified algorithm
 func (m *Manager) SignBlock(addr *core.Address, block *core.Block) error {
+	if m.signer != nil {
+		if err := m.signer.SignBlock(addr, block); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"func":   "SignBlock",
+				"signer": m.signer.Endpoint(),
+				"err":    err,
+				"block":  block,
+			}).Error("Failed to sign block by the external signer.")
+			return err
+		}
+		return nil
+	}
+
 	key, err := m.ks.GetUnlocked(addr.String())
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -296,6 +328,53 @@ func (m *Manager) SignBlock(addr *core.Address, block *core.Block) error {
 	return block.Sign(signature)
 }
 
+// ExternalSigner returns whether blocks and finality votes are signed by an external signer.
+func (m *Manager) ExternalSigner() bool {
+	return m.signer != nil
+}
+
+// SignHash sign hash with the specified algorithm, the key must be unlocked in the node.
+func (m *Manager) SignHash(addr *core.Address, hash byteutils.Hash) (keystore.Algorithm, []byte, error) {
+	key, err := m.ks.GetUnlocked(addr.String())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"func": "SignHash",
+			"err":  ErrHashAddressLocked,
+			"hash": hash,
+		}).Error("hash signer's address locked")
+		return 0, nil, err
+	}
+
+	signature, err := crypto.NewSignature(m.signatureAlg)
+	if err != nil {
+		return 0, nil, err
+	}
+	signature.InitSign(key.(keystore.PrivateKey))
+	sign, err := signature.Sign(hash)
+	if err != nil {
+		return 0, nil, err
+	}
+	return m.signatureAlg, sign, nil
+}
+
+// SignVote sign the finality vote for the block, by the external signer if configured.
+func (m *Manager) SignVote(addr *core.Address, voteType int32, chainID uint32, height uint64, blockHash byteutils.Hash) (keystore.Algorithm, []byte, error) {
+	if m.signer != nil {
+		alg, sign, err := m.signer.SignVote(addr, voteType, chainID, height, blockHash)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"func":   "SignVote",
+				"signer": m.signer.Endpoint(),
+				"err":    err,
+				"height": height,
+			}).Error("Failed to sign vote by the external signer.")
+			return 0, nil, err
+		}
+		return alg, sign, nil
+	}
+	return m.SignHash(addr, signer.VoteHash(voteType, chainID, height, blockHash))
+}
+
 // SignTransactionWithPassphrase sign transaction with the from passphrase
 func (m *Manager) SignTransactionWithPassphrase(addr *core.Address, tx *core.Transaction, passphrase []byte) error {
 	// check sign addr is tx's from addr
@@ -0,0 +1,173 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/account/signer/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// UnixPrefix is the prefix of unix socket endpoints.
+const UnixPrefix = "unix://"
+
+// SignTimeout is the timeout of a sign request.
+const SignTimeout = 3 * time.Second
+
+// Errors
+var (
+	ErrInvalidSignature = errors.New("external signer returned an invalid signature")
+	ErrDoubleSign       = errors.New("refused to sign, a different block was signed in the slot or a later slot")
+	ErrFutureBlock      = errors.New("refused to sign, the block is more than a slot ahead of the clock")
+	ErrInvalidEndpoint  = errors.New("the signer is only served on unix sockets, unix:///path/to/socket")
+	ErrDoubleVote       = errors.New("refused to sign, a different vote was signed at the height or a later height")
+)
+
+// VoteHash returns the hash signed by a finality vote for the block.
+func VoteHash(voteType int32, chainID uint32, height uint64, blockHash byteutils.Hash) byteutils.Hash {
+	return hash.Sha3256(
+		byteutils.FromInt32(voteType),
+		byteutils.FromUint32(chainID),
+		byteutils.FromUint64(height),
+		blockHash,
+	)
+}
+
+// Client signs blocks and finality votes by an external signer.
+type Client struct {
+	endpoint string
+	conn     *grpc.ClientConn
+	signer   signerpb.SignerClient
+}
+
+// NewClient create a Client connecting to the signer at endpoint, unix:///path/to/socket.
+// The socket is only accessible by the user running the signer, so the connection needs no
+// other authentication.
+func NewClient(endpoint string) (*Client, error) {
+	if !strings.HasPrefix(endpoint, UnixPrefix) {
+		return nil, ErrInvalidEndpoint
+	}
+	conn, err := grpc.Dial(strings.TrimPrefix(endpoint, UnixPrefix), grpc.WithInsecure(),
+		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", addr, timeout)
+		}))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		endpoint: endpoint,
+		conn:     conn,
+		signer:   signerpb.NewSignerClient(conn),
+	}, nil
+}
+
+// Endpoint returns the endpoint of the signer.
+func (c *Client) Endpoint() string {
+	return c.endpoint
+}
+
+// SignBlock asks the signer to sign the block, the signature is checked before set to the block.
+func (c *Client) SignBlock(addr *core.Address, block *core.Block) error {
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return err
+	}
+	header, err := proto.Marshal(pbBlock.(*corepb.Block).Header)
+	if err != nil {
+		return err
+	}
+	req := &signerpb.SignBlockRequest{
+		Address: addr.String(),
+		Header:  header,
+		Height:  block.Height(),
+	}
+	for _, tx := range block.Transactions() {
+		req.TxHashes = append(req.TxHashes, tx.Hash())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), SignTimeout)
+	defer cancel()
+	resp, err := c.signer.SignBlock(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	block.SetSignature(keystore.Algorithm(resp.Alg), resp.Signature)
+	signer, err := core.RecoverMiner(block)
+	if err != nil || !signer.Equals(addr) {
+		block.SetSignature(0, nil)
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// SignVote asks the signer to sign the finality vote for the block, the signature is checked
+// before returned.
+func (c *Client) SignVote(addr *core.Address, voteType int32, chainID uint32, height uint64, blockHash byteutils.Hash) (keystore.Algorithm, []byte, error) {
+	req := &signerpb.SignVoteRequest{
+		Address:   addr.String(),
+		Type:      voteType,
+		ChainId:   chainID,
+		Height:    height,
+		BlockHash: blockHash,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), SignTimeout)
+	defer cancel()
+	resp, err := c.signer.SignVote(ctx, req)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	alg := keystore.Algorithm(resp.Alg)
+	signature, err := crypto.NewSignature(alg)
+	if err != nil {
+		return 0, nil, err
+	}
+	pub, err := signature.RecoverPublic(VoteHash(voteType, chainID, height, blockHash), resp.Signature)
+	if err != nil {
+		return 0, nil, ErrInvalidSignature
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return 0, nil, err
+	}
+	signer, err := core.NewAddressFromPublicKey(pubdata)
+	if err != nil || !signer.Equals(addr) {
+		return 0, nil, ErrInvalidSignature
+	}
+	return alg, resp.Signature, nil
+}
+
+// Close closes the connection to the signer.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
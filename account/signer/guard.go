@@ -0,0 +1,221 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// SignedBlock is the last block signed by a signer.
+type SignedBlock struct {
+	ChainID   uint32 `json:"chain_id"`
+	Height    uint64 `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+	Hash      string `json:"hash"`
+}
+
+// SignedVote is the last finality vote of a type signed by a signer.
+type SignedVote struct {
+	ChainID uint32 `json:"chain_id"`
+	Type    int32  `json:"type"`
+	Height  uint64 `json:"height"`
+	Hash    string `json:"hash"`
+}
+
+// guardState is the state file of the guard.
+type guardState struct {
+	Blocks []*SignedBlock `json:"blocks"`
+	Votes  []*SignedVote  `json:"votes"`
+}
+
+// Guard refuses to sign two different blocks in a slot or to sign a block in an earlier slot,
+// and refuses blocks more than a slot ahead of the clock, so a far future block can't stop
+// the signer from signing. Votes are guarded alike, two different votes of a type at a height
+// or a vote below the last voted height of the type are refused. The last signed blocks and
+// votes of each chain are persisted before the signature is returned, so restarts can't make
+// the signer double sign.
+type Guard struct {
+	mu    sync.Mutex
+	path  string
+	slot  int64
+	last  map[uint32]*SignedBlock
+	votes map[string]*SignedVote
+
+	now func() int64
+}
+
+func voteKey(chainID uint32, voteType int32) string {
+	return fmt.Sprintf("%d_%d", chainID, voteType)
+}
+
+// NewGuard create a Guard persisting the last signed blocks and votes in the file at path,
+// slot is the block interval in seconds.
+func NewGuard(path string, slot int64) (*Guard, error) {
+	g := &Guard{
+		path:  path,
+		slot:  slot,
+		last:  make(map[uint32]*SignedBlock),
+		votes: make(map[string]*SignedVote),
+		now:   func() int64 { return time.Now().Unix() },
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		state := new(guardState)
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, err
+		}
+		for _, block := range state.Blocks {
+			g.last[block.ChainID] = block
+		}
+		for _, vote := range state.Votes {
+			g.votes[voteKey(vote.ChainID, vote.Type)] = vote
+		}
+	}
+	return g, nil
+}
+
+// Last returns the last signed block of the chain, nil if nothing signed.
+func (g *Guard) Last(chainID uint32) *SignedBlock {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.last[chainID]
+}
+
+// LastVote returns the last signed vote of the type in the chain, nil if nothing signed.
+func (g *Guard) LastVote(chainID uint32, voteType int32) *SignedVote {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.votes[voteKey(chainID, voteType)]
+}
+
+// Approve checks the block and records it as the last signed block of its chain.
+// Signing the same block again is approved.
+func (g *Guard) Approve(block *SignedBlock) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if block.Timestamp > g.now()+g.slot {
+		return ErrFutureBlock
+	}
+	last, ok := g.last[block.ChainID]
+	if ok {
+		if block.Timestamp < last.Timestamp {
+			return ErrDoubleSign
+		}
+		if block.Timestamp == last.Timestamp {
+			if block.Hash != last.Hash {
+				return ErrDoubleSign
+			}
+			return nil
+		}
+	}
+
+	g.last[block.ChainID] = block
+	if err := g.persist(); err != nil {
+		if ok {
+			g.last[block.ChainID] = last
+		} else {
+			delete(g.last, block.ChainID)
+		}
+		return err
+	}
+	return nil
+}
+
+// ApproveVote checks the vote and records it as the last signed vote of its type.
+// Signing the same vote again is approved.
+func (g *Guard) ApproveVote(vote *SignedVote) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key := voteKey(vote.ChainID, vote.Type)
+	last, ok := g.votes[key]
+	if ok {
+		if vote.Height < last.Height {
+			return ErrDoubleVote
+		}
+		if vote.Height == last.Height {
+			if vote.Hash != last.Hash {
+				return ErrDoubleVote
+			}
+			return nil
+		}
+	}
+
+	g.votes[key] = vote
+	if err := g.persist(); err != nil {
+		if ok {
+			g.votes[key] = last
+		} else {
+			delete(g.votes, key)
+		}
+		return err
+	}
+	return nil
+}
+
+func (g *Guard) persist() error {
+	state := new(guardState)
+	for _, block := range g.last {
+		state.Blocks = append(state.Blocks, block)
+	}
+	sort.Slice(state.Blocks, func(i, j int) bool {
+		return state.Blocks[i].ChainID < state.Blocks[j].ChainID
+	})
+	for _, vote := range g.votes {
+		state.Votes = append(state.Votes, vote)
+	}
+	sort.Slice(state.Votes, func(i, j int) bool {
+		if state.Votes[i].ChainID != state.Votes[j].ChainID {
+			return state.Votes[i].ChainID < state.Votes[j].ChainID
+		}
+		return state.Votes[i].Type < state.Votes[j].Type
+	})
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	// the state is synced before the rename, a crash must not leave an empty state file behind.
+	tmp := g.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, g.path)
+}
@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: signer.proto
+
+/*
+Package signerpb is a generated protocol buffer package.
+
+It is generated from these files:
+	signer.proto
+
+It has these top-level messages:
+	SignBlockRequest
+	SignBlockResponse
+	SignVoteRequest
+	SignVoteResponse
+*/
+package signerpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type SignBlockRequest struct {
+	// the miner address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the block is sent as the marshaled corepb.BlockHeader and the hashes of its txs,
+	// the signer computes the block hash from them instead of trusting a hash.
+	Header   []byte   `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`
+	TxHashes [][]byte `protobuf:"bytes,7,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+	// the height of the block, only logged by the signer.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SignBlockRequest) Reset()                    { *m = SignBlockRequest{} }
+func (m *SignBlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignBlockRequest) ProtoMessage()               {}
+func (*SignBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{0} }
+
+func (m *SignBlockRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignBlockRequest) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignBlockRequest) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *SignBlockRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type SignBlockResponse struct {
+	Alg       uint32 `protobuf:"varint,1,opt,name=alg,proto3" json:"alg,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignBlockResponse) Reset()                    { *m = SignBlockResponse{} }
+func (m *SignBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignBlockResponse) ProtoMessage()               {}
+func (*SignBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{1} }
+
+func (m *SignBlockResponse) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SignBlockResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SignVoteRequest struct {
+	// the voter address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the finality vote, type is the finalitypb.VoteType.
+	Type      int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	ChainId   uint32 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height    uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash []byte `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (m *SignVoteRequest) Reset()                    { *m = SignVoteRequest{} }
+func (m *SignVoteRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignVoteRequest) ProtoMessage()               {}
+func (*SignVoteRequest) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{2} }
+
+func (m *SignVoteRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignVoteRequest) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *SignVoteRequest) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SignVoteRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignVoteRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type SignVoteResponse struct {
+	Alg       uint32 `protobuf:"varint,1,opt,name=alg,proto3" json:"alg,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignVoteResponse) Reset()                    { *m = SignVoteResponse{} }
+func (m *SignVoteResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignVoteResponse) ProtoMessage()               {}
+func (*SignVoteResponse) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{3} }
+
+func (m *SignVoteResponse) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SignVoteResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignBlockRequest)(nil), "signerpb.SignBlockRequest")
+	proto.RegisterType((*SignBlockResponse)(nil), "signerpb.SignBlockResponse")
+	proto.RegisterType((*SignVoteRequest)(nil), "signerpb.SignVoteRequest")
+	proto.RegisterType((*SignVoteResponse)(nil), "signerpb.SignVoteResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Signer service
+
+type SignerClient interface {
+	SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error)
+	SignVote(ctx context.Context, in *SignVoteRequest, opts ...grpc.CallOption) (*SignVoteResponse, error)
+}
+
+type signerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSignerClient(cc *grpc.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error) {
+	out := new(SignBlockResponse)
+	err := grpc.Invoke(ctx, "/signerpb.Signer/SignBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignVote(ctx context.Context, in *SignVoteRequest, opts ...grpc.CallOption) (*SignVoteResponse, error) {
+	out := new(SignVoteResponse)
+	err := grpc.Invoke(ctx, "/signerpb.Signer/SignVote", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Signer service
+
+type SignerServer interface {
+	SignBlock(context.Context, *SignBlockRequest) (*SignBlockResponse, error)
+	SignVote(context.Context, *SignVoteRequest) (*SignVoteResponse, error)
+}
+
+func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_SignBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/SignBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignBlock(ctx, req.(*SignBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/SignVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignVote(ctx, req.(*SignVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "signerpb.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignBlock",
+			Handler:    _Signer_SignBlock_Handler,
+		},
+		{
+			MethodName: "SignVote",
+			Handler:    _Signer_SignVote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
+
+func init() { proto.RegisterFile("signer.proto", fileDescriptorSigner) }
+
+var fileDescriptorSigner = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4e, 0xfa, 0x40,
+	0x10, 0xc6, 0xb3, 0x74, 0x29, 0xdb, 0x09, 0xff, 0xfc, 0xd7, 0x39, 0x98, 0xa5, 0x68, 0xd2, 0xf4,
+	0xd4, 0x13, 0x07, 0x7d, 0x02, 0xd1, 0x83, 0x72, 0x5c, 0x12, 0xaf, 0x64, 0xa1, 0x9b, 0xb6, 0x91,
+	0xb4, 0xb5, 0xbb, 0x24, 0xf8, 0x0c, 0x9e, 0x78, 0x63, 0xd3, 0x2d, 0x08, 0x1a, 0x8c, 0x89, 0xb7,
+	0xf9, 0x66, 0xda, 0xe9, 0xef, 0xfb, 0xa6, 0x30, 0x34, 0x45, 0x56, 0xea, 0x66, 0x52, 0x37, 0x95,
+	0xad, 0x90, 0x75, 0xaa, 0x5e, 0xc6, 0xef, 0x04, 0xf8, 0xbc, 0xc8, 0xca, 0xe9, 0xba, 0x5a, 0xbd,
+	0x48, 0xfd, 0xba, 0xd1, 0xc6, 0xa2, 0x80, 0x81, 0x4a, 0xd3, 0x46, 0x1b, 0x23, 0x48, 0x44, 0x92,
+	0x40, 0x1e, 0x24, 0x5e, 0x82, 0x9f, 0x6b, 0x95, 0xea, 0x46, 0xf8, 0x11, 0x49, 0x86, 0x72, 0xaf,
+	0x70, 0x0c, 0x81, 0xdd, 0x2e, 0x72, 0x65, 0x72, 0x6d, 0xc4, 0x20, 0xf2, 0x92, 0xa1, 0x64, 0x76,
+	0xfb, 0xe8, 0x74, 0xf7, 0x52, 0x91, 0xe5, 0x56, 0xd0, 0x88, 0x24, 0x54, 0xee, 0xd5, 0x8c, 0xb2,
+	0x1e, 0xf7, 0x66, 0x94, 0x79, 0x9c, 0xce, 0x28, 0xeb, 0x73, 0x3f, 0xbe, 0x87, 0x8b, 0x13, 0x18,
+	0x53, 0x57, 0xa5, 0xd1, 0xc8, 0xc1, 0x53, 0xeb, 0xcc, 0x91, 0xfc, 0x93, 0x6d, 0x89, 0x57, 0x10,
+	0xb4, 0x06, 0x94, 0xdd, 0x34, 0x5a, 0xf4, 0x1c, 0xc8, 0xb1, 0x11, 0xef, 0x08, 0xfc, 0x6f, 0xb7,
+	0x3c, 0x57, 0x56, 0xff, 0xee, 0x08, 0x81, 0xda, 0xb7, 0xba, 0x5b, 0xd3, 0x97, 0xae, 0xc6, 0x11,
+	0xb0, 0x55, 0xae, 0x8a, 0x72, 0x51, 0xa4, 0xc2, 0x73, 0x9f, 0x1d, 0x38, 0xfd, 0x94, 0xfe, 0xe4,
+	0x05, 0xaf, 0x01, 0x96, 0x2d, 0xb5, 0xcb, 0x40, 0xf4, 0x3b, 0x26, 0xd7, 0x69, 0x43, 0x88, 0xa7,
+	0xc0, 0x8f, 0x48, 0x7f, 0xf3, 0x75, 0xb3, 0x23, 0xe0, 0xcf, 0xdd, 0xdd, 0xf0, 0x01, 0x82, 0xcf,
+	0x9c, 0x30, 0x9c, 0x1c, 0xae, 0x39, 0xf9, 0x7e, 0xc9, 0x70, 0x7c, 0x76, 0xb6, 0x07, 0xb8, 0x03,
+	0x76, 0x80, 0xc2, 0xd1, 0xd7, 0x07, 0x4f, 0xb2, 0x0b, 0xc3, 0x73, 0xa3, 0x6e, 0xc5, 0xd2, 0x77,
+	0xff, 0xd3, 0xed, 0xc7, 0x00, 0xa9, 0x54, 0xf6, 0x35, 0x5f, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"errors"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/account/signer/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// Errors
+var (
+	ErrUnknownAddress = errors.New("the signer doesn't hold the key of the address")
+)
+
+// Server signs blocks and finality votes with a validator key, guarded against double signing.
+type Server struct {
+	key   keystore.PrivateKey
+	addr  *core.Address
+	guard *Guard
+
+	rpc *grpc.Server
+}
+
+// NewServer create a Server signing with the key.
+func NewServer(key keystore.PrivateKey, guard *Guard) (*Server, error) {
+	pub, err := key.PublicKey().Encoded()
+	if err != nil {
+		return nil, err
+	}
+	addr, err := core.NewAddressFromPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		key:   key,
+		addr:  addr,
+		guard: guard,
+		rpc:   grpc.NewServer(),
+	}
+	signerpb.RegisterSignerServer(s.rpc, s)
+	return s, nil
+}
+
+// Address returns the address of the key.
+func (s *Server) Address() *core.Address {
+	return s.addr
+}
+
+// SignBlock is the rpc handler signing a block, the signed hash is computed from the header
+// so the key can't be used to sign anything but a block, e.g. a transaction.
+func (s *Server) SignBlock(ctx context.Context, req *signerpb.SignBlockRequest) (*signerpb.SignBlockResponse, error) {
+	if req.Address != s.addr.String() {
+		return nil, ErrUnknownAddress
+	}
+	header := new(corepb.BlockHeader)
+	if err := proto.Unmarshal(req.Header, header); err != nil {
+		return nil, err
+	}
+	txHashes := make([]byteutils.Hash, len(req.TxHashes))
+	for i, hash := range req.TxHashes {
+		txHashes[i] = hash
+	}
+	hash, err := core.HashBlockHeader(header, txHashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(header.Hash) > 0 && !byteutils.Equal(header.Hash, hash) {
+		return nil, core.ErrInvalidBlockHash
+	}
+
+	block := &SignedBlock{
+		ChainID:   header.ChainId,
+		Height:    req.Height,
+		Timestamp: header.Timestamp,
+		Hash:      string(hash.Hex()),
+	}
+	if err := s.guard.Approve(block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"height":    block.Height,
+			"timestamp": block.Timestamp,
+			"last":      s.guard.Last(block.ChainID),
+			"err":       err,
+		}).Warn("Refused to sign block.")
+		return nil, err
+	}
+
+	sign, err := s.sign(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"height":    block.Height,
+		"timestamp": block.Timestamp,
+		"hash":      block.Hash,
+	}).Info("Signed block.")
+	return &signerpb.SignBlockResponse{
+		Alg:       uint32(keystore.SECP256K1),
+		Signature: sign,
+	}, nil
+}
+
+// SignVote is the rpc handler signing a finality vote, the signed hash is computed from the
+// vote fields so the key can't be used to sign anything but a vote.
+func (s *Server) SignVote(ctx context.Context, req *signerpb.SignVoteRequest) (*signerpb.SignVoteResponse, error) {
+	if req.Address != s.addr.String() {
+		return nil, ErrUnknownAddress
+	}
+	if len(req.BlockHash) != core.BlockHashLength {
+		return nil, core.ErrInvalidBlockHash
+	}
+
+	vote := &SignedVote{
+		ChainID: req.ChainId,
+		Type:    req.Type,
+		Height:  req.Height,
+		Hash:    string(byteutils.Hash(req.BlockHash).Hex()),
+	}
+	if err := s.guard.ApproveVote(vote); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"type":   vote.Type,
+			"height": vote.Height,
+			"last":   s.guard.LastVote(vote.ChainID, vote.Type),
+			"err":    err,
+		}).Warn("Refused to sign vote.")
+		return nil, err
+	}
+
+	sign, err := s.sign(VoteHash(req.Type, req.ChainId, req.Height, req.BlockHash))
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"type":   vote.Type,
+		"height": vote.Height,
+		"hash":   vote.Hash,
+	}).Info("Signed vote.")
+	return &signerpb.SignVoteResponse{
+		Alg:       uint32(keystore.SECP256K1),
+		Signature: sign,
+	}, nil
+}
+
+func (s *Server) sign(hash byteutils.Hash) ([]byte, error) {
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		return nil, err
+	}
+	if err := signature.InitSign(s.key); err != nil {
+		return nil, err
+	}
+	return signature.Sign(hash)
+}
+
+// Serve serves sign requests at endpoint, unix:///path/to/socket. Sign requests are not
+// authenticated, so they are only served on a unix socket accessible by the same user.
+func (s *Server) Serve(endpoint string) error {
+	if !strings.HasPrefix(endpoint, UnixPrefix) {
+		return ErrInvalidEndpoint
+	}
+	addr := strings.TrimPrefix(endpoint, UnixPrefix)
+	if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	listener, err := net.Listen("unix", addr)
+	if err != nil {
+		return err
+	}
+	// only the node running as the same user can ask for signatures
+	if err := os.Chmod(addr, 0600); err != nil {
+		listener.Close()
+		return err
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"endpoint": endpoint,
+		"address":  s.addr,
+	}).Info("Started block signer.")
+	return s.rpc.Serve(listener)
+}
+
+// Stop stops serving.
+func (s *Server) Stop() {
+	s.rpc.Stop()
+}
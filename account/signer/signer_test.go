@@ -0,0 +1,203 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/account/signer/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+type mockNeb struct {
+	genesis *corepb.Genesis
+	config  *nebletpb.Config
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *mockNeb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *mockNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *mockNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func TestGuard(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "state.json")
+
+	now := func() int64 { return 50 }
+	guard, err := NewGuard(path, 5)
+	assert.Nil(t, err)
+	guard.now = now
+	assert.Nil(t, guard.Last(100))
+
+	block := &SignedBlock{ChainID: 100, Hash: "01", Height: 10, Timestamp: 50}
+	assert.Nil(t, guard.Approve(block))
+	// sign the same block again
+	assert.Nil(t, guard.Approve(block))
+	// another block in the slot
+	assert.Equal(t, ErrDoubleSign, guard.Approve(&SignedBlock{ChainID: 100, Hash: "02", Height: 10, Timestamp: 50}))
+	// a block in an earlier slot
+	assert.Equal(t, ErrDoubleSign, guard.Approve(&SignedBlock{ChainID: 100, Hash: "03", Height: 9, Timestamp: 45}))
+	// a block far ahead of the clock can't lock the signer
+	assert.Equal(t, ErrFutureBlock, guard.Approve(&SignedBlock{ChainID: 100, Hash: "04", Height: 11, Timestamp: 1 << 40}))
+	// another chain is guarded separately
+	assert.Nil(t, guard.Approve(&SignedBlock{ChainID: 1, Hash: "05", Height: 3, Timestamp: 40}))
+
+	// the last signed blocks survive restarts
+	guard, err = NewGuard(path, 5)
+	assert.Nil(t, err)
+	guard.now = now
+	assert.Equal(t, block, guard.Last(100))
+	assert.Equal(t, &SignedBlock{ChainID: 1, Hash: "05", Height: 3, Timestamp: 40}, guard.Last(1))
+	assert.Equal(t, ErrDoubleSign, guard.Approve(&SignedBlock{ChainID: 100, Hash: "02", Height: 10, Timestamp: 50}))
+	assert.Nil(t, guard.Approve(&SignedBlock{ChainID: 100, Hash: "06", Height: 11, Timestamp: 55}))
+
+	// votes are guarded per chain and type
+	vote := &SignedVote{ChainID: 100, Type: 1, Height: 10, Hash: "01"}
+	assert.Nil(t, guard.ApproveVote(vote))
+	assert.Nil(t, guard.ApproveVote(vote))
+	assert.Equal(t, ErrDoubleVote, guard.ApproveVote(&SignedVote{ChainID: 100, Type: 1, Height: 10, Hash: "02"}))
+	assert.Equal(t, ErrDoubleVote, guard.ApproveVote(&SignedVote{ChainID: 100, Type: 1, Height: 9, Hash: "03"}))
+	assert.Nil(t, guard.ApproveVote(&SignedVote{ChainID: 100, Type: 2, Height: 10, Hash: "02"}))
+	guard, err = NewGuard(path, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, vote, guard.LastVote(100, 1))
+	assert.Equal(t, ErrDoubleVote, guard.ApproveVote(&SignedVote{ChainID: 100, Type: 1, Height: 10, Hash: "02"}))
+	assert.Equal(t, &SignedBlock{ChainID: 100, Hash: "06", Height: 11, Timestamp: 55}, guard.Last(100))
+
+	// a corrupt state file is refused instead of signing from an empty state
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"blocks":`), 0600))
+	_, err = NewGuard(path, 5)
+	assert.NotNil(t, err)
+}
+
+func TestSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	key := secp256k1.GeneratePrivateKey()
+	guard, err := NewGuard(filepath.Join(dir, "state.json"), core.BlockInterval)
+	assert.Nil(t, err)
+	server, err := NewServer(key, guard)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidEndpoint, server.Serve("127.0.0.1:8700"))
+	endpoint := UnixPrefix + filepath.Join(dir, "signer.sock")
+	go server.Serve(endpoint)
+	defer server.Stop()
+	time.Sleep(100 * time.Millisecond)
+
+	stor, _ := storage.NewMemoryStorage()
+	neb := &mockNeb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 100},
+			Consensus: &corepb.GenesisConsensus{
+				Dpos: &corepb.GenesisConsensusDpos{
+					Dynasty:     []string{server.Address().String()},
+					DynastySize: 1,
+				},
+			},
+		},
+		config:  &nebletpb.Config{Chain: &nebletpb.ChainConfig{ChainId: 100}},
+		storage: stor,
+		emitter: core.NewEventEmitter(1024),
+	}
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	_, err = NewClient("127.0.0.1:8700")
+	assert.Equal(t, ErrInvalidEndpoint, err)
+	client, err := NewClient(endpoint)
+	assert.Nil(t, err)
+	defer client.Close()
+
+	block, err := chain.NewBlock(server.Address())
+	assert.Nil(t, err)
+	block.SetTimestamp(core.BlockInterval)
+	block.SetMiner(server.Address())
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, client.SignBlock(server.Address(), block))
+	miner, err := core.RecoverMiner(block)
+	assert.Nil(t, err)
+	assert.Equal(t, server.Address(), miner)
+
+	// the signer doesn't hold the key
+	coinbase, err := core.AddressParse("2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8")
+	assert.Nil(t, err)
+	other, err := chain.NewBlock(coinbase)
+	assert.Nil(t, err)
+	other.SetTimestamp(core.BlockInterval)
+	other.SetMiner(server.Address())
+	assert.Nil(t, other.Seal())
+	assert.NotNil(t, client.SignBlock(coinbase, other))
+
+	// another block in the signed slot
+	err = client.SignBlock(server.Address(), other)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), ErrDoubleSign.Error())
+	assert.Nil(t, other.Signature())
+
+	// finality votes are guarded alike
+	alg, sign, err := client.SignVote(server.Address(), 1, 100, block.Height(), block.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, keystore.SECP256K1, alg)
+	assert.NotNil(t, sign)
+	_, _, err = client.SignVote(server.Address(), 1, 100, block.Height(), other.Hash())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), ErrDoubleVote.Error())
+
+	// the signer signs the hash computed from the header, never a hash sent by the caller
+	header, err := proto.Marshal(&corepb.BlockHeader{Hash: []byte("a transaction hash"), ChainId: 100, Timestamp: 2 * core.BlockInterval})
+	assert.Nil(t, err)
+	_, err = server.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: server.Address().String(), Header: header})
+	assert.Equal(t, core.ErrInvalidBlockHeader, err)
+	pbBlock, err := block.ToProto()
+	assert.Nil(t, err)
+	pbHeader := pbBlock.(*corepb.Block).Header
+	pbHeader.Hash = []byte("a transaction hash")
+	header, err = proto.Marshal(pbHeader)
+	assert.Nil(t, err)
+	_, err = server.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: server.Address().String(), Header: header})
+	assert.Equal(t, core.ErrInvalidBlockHash, err)
+}
@@ -55,6 +55,18 @@ The genesis command for genesis dump or other commands.`,
 
 Dump the genesis config info.`,
 			},
+			{
+				Name:   "new",
+				Usage:  "generate the genesis and validator configs of a new chain",
+				Action: genesisNew,
+				Flags:  genesisNewFlags,
+				Description: `
+    neb genesis new --chainid 1001 --validators 3 --distribution tokens.csv --output testnet
+
+Generate a genesis with an initial dynasty of freshly created validator keystores and the token
+distribution of a csv file, validate it and write a node config for each validator of a local testnet.
+Use --interactive to input the params by prompts.`,
+			},
 		},
 	}
 
@@ -75,6 +87,9 @@ func initGenesis(ctx *cli.Context) error {
 	if err != nil {
 		FatalF("load genesis conf faild: %v", err)
 	}
+	if err := core.CheckGenesisConf(genesis); err != nil {
+		FatalF("invalid genesis conf: %v", err)
+	}
 
 	neb, err := makeNeb(ctx)
 	if err != nil {
@@ -0,0 +1,91 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/neblet"
+	"github.com/nebulasio/go-nebulas/rpc"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/urfave/cli"
+	"golang.org/x/net/context"
+)
+
+var (
+	dynastyCommand = cli.Command{
+		Name:     "dynasty",
+		Usage:    "Query the dynasties of a running node",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Query the dynasties from the rpc service of a running node.`,
+		Subcommands: []cli.Command{
+			{
+				Name:   "schedule",
+				Usage:  "Show the proposer schedule of the current and next dynasty",
+				Action: MergeFlags(proposerSchedule),
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "height",
+						Usage: "the dynasty of the block at height, the tail if not set",
+					},
+				},
+				Description: `
+    neb dynasty schedule
+
+Show the proposer of each slot in the current and next dynasty,
+and the blocks minted and missed by the validators in the current dynasty.`,
+			},
+		},
+	}
+)
+
+func proposerSchedule(ctx *cli.Context) error {
+	conf := neblet.LoadConfig(config)
+	rpcConfig(ctx, conf.Rpc)
+	if len(conf.Rpc.RpcListen) == 0 {
+		FatalF("query proposer schedule faild: %v", errors.New("no rpc listen address"))
+	}
+
+	conn, err := rpc.Dial(conf.Rpc.RpcListen[0])
+	if err != nil {
+		FatalF("query proposer schedule faild: %v", err)
+	}
+	defer conn.Close()
+
+	client := rpcpb.NewApiServiceClient(conn)
+	resp, err := client.GetProposerSchedule(context.Background(), &rpcpb.ByBlockHeightRequest{Height: ctx.Uint64("height")})
+	if err != nil {
+		FatalF("query proposer schedule faild: %v", err)
+	}
+
+	respJSON, err := json.Marshal(resp)
+	if err != nil {
+		FatalF("query proposer schedule faild: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, respJSON, "", "    "); err != nil {
+		FatalF("query proposer schedule faild: %v", err)
+	}
+	fmt.Println(buf.String())
+	return nil
+}
@@ -0,0 +1,314 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/cmd/console"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/urfave/cli"
+)
+
+// ports of the first node, each following node adds genesisPortStep to them.
+const (
+	genesisNetworkPort = 8680
+	genesisRPCPort     = 8684
+	genesisHTTPPort    = 8685
+	genesisPortStep    = 10
+)
+
+var genesisNewFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "interactive, i",
+		Usage: "prompt for the genesis params instead of reading the flags",
+	},
+	cli.UintFlag{
+		Name:  "chainid",
+		Usage: "chain id of the new chain",
+		Value: 100,
+	},
+	cli.UintFlag{
+		Name:  "validators",
+		Usage: "count of validators in the initial dynasty, a keystore is created for each",
+		Value: uint(core.DynastySize),
+	},
+	cli.UintFlag{
+		Name:  "dynasty_size",
+		Usage: "validators in a dynasty, the count of validators if not set",
+	},
+	cli.Int64Flag{
+		Name:  "block_interval",
+		Usage: "seconds between two blocks",
+		Value: core.BlockInterval,
+	},
+	cli.Int64Flag{
+		Name:  "dynasty_interval",
+		Usage: "seconds of a dynasty",
+		Value: core.DynastyInterval,
+	},
+	cli.StringFlag{
+		Name:  "distribution",
+		Usage: "csv `FILE` of the token distribution, a line of address,value for each account",
+	},
+	cli.StringFlag{
+		Name:  "output, o",
+		Usage: "`DIR` to write the genesis, keystores and node configs",
+		Value: "testnet",
+	},
+	cli.StringFlag{
+		Name:  "passphrase",
+		Usage: "passphrase of the validator keystores, prompt input if not set",
+	},
+}
+
+// genesisKeydir provides the keydir of a validator to the account manager.
+type genesisKeydir struct {
+	config *nebletpb.Config
+}
+
+func (k *genesisKeydir) Config() *nebletpb.Config {
+	return k.config
+}
+
+// genesisNew generates the genesis of a new chain with fresh validator keystores
+// and writes a node config for each validator of a local testnet.
+func genesisNew(ctx *cli.Context) error {
+	chainID := uint32(ctx.Uint("chainid"))
+	validators := ctx.Uint("validators")
+	dynastySize := uint32(ctx.Uint("dynasty_size"))
+	blockInterval := ctx.Int64("block_interval")
+	dynastyInterval := ctx.Int64("dynasty_interval")
+	distribution := ctx.String("distribution")
+	output := ctx.String("output")
+	passphrase := ctx.String("passphrase")
+
+	if ctx.Bool("interactive") {
+		chainID = uint32(promptUint("Chain id", uint64(chainID)))
+		validators = uint(promptUint("Validators in the initial dynasty", uint64(validators)))
+		dynastySize = uint32(promptUint("Dynasty size, 0 for the count of validators", uint64(dynastySize)))
+		blockInterval = int64(promptUint("Block interval in seconds", uint64(blockInterval)))
+		dynastyInterval = int64(promptUint("Dynasty interval in seconds", uint64(dynastyInterval)))
+		distribution = promptString("Token distribution csv file, empty for none", distribution)
+		output = promptString("Output directory", output)
+	}
+	if validators == 0 {
+		FatalF("at least one validator is required")
+	}
+	if dynastySize == 0 {
+		dynastySize = uint32(validators)
+	}
+	if _, err := os.Stat(filepath.Join(output, "genesis.conf")); err == nil {
+		FatalF("genesis already exists in %s", output)
+	}
+	genesis := &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: chainID},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{
+				BlockInterval:   blockInterval,
+				DynastyInterval: dynastyInterval,
+				DynastySize:     dynastySize,
+			},
+		},
+	}
+	if len(distribution) > 0 {
+		tokens, err := loadTokenDistribution(distribution)
+		if err != nil {
+			FatalF("load token distribution failed: %v", err)
+		}
+		genesis.TokenDistribution = tokens
+	}
+
+	// the genesis is checked with placeholder validators before any key is created,
+	// the fresh validator addresses are always valid and distinct.
+	check := proto.Clone(genesis).(*corepb.Genesis)
+	for i := uint(0); i < validators; i++ {
+		pubdata, err := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+		if err != nil {
+			FatalF("generate validator key failed: %v", err)
+		}
+		addr, err := core.NewAddressFromPublicKey(pubdata)
+		if err != nil {
+			FatalF("generate validator key failed: %v", err)
+		}
+		check.Consensus.Dpos.Dynasty = append(check.Consensus.Dpos.Dynasty, addr.String())
+	}
+	if err := core.CheckGenesisConf(check); err != nil {
+		FatalF("invalid genesis conf: %v", err)
+	}
+	if len(passphrase) == 0 {
+		passphrase = getPassPhrase("The validator keystores are locked with a passphrase. Please give a passphrase. Do not forget this passphrase.", true)
+	}
+
+	nodes := make([]*nebletpb.Config, validators)
+	seeds := []string{}
+	for i := range nodes {
+		dir := filepath.Join(output, fmt.Sprintf("node%d", i))
+		keydir := filepath.Join(dir, "keydir")
+		manager := account.NewManager(&genesisKeydir{&nebletpb.Config{Chain: &nebletpb.ChainConfig{Keydir: keydir}}})
+		addr, err := manager.NewAccount([]byte(passphrase))
+		if err != nil {
+			FatalF("create validator keystore failed: %v", err)
+		}
+		genesis.Consensus.Dpos.Dynasty = append(genesis.Consensus.Dpos.Dynasty, addr.String())
+
+		key, err := net.GenerateEd25519Key()
+		if err != nil {
+			FatalF("generate network key failed: %v", err)
+		}
+		str, err := net.MarshalNetworkKey(key)
+		if err != nil {
+			FatalF("generate network key failed: %v", err)
+		}
+		keyPath := filepath.Join(dir, "network.key")
+		if err := account.WriteFile(keyPath, []byte(str)); err != nil {
+			FatalF("write network key failed: %v", err)
+		}
+
+		offset := i * genesisPortStep
+		nodes[i] = &nebletpb.Config{
+			Network: &nebletpb.NetworkConfig{
+				Listen:     []string{fmt.Sprintf("0.0.0.0:%d", genesisNetworkPort+offset)},
+				PrivateKey: keyPath,
+				NetworkId:  1,
+			},
+			Chain: &nebletpb.ChainConfig{
+				ChainId:          chainID,
+				Genesis:          filepath.Join(output, "genesis.conf"),
+				Datadir:          filepath.Join(dir, "data.db"),
+				Keydir:           keydir,
+				StartMine:        true,
+				Coinbase:         addr.String(),
+				Miner:            addr.String(),
+				Passphrase:       passphrase,
+				SignatureCiphers: []string{account.EccSecp256K1},
+			},
+			Rpc: &nebletpb.RPCConfig{
+				RpcListen:  []string{fmt.Sprintf("127.0.0.1:%d", genesisRPCPort+offset)},
+				HttpListen: []string{fmt.Sprintf("127.0.0.1:%d", genesisHTTPPort+offset)},
+				HttpModule: []string{"api", "admin"},
+			},
+			App: &nebletpb.AppConfig{
+				LogLevel: "info",
+				LogFile:  filepath.Join(dir, "logs"),
+			},
+			Stats: &nebletpb.StatsConfig{},
+		}
+
+		// the other nodes join the network by the first node.
+		if i == 0 {
+			id, err := peer.IDFromPublicKey(key.GetPublic())
+			if err != nil {
+				FatalF("generate network id failed: %v", err)
+			}
+			seeds = append(seeds, fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/ipfs/%s", genesisNetworkPort, id.Pretty()))
+		} else {
+			nodes[i].Network.Seed = seeds
+		}
+	}
+
+	if err := core.CheckGenesisConf(genesis); err != nil {
+		FatalF("invalid genesis conf: %v", err)
+	}
+	genesisPath := filepath.Join(output, "genesis.conf")
+	if err := writeConf(genesisPath, "# Neb genesis text file. Scheme is defined in core/pb/genesis.proto.", genesis); err != nil {
+		FatalF("write genesis failed: %v", err)
+	}
+	fmt.Printf("Genesis: %s\n", genesisPath)
+	for i, node := range nodes {
+		path := filepath.Join(output, fmt.Sprintf("node%d", i), "config.conf")
+		if err := writeConf(path, "# Neb configuration text file. Scheme is defined in neblet/pb/config.proto:Config.", node); err != nil {
+			FatalF("write node config failed: %v", err)
+		}
+		fmt.Printf("Validator #%d: %s, config: %s\n", i, node.Chain.Miner, path)
+	}
+	return nil
+}
+
+// loadTokenDistribution reads the address,value lines of the csv file, lines starting with # are skipped.
+func loadTokenDistribution(path string) ([]*corepb.GenesisTokenDistribution, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	tokens := []*corepb.GenesisTokenDistribution{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &corepb.GenesisTokenDistribution{
+			Address: strings.TrimSpace(record[0]),
+			Value:   strings.TrimSpace(record[1]),
+		})
+	}
+	return tokens, nil
+}
+
+// writeConf writes the conf readable by the owner only, a node config holds the keystore passphrase.
+func writeConf(path, header string, conf proto.Message) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	content := header + "\n#\n\n" + proto.MarshalTextString(conf)
+	return ioutil.WriteFile(path, []byte(content), 0600)
+}
+
+func promptString(prompt, value string) string {
+	input, err := console.Stdin.Prompt(fmt.Sprintf("%s [%s]: ", prompt, value))
+	if err != nil {
+		FatalF("Failed to read input: %v", err)
+	}
+	if input = strings.TrimSpace(input); len(input) > 0 {
+		return input
+	}
+	return value
+}
+
+func promptUint(prompt string, value uint64) uint64 {
+	input := promptString(prompt, strconv.FormatUint(value, 10))
+	v, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		FatalF("invalid number: %s", input)
+	}
+	return v
+}
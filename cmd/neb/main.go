@@ -71,6 +71,8 @@ func main() {
 		configCommand,
 		blockDumpCommand,
 		serializeCommand,
+		nrCommand,
+		dynastyCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -0,0 +1,100 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/nebulasio/go-nebulas/nr"
+	"github.com/urfave/cli"
+)
+
+var (
+	nrCommand = cli.Command{
+		Name:     "nr",
+		Usage:    "Manage nebulas rank",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Compute the nebulas rank of addresses from the local chain data.`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "compute",
+				Usage:     "Compute the nebulas rank of a period",
+				ArgsUsage: "<period>",
+				Action:    MergeFlags(computeNR),
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "window",
+						Usage: "count of blocks in a period, the genesis nr window if not set",
+					},
+					cli.BoolFlag{
+						Name:  "save",
+						Usage: "store the result in the chain storage",
+					},
+				},
+				Description: `
+    neb nr compute 3
+
+Compute the nebulas rank of period 3 on the canonical chain and print it as json.`,
+			},
+		},
+	}
+)
+
+func computeNR(ctx *cli.Context) error {
+	period, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		FatalF("invalid period: %v", err)
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	neb.Setup()
+
+	window := ctx.Uint64("window")
+	if window == 0 {
+		window = nr.GenesisWindow(neb.Genesis())
+	}
+	data, err := nr.Compute(neb.BlockChain(), period, window)
+	if err != nil {
+		FatalF("compute nebulas rank faild: %v", err)
+	}
+	if ctx.Bool("save") {
+		if err := nr.Save(neb.Storage(), data); err != nil {
+			FatalF("save nebulas rank faild: %v", err)
+		}
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		FatalF("compute nebulas rank faild: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, dataJSON, "", "    "); err != nil {
+		FatalF("compute nebulas rank faild: %v", err)
+	}
+	fmt.Println(buf.String())
+	return nil
+}
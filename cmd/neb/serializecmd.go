@@ -83,6 +83,14 @@ type txJSON struct {
 
 	Delegate *delegateJSON `json:"delegate"`
 
+	// claim the shared rewards of the sender
+	Claim bool `json:"claim"`
+
+	// return the jailed sender to elections
+	Unjail bool `json:"unjail"`
+
+	Proposal *proposalJSON `json:"proposal"`
+
 	// from key file path
 	Keyfile string `json:"keyfile"`
 	// from key passphrase
@@ -97,12 +105,26 @@ type contractJSON struct {
 }
 
 type candidateJSON struct {
-	Action string `json:"action"`
+	Action     string `json:"action"`
+	Name       string `json:"name"`
+	URL        string `json:"url"`
+	Endpoint   string `json:"endpoint"`
+	Commission uint32 `json:"commission"`
 }
 
 type delegateJSON struct {
 	Action    string `json:"action"`
 	Delegatee string `json:"delegatee"`
+	Value     string `json:"value"`
+}
+
+type proposalJSON struct {
+	Action   string `json:"action"`
+	Param    string `json:"param"`
+	Value    string `json:"value"`
+	Height   uint64 `json:"height"`
+	Proposal string `json:"proposal"`
+	Approve  bool   `json:"approve"`
 }
 
 type blockHeaderJSON struct {
@@ -216,10 +238,33 @@ func parseTransaction(neb *neblet.Neblet, txJSON *txJSON) (*core.Transaction, er
 		payload, err = core.NewCallPayload(txJSON.Contract.Function, txJSON.Contract.Args).ToBytes()
 	} else if txJSON.Candidate != nil {
 		payloadType = core.TxPayloadCandidateType
-		payload, err = core.NewCandidatePayload(txJSON.Candidate.Action).ToBytes()
+		candidate := core.NewCandidatePayload(txJSON.Candidate.Action)
+		candidate.Name = txJSON.Candidate.Name
+		candidate.URL = txJSON.Candidate.URL
+		candidate.Endpoint = txJSON.Candidate.Endpoint
+		candidate.Commission = txJSON.Candidate.Commission
+		payload, err = candidate.ToBytes()
 	} else if txJSON.Delegate != nil {
 		payloadType = core.TxPayloadDelegateType
-		payload, err = core.NewDelegatePayload(txJSON.Delegate.Action, txJSON.Delegate.Delegatee).ToBytes()
+		delegate := core.NewDelegatePayload(txJSON.Delegate.Action, txJSON.Delegate.Delegatee)
+		delegate.Value = txJSON.Delegate.Value
+		payload, err = delegate.ToBytes()
+	} else if txJSON.Claim {
+		payloadType = core.TxPayloadClaimType
+		payload, err = core.NewClaimPayload().ToBytes()
+	} else if txJSON.Unjail {
+		payloadType = core.TxPayloadUnjailType
+		payload, err = core.NewUnjailPayload().ToBytes()
+	} else if txJSON.Proposal != nil {
+		payloadType = core.TxPayloadProposalType
+		payload, err = (&core.ProposalPayload{
+			Action:   txJSON.Proposal.Action,
+			Param:    txJSON.Proposal.Param,
+			Value:    txJSON.Proposal.Value,
+			Height:   txJSON.Proposal.Height,
+			Proposal: txJSON.Proposal.Proposal,
+			Approve:  txJSON.Proposal.Approve,
+		}).ToBytes()
 	} else {
 		payloadType = core.TxPayloadBinaryType
 	}
@@ -0,0 +1,97 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// signer is the reference external block signer of validators. It keeps the
+// miner key out of the node and refuses to sign two blocks in a slot, the last
+// signed block is persisted in the state file.
+//
+//	signer -key keydir/<miner key file> -listen unix:///var/run/neb-signer.sock
+//
+// and set `signer: "unix:///var/run/neb-signer.sock"` in the chain config of the node.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/nebulasio/go-nebulas/account/signer"
+	"github.com/nebulasio/go-nebulas/cmd/console"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/cipher"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+)
+
+var (
+	keyFile    = flag.String("key", "", "the keystore file of the miner")
+	passphrase = flag.String("passphrase", "", "passphrase of the key, prompt if not set")
+	listen     = flag.String("listen", "unix:///tmp/neb-signer.sock", "listen endpoint, unix:///path/to/socket")
+	slot       = flag.Int64("slot", core.BlockInterval, "block interval in seconds, blocks more than a slot ahead of the clock are refused")
+	stateFile  = flag.String("state", "signer.state.json", "file persisting the last signed block")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	keyjson, err := ioutil.ReadFile(*keyFile)
+	if err != nil {
+		return err
+	}
+	pass := *passphrase
+	if len(pass) == 0 {
+		if pass, err = console.Stdin.PromptPassphrase("Enter the miner's passphrase:"); err != nil {
+			return err
+		}
+	}
+	data, err := cipher.NewCipher(uint8(keystore.SCRYPT)).DecryptKey(keyjson, []byte(pass))
+	if err != nil {
+		return err
+	}
+	key, err := crypto.NewPrivateKey(keystore.SECP256K1, data)
+	if err != nil {
+		return err
+	}
+
+	guard, err := signer.NewGuard(*stateFile, *slot)
+	if err != nil {
+		return err
+	}
+	server, err := signer.NewServer(key, guard)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("signing blocks of %s at %s\n", server.Address(), *listen)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		server.Stop()
+	}()
+	return server.Serve(*listen)
+}
@@ -21,8 +21,15 @@ package trie
 import (
 	"bytes"
 	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 )
 
+// ErrInvalidProof is returned if a merkle proof does not prove the value of the key.
+var ErrInvalidProof = errors.New("invalid merkle proof")
+
 // MerkleProof is a path from root to the proved node
 // every element in path is the value of a node
 type MerkleProof [][][]byte
@@ -119,3 +126,41 @@ func (t *Trie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
 	}
 	return nil
 }
+
+// VerifyProof checks the proof is a complete path from the root to the leaf of the key
+// and the leaf holds the value. Unlike Verify, the proof nodes are not committed to
+// a storage and malformed proofs from peers are rejected instead of panicking.
+func VerifyProof(rootHash []byte, key []byte, value []byte, proof MerkleProof) error {
+	curRoute := keyToRoute(key)
+	wantHash := rootHash
+	for _, val := range proof {
+		ir, err := proto.Marshal(&triepb.Node{Val: val})
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(wantHash, hash.Sha3256(ir)) {
+			return ErrInvalidProof
+		}
+
+		switch {
+		case len(val) == 16 && len(curRoute) > 0:
+			wantHash = val[curRoute[0]]
+			curRoute = curRoute[1:]
+		case len(val) == 3 && len(val[0]) == 1 && val[0][0] == byte(ext):
+			extLen := len(val[1])
+			if extLen > len(curRoute) || !bytes.Equal(val[1], curRoute[:extLen]) {
+				return ErrInvalidProof
+			}
+			wantHash = val[2]
+			curRoute = curRoute[extLen:]
+		case len(val) == 3 && len(val[0]) == 1 && val[0][0] == byte(leaf):
+			if !bytes.Equal(val[1], curRoute) || !bytes.Equal(val[2], value) {
+				return ErrInvalidProof
+			}
+			return nil
+		default:
+			return ErrInvalidProof
+		}
+	}
+	return ErrInvalidProof
+}
@@ -266,10 +266,11 @@ func (t *Trie) updateWhenMeetExt(rootNode *node, route []byte, val []byte) ([]by
 	var err error
 	path := rootNode.Val[1]
 	next := rootNode.Val[2]
-	if len(path) > len(route) {
+	matchLen := prefixLen(path, route)
+	// a shorter route diverging from the path is fine, a prefix of the path can not be stored.
+	if len(path) > len(route) && matchLen == len(route) {
 		return nil, errors.New("wrong key, too short")
 	}
-	matchLen := prefixLen(path, route)
 	// add new node to the ext node's sub-trie
 	if matchLen == len(path) {
 		newHash, err := t.update(next, route[matchLen:], val)
@@ -344,10 +345,11 @@ func (t *Trie) updateWhenMeetLeaf(rootNode *node, route []byte, val []byte) ([]b
 	var err error
 	path := rootNode.Val[1]
 	leafVal := rootNode.Val[2]
-	if len(path) > len(route) {
+	matchLen := prefixLen(path, route)
+	// a shorter route diverging from the path is fine, a prefix of the path can not be stored.
+	if len(path) > len(route) && matchLen == len(route) {
 		return nil, errors.New("wrong key, too short")
 	}
-	matchLen := prefixLen(path, route)
 	// node exists, update its value
 	if matchLen == len(path) {
 		rootNode.Val[2] = val
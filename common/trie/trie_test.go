@@ -273,3 +273,64 @@ func TestTrie_Stress(t *testing.T) {
 	fmt.Printf("%d Get, cost %d\n", COUNT, endAt-startAt)
 	// 10000 Get, cost 396201000
 }
+
+func TestTrie_MixedKeyLength(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, storage)
+
+	// shorter keys diverging from the stored paths are stored beside them
+	long, short, ext := []byte{3, 0, 0, 1}, []byte{2}, []byte{3, 0, 0, 2}
+	for _, key := range [][]byte{long, short, ext, {3, 1}} {
+		if _, err := tr.Put(key, key); err != nil {
+			t.Errorf("Put(%v) error = %v", key, err)
+		}
+	}
+	for _, key := range [][]byte{long, short, ext, {3, 1}} {
+		if val, err := tr.Get(key); err != nil || !reflect.DeepEqual(val, key) {
+			t.Errorf("Get(%v) = %v, %v", key, val, err)
+		}
+	}
+	// a prefix of a stored path can not be stored
+	if _, err := tr.Put([]byte{3, 0}, nil); err == nil {
+		t.Errorf("Put of a prefix should fail")
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, storage)
+	keys := [][]byte{{0x1f, 0x34, 0x56}, {0x1f, 0x35, 0x56}, {0x2a, 0x00, 0x01}}
+	for i, key := range keys {
+		tr.Put(key, []byte{byte(i)})
+	}
+
+	proof, err := tr.Prove(keys[1])
+	if err != nil {
+		t.Fatalf("Trie.Prove() %v", err)
+	}
+	if err := VerifyProof(tr.rootHash, keys[1], []byte{1}, proof); err != nil {
+		t.Errorf("VerifyProof() %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		root  []byte
+		key   []byte
+		value []byte
+		proof MerkleProof
+	}{
+		{"wrong value", tr.rootHash, keys[1], []byte{2}, proof},
+		{"wrong key", tr.rootHash, keys[0], []byte{1}, proof},
+		{"wrong root", hash.Sha3256([]byte("root")), keys[1], []byte{1}, proof},
+		{"empty proof", tr.rootHash, keys[1], []byte{1}, nil},
+		{"incomplete proof", tr.rootHash, keys[1], []byte{1}, proof[:len(proof)-1]},
+		{"short key", tr.rootHash, keys[1][:1], []byte{1}, proof},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifyProof(tt.root, tt.key, tt.value, tt.proof); err != ErrInvalidProof {
+				t.Errorf("VerifyProof() error = %v, want %v", err, ErrInvalidProof)
+			}
+		})
+	}
+}
@@ -28,10 +28,12 @@ import (
 	"github.com/nebulasio/go-nebulas/account"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net"
 
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -42,8 +44,6 @@ var (
 	ErrInvalidBlockInterval   = errors.New("invalid block interval")
 	ErrMissingConfigForDpos   = errors.New("missing configuration for Dpos")
 	ErrInvalidBlockProposer   = errors.New("invalid block proposer")
-	ErrCannotMintWhenPending  = errors.New("cannot mint block now, waiting for cancel pending again")
-	ErrCannotMintWhenDiable   = errors.New("cannot mint block now, waiting for enable it again")
 	ErrWaitingBlockInLastSlot = errors.New("cannot mint block now, waiting for last block")
 	ErrBlockMintedInNextSlot  = errors.New("cannot mint block now, there is a block minted in current slot")
 )
@@ -59,25 +59,32 @@ type Neblet interface {
 	BlockChain() *core.BlockChain
 	NetService() net.Service
 	AccountManager() *account.Manager
+	EventEmitter() *core.EventEmitter
 }
 
 // Dpos Delegate Proof-of-Stake
 type Dpos struct {
 	quitCh chan bool
 
-	chain *core.BlockChain
-	ns    net.Service
-	am    *account.Manager
+	chain   *core.BlockChain
+	ns      net.Service
+	am      *account.Manager
+	emitter *core.EventEmitter
 
 	coinbase *core.Address
 	miner    *core.Address
 
-	blockInterval   int64
-	dynastyInterval int64
-	txsPerBlock     int
-
-	enable  bool
-	pending bool
+	params      *core.DposParams
+	txsPerBlock int
+
+	machine      *consensus.StateMachine
+	chainEvents  *core.EventSubscriber
+	idle         *idleState
+	idleSyncing  *idleSyncingState
+	syncing      *syncingState
+	waiting      *waitingSlotState
+	minting      *mintingState
+	broadcasting *broadcastingState
 }
 
 // NewDpos create Dpos instance.
@@ -85,17 +92,23 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 	p := &Dpos{
 		quitCh: make(chan bool, 5),
 
-		chain: neblet.BlockChain(),
-		ns:    neblet.NetService(),
-		am:    neblet.AccountManager(),
+		chain:   neblet.BlockChain(),
+		ns:      neblet.NetService(),
+		am:      neblet.AccountManager(),
+		emitter: neblet.EventEmitter(),
 
-		blockInterval:   core.BlockInterval,
-		dynastyInterval: core.DynastyInterval,
-		txsPerBlock:     10000,
+		params:      neblet.BlockChain().DposParams(),
+		txsPerBlock: 10000,
 
-		enable:  false,
-		pending: true,
+		chainEvents: core.NewEventSubscriber(1024, []string{core.TopicLinkBlock, core.TopicDoubleSign}),
 	}
+	p.idle = &idleState{p: p}
+	p.idleSyncing = &idleSyncingState{p: p}
+	p.syncing = &syncingState{p: p}
+	p.waiting = &waitingSlotState{p: p}
+	p.minting = &mintingState{p: p}
+	p.broadcasting = &broadcastingState{p: p}
+	p.machine = consensus.NewStateMachine(p.idleSyncing)
 
 	config := neblet.Config().Chain
 	coinbase, err := core.AddressParse(config.Coinbase)
@@ -122,7 +135,9 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 // Start start pow service.
 func (p *Dpos) Start() {
 	logging.CLog().Info("Starting Dpos Mining...")
-	go p.blockLoop()
+	p.machine.Start()
+	p.emitter.Register(p.chainEvents)
+	go p.eventLoop()
 }
 
 // Stop stop pow service.
@@ -130,38 +145,39 @@ func (p *Dpos) Stop() {
 	logging.CLog().Info("Stopping Dpos Mining...")
 	p.DisableMining()
 	p.quitCh <- true
+	p.emitter.Deregister(p.chainEvents)
+	p.machine.Stop()
 }
 
 // EnableMining start the consensus
 func (p *Dpos) EnableMining(passphrase string) error {
-	if err := p.am.Unlock(p.miner, []byte(passphrase), keystore.YearUnlockDuration); err != nil {
-		return err
+	// the miner key is kept by the external signer
+	if !p.am.ExternalSigner() {
+		if err := p.am.Unlock(p.miner, []byte(passphrase), keystore.YearUnlockDuration); err != nil {
+			return err
+		}
 	}
-	p.enable = true
+	p.machine.Fire(consensus.NewBaseEvent(consensus.EnableMiningEvent, nil))
 	logging.CLog().Info("Enabled Dpos Mining...")
 	return nil
 }
 
 // DisableMining stop the consensus
 func (p *Dpos) DisableMining() error {
-	if err := p.am.Lock(p.miner); err != nil {
-		return err
+	if !p.am.ExternalSigner() {
+		if err := p.am.Lock(p.miner); err != nil {
+			return err
+		}
 	}
-	p.enable = false
+	p.machine.Fire(consensus.NewBaseEvent(consensus.DisableMiningEvent, nil))
 	logging.CLog().Info("Disable Dpos Mining...")
 	return nil
 }
 
 // Enable returns is mining
 func (p *Dpos) Enable() bool {
-	return p.enable
-}
-
-func less(a *core.Block, b *core.Block) bool {
-	if a.Height() != b.Height() {
-		return a.Height() < b.Height()
-	}
-	return byteutils.Less(a.Hash(), b.Hash())
+	state := p.machine.State()
+	return state != IdleState && state != IdleSyncingState
 }
 
 // ForkChoice select new tail
@@ -170,19 +186,13 @@ func (p *Dpos) ForkChoice() error {
 	tailBlock := bc.TailBlock()
 	detachedTailBlocks := bc.DetachedTailBlocks()
 
-	// find the max depth.
-	newTailBlock := tailBlock
-
-	for _, v := range detachedTailBlocks {
-		if less(newTailBlock, v) {
-			newTailBlock = v
-		}
-	}
+	newTailBlock := bc.ForkChoiceRule().Choose(bc, tailBlock, detachedTailBlocks)
 
 	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
 		logging.VLog().WithFields(logrus.Fields{
 			"old tail": tailBlock,
 			"new tail": newTailBlock,
+			"rule":     bc.ForkChoiceRule().Name(),
 		}).Info("Current tail is best, no need to change.")
 		return nil
 	}
@@ -204,21 +214,32 @@ func (p *Dpos) ForkChoice() error {
 	return nil
 }
 
-// Pending return if consensus can do mining now
+// Pending return if the mining waits for the chain to be synchronized
 func (p *Dpos) Pending() bool {
-	return p.pending
+	state := p.machine.State()
+	return state == SyncingState || state == IdleSyncingState
 }
 
 // SuspendMining pend dpos mining
 func (p *Dpos) SuspendMining() {
 	logging.CLog().Info("Suspended Dpos Mining.")
-	p.pending = true
+	p.machine.Fire(consensus.NewBaseEvent(consensus.SyncStartEvent, nil))
 }
 
 // ResumeMining continue dpos mining
 func (p *Dpos) ResumeMining() {
 	logging.CLog().Info("Resumed Dpos Mining.")
-	p.pending = false
+	p.machine.Fire(consensus.NewBaseEvent(consensus.SyncFinishEvent, nil))
+}
+
+// State returns the current state of the mining state machine.
+func (p *Dpos) State() string {
+	return p.machine.State()
+}
+
+// Transitions returns the recent transitions of the mining state machine.
+func (p *Dpos) Transitions() []*consensus.Transition {
+	return p.machine.Transitions()
 }
 
 func verifyBlockSign(miner *core.Address, block *core.Block) error {
@@ -250,12 +271,12 @@ func (p *Dpos) FastVerifyBlock(block *core.Block) error {
 	tail := p.chain.TailBlock()
 	// check timestamp
 	elapsedSecond := block.Timestamp() - tail.Timestamp()
-	if elapsedSecond%p.blockInterval != 0 {
+	if elapsedSecond%p.params.BlockInterval != 0 {
 		return ErrInvalidBlockInterval
 	}
 	// check proposer
-	currentHour := block.Timestamp() / core.DynastyInterval
-	tailHour := tail.Timestamp() / core.DynastyInterval
+	currentHour := block.Timestamp() / p.params.DynastyInterval
+	tailHour := tail.Timestamp() / p.params.DynastyInterval
 	var dynastyRoot byteutils.Hash
 	if currentHour == tailHour {
 		dynastyRoot = tail.DposContext().DynastyRoot
@@ -273,7 +294,7 @@ func (p *Dpos) FastVerifyBlock(block *core.Block) error {
 		}).Debug("Failed to create new trie.")
 		return err
 	}
-	proposer, err := core.FindProposer(block.Timestamp(), dynasty)
+	proposer, err := p.params.FindProposer(block.Timestamp(), dynasty)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"proposer": proposer,
@@ -301,7 +322,7 @@ func (p *Dpos) VerifyBlock(block *core.Block, parent *core.Block) error {
 	if err != nil {
 		return err
 	}
-	proposer, err := core.FindProposer(block.Timestamp(), dynasty)
+	proposer, err := p.params.FindProposer(block.Timestamp(), dynasty)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"proposer": proposer,
@@ -373,16 +394,16 @@ func (p *Dpos) newBlock(tail *core.Block, context *core.DynastyContext, deadline
 	return block, nil
 }
 
-func lastSlot(now int64) int64 {
-	return int64((now-1)/core.BlockInterval) * core.BlockInterval
+func (p *Dpos) lastSlot(now int64) int64 {
+	return int64((now-1)/p.params.BlockInterval) * p.params.BlockInterval
 }
 
-func nextSlot(now int64) int64 {
-	return int64((now+core.BlockInterval-1)/core.BlockInterval) * core.BlockInterval
+func (p *Dpos) nextSlot(now int64) int64 {
+	return int64((now+p.params.BlockInterval-1)/p.params.BlockInterval) * p.params.BlockInterval
 }
 
-func deadline(now int64) int64 {
-	nextSlot := nextSlot(now)
+func (p *Dpos) deadline(now int64) int64 {
+	nextSlot := p.nextSlot(now)
 	remain := nextSlot - now
 	if core.MaxMintDuration > remain {
 		return nextSlot
@@ -391,23 +412,23 @@ func deadline(now int64) int64 {
 }
 
 func (p *Dpos) checkDeadline(tail *core.Block, now int64) (int64, error) {
-	lastSlot := lastSlot(now)
-	nextSlot := nextSlot(now)
+	lastSlot := p.lastSlot(now)
+	nextSlot := p.nextSlot(now)
 
 	if tail.Timestamp() == nextSlot {
 		return 0, ErrBlockMintedInNextSlot
 	}
 	if tail.Timestamp() == lastSlot {
-		return deadline(now), nil
+		return p.deadline(now), nil
 	}
 	if nextSlot-now <= core.MinMintDuration {
-		return deadline(now), nil
+		return p.deadline(now), nil
 	}
 	return 0, ErrWaitingBlockInLastSlot
 }
 
 func (p *Dpos) checkProposer(tail *core.Block, now int64) (*core.DynastyContext, error) {
-	slot := nextSlot(now)
+	slot := p.nextSlot(now)
 	elapsed := slot - tail.Timestamp()
 	context, err := tail.NextDynastyContext(p.chain, elapsed)
 	if err != nil {
@@ -447,83 +468,79 @@ func (p *Dpos) broadcast(tail *core.Block, block *core.Block) error {
 	return nil
 }
 
-func (p *Dpos) mintBlock(now int64) error {
+// checkMint checks whether the miner can mint a block in the coming slot.
+func (p *Dpos) checkMint(now int64) (*mintTask, error) {
 	metricsBlockPackingTime.Update(0)
 
-	// check mining enable
-	if !p.enable {
-		return ErrCannotMintWhenDiable
-	}
-
-	// check mining pending
-	if p.pending {
-		return ErrCannotMintWhenPending
-	}
-
 	tail := p.chain.TailBlock()
 
 	deadline, err := p.checkDeadline(tail, now)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	context, err := p.checkProposer(tail, now)
 	if err != nil {
-		return err
-	}
-
-	logging.CLog().WithFields(logrus.Fields{
-		"tail":     tail,
-		"start":    now,
-		"deadline": deadline,
-		"expected": context.Proposer.Hex(),
-		"actual":   p.coinbase,
-	}).Info("My turn to mint block")
-	metricsBlockPackingTime.Update(deadline - now)
-
-	block, err := p.newBlock(tail, context, deadline)
-	if err != nil {
-		return err
-	}
-
-	slot := nextSlot(now)
-	current := time.Now().Unix()
-	if slot > current {
-		timer := time.NewTimer(time.Duration(slot-current) * time.Second).C
-		<-timer
-	}
-
-	logging.CLog().WithFields(logrus.Fields{
-		"tail":     tail,
-		"block":    block,
-		"start":    now,
-		"packed":   current,
-		"deadline": deadline,
-		"slot":     slot,
-		"end":      time.Now().Unix(),
-	}).Info("Minted new block")
-
-	if err := p.broadcast(tail, block); err != nil {
-		return err
+		return nil, err
 	}
 
-	logging.CLog().WithFields(logrus.Fields{
-		"tail":  tail,
-		"block": block,
-	}).Info("Broadcasted new block")
-	return nil
+	return &mintTask{
+		tail:     tail,
+		context:  context,
+		now:      now,
+		deadline: deadline,
+	}, nil
 }
 
-func (p *Dpos) blockLoop() {
+func (p *Dpos) eventLoop() {
 	logging.CLog().Info("Started Dpos Mining.")
 	timeChan := time.NewTicker(time.Second).C
 	for {
 		select {
 		case now := <-timeChan:
-			p.mintBlock(now.Unix())
+			p.machine.Fire(consensus.NewBaseEvent(consensus.TickEvent, now.Unix()))
+		case event := <-p.chainEvents.EventChan():
+			if event.Topic == core.TopicDoubleSign {
+				p.submitEvidence(event.Data)
+				continue
+			}
+			p.machine.Fire(consensus.NewBaseEvent(consensus.NewTailEvent, time.Now().Unix()))
 		case <-p.quitCh:
 			logging.CLog().Info("Stopped Dpos Mining.")
 			return
 		}
 	}
 }
+
+// submitEvidence sends the double sign evidence found by the block pool in a tx signed by the miner.
+func (p *Dpos) submitEvidence(data string) {
+	if !p.Enable() {
+		return
+	}
+	tail := p.chain.TailBlock()
+	if !p.chain.ChainRules(tail.Height() + 1).IsDoubleSignEvidence {
+		return
+	}
+	nonce, err := tail.GetNonce(p.miner.Bytes())
+	if err != nil {
+		return
+	}
+	tx := core.NewTransaction(p.chain.ChainID(), p.miner, p.miner, util.NewUint128(), nonce+1, core.TxPayloadEvidenceType, []byte(data), core.TransactionGasPrice, core.EvidenceGasLimit)
+	if err := p.am.SignTransaction(p.miner, tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"miner": p.miner,
+			"err":   err,
+		}).Error("Failed to sign double sign evidence.")
+		return
+	}
+	if err := p.chain.TransactionPool().PushAndBroadcast(tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tx":  tx,
+			"err": err,
+		}).Warn("Failed to submit double sign evidence.")
+		return
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"tx": tx,
+	}).Info("Submitted double sign evidence.")
+}
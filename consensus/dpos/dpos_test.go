@@ -27,6 +27,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
@@ -313,10 +314,14 @@ func TestCanMining(t *testing.T) {
 	dpos, err := NewDpos(mockNeb(t))
 	assert.Nil(t, err)
 	assert.Equal(t, dpos.Pending(), true)
-	dpos.SuspendMining()
-	assert.Equal(t, dpos.Pending(), true)
-	dpos.ResumeMining()
+	assert.Equal(t, dpos.Enable(), false)
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SyncFinishEvent, nil))
 	assert.Equal(t, dpos.Pending(), false)
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.EnableMiningEvent, nil))
+	assert.Equal(t, dpos.Enable(), true)
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SyncStartEvent, nil))
+	assert.Equal(t, dpos.Pending(), true)
+	assert.Equal(t, dpos.Enable(), true)
 }
 
 func TestFastVerifyBlock(t *testing.T) {
@@ -368,18 +373,82 @@ func TestDpos_MintBlock(t *testing.T) {
 	assert.Nil(t, err)
 	dpos.chain.SetConsensusHandler(dpos)
 
-	assert.Equal(t, dpos.mintBlock(0), ErrCannotMintWhenDiable)
+	_, err = dpos.checkMint(core.BlockInterval)
+	assert.Equal(t, err, ErrInvalidBlockProposer)
 
 	assert.Nil(t, dpos.EnableMining("passphrase"))
-	dpos.SuspendMining()
-	assert.Equal(t, dpos.mintBlock(0), ErrCannotMintWhenPending)
-
-	dpos.ResumeMining()
-	assert.Equal(t, dpos.mintBlock(core.BlockInterval), ErrInvalidBlockProposer)
-
 	received = []byte{}
-	assert.Equal(t, dpos.mintBlock(core.DynastyInterval), nil)
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SyncFinishEvent, nil))
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.EnableMiningEvent, nil))
+	assert.Equal(t, WaitingSlotState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.TickEvent, int64(core.DynastyInterval)))
+	assert.Equal(t, WaitingSlotState, dpos.State())
 	assert.NotEqual(t, received, []byte{})
+
+	var states []string
+	for _, v := range dpos.Transitions() {
+		states = append(states, v.To)
+	}
+	assert.Equal(t, []string{IdleState, WaitingSlotState, MintingState, BroadcastingState, WaitingSlotState}, states)
+}
+
+func TestDpos_StateMachine(t *testing.T) {
+	dpos, err := NewDpos(mockNeb(t))
+	assert.Nil(t, err)
+	assert.Equal(t, IdleSyncingState, dpos.State())
+
+	// pending until the first sync finished
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.EnableMiningEvent, nil))
+	assert.Equal(t, SyncingState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.TickEvent, int64(0)))
+	assert.Equal(t, SyncingState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SyncFinishEvent, nil))
+	assert.Equal(t, WaitingSlotState, dpos.State())
+
+	// not my turn, stay waiting on ticks
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.TickEvent, int64(0)))
+	assert.Equal(t, WaitingSlotState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SyncStartEvent, nil))
+	assert.Equal(t, SyncingState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.DisableMiningEvent, nil))
+	assert.Equal(t, IdleSyncingState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SyncFinishEvent, nil))
+	assert.Equal(t, IdleState, dpos.State())
+
+	transitions := dpos.Transitions()
+	assert.Equal(t, 5, len(transitions))
+	assert.Equal(t, SyncingState, transitions[3].From)
+	assert.Equal(t, IdleSyncingState, transitions[3].To)
+	assert.Equal(t, consensus.DisableMiningEvent, transitions[3].Event)
+}
+
+func TestDpos_BroadcastingState(t *testing.T) {
+	dpos, err := NewDpos(mockNeb(t))
+	assert.Nil(t, err)
+	dpos.chain.SetConsensusHandler(dpos)
+	tail := dpos.chain.TailBlock()
+	block, err := dpos.chain.NewBlock(dpos.coinbase)
+	assert.Nil(t, err)
+	block.SetMiner(dpos.coinbase)
+	assert.Nil(t, block.Seal())
+
+	// the slot is not come, the machine keeps handling events meanwhile.
+	dpos.machine = consensus.NewStateMachine(dpos.minting)
+	dpos.broadcasting.task = &mintTask{tail: tail, now: time.Now().Unix() + 60}
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.MintedEvent, block))
+	assert.Equal(t, BroadcastingState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SlotEvent, tail))
+	assert.Equal(t, BroadcastingState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.DisableMiningEvent, nil))
+	assert.Equal(t, IdleState, dpos.State())
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.SlotEvent, block))
+	assert.Equal(t, IdleState, dpos.State())
+
+	// the slot is passed, the block is broadcasted right away.
+	dpos.machine = consensus.NewStateMachine(dpos.minting)
+	dpos.broadcasting.task = &mintTask{tail: tail, now: 0}
+	dpos.machine.Handle(consensus.NewBaseEvent(consensus.MintedEvent, block))
+	assert.Equal(t, WaitingSlotState, dpos.State())
 }
 
 func TestContracts(t *testing.T) {
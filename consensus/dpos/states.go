@@ -0,0 +1,244 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"time"
+
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// States of Dpos
+const (
+	IdleState         = "idle"
+	IdleSyncingState  = "idle-syncing"
+	SyncingState      = "syncing"
+	WaitingSlotState  = "waiting-for-slot"
+	MintingState      = "minting"
+	BroadcastingState = "broadcasting"
+)
+
+// mintTask is the block to mint in the coming slot.
+type mintTask struct {
+	tail     *core.Block
+	context  *core.DynastyContext
+	now      int64
+	deadline int64
+}
+
+// idleState mining is disabled, the chain is synchronized.
+type idleState struct {
+	p *Dpos
+}
+
+func (s *idleState) Event(e consensus.Event) (bool, consensus.State) {
+	switch e.EventType() {
+	case consensus.EnableMiningEvent:
+		return true, s.p.waiting
+	case consensus.SyncStartEvent:
+		return true, s.p.idleSyncing
+	}
+	return false, nil
+}
+
+func (s *idleState) Enter(data interface{}) {}
+func (s *idleState) Leave(data interface{}) {}
+func (s *idleState) String() string         { return IdleState }
+
+// idleSyncingState mining is disabled, the chain is being synchronized.
+// The machine starts from it, the chain is synchronized before mining.
+type idleSyncingState struct {
+	p *Dpos
+}
+
+func (s *idleSyncingState) Event(e consensus.Event) (bool, consensus.State) {
+	switch e.EventType() {
+	case consensus.EnableMiningEvent:
+		return true, s.p.syncing
+	case consensus.SyncFinishEvent:
+		return true, s.p.idle
+	}
+	return false, nil
+}
+
+func (s *idleSyncingState) Enter(data interface{}) {}
+func (s *idleSyncingState) Leave(data interface{}) {}
+func (s *idleSyncingState) String() string         { return IdleSyncingState }
+
+// syncingState mining is enabled, waiting for the chain to be synchronized.
+type syncingState struct {
+	p *Dpos
+}
+
+func (s *syncingState) Event(e consensus.Event) (bool, consensus.State) {
+	switch e.EventType() {
+	case consensus.SyncFinishEvent:
+		return true, s.p.waiting
+	case consensus.DisableMiningEvent:
+		return true, s.p.idleSyncing
+	}
+	return false, nil
+}
+
+func (s *syncingState) Enter(data interface{}) {}
+func (s *syncingState) Leave(data interface{}) {}
+func (s *syncingState) String() string         { return SyncingState }
+
+// waitingSlotState checks on every tick and new tail whether it's the miner's turn.
+type waitingSlotState struct {
+	p *Dpos
+}
+
+func (s *waitingSlotState) Event(e consensus.Event) (bool, consensus.State) {
+	switch e.EventType() {
+	case consensus.TickEvent, consensus.NewTailEvent:
+		now, ok := e.Data().(int64)
+		if !ok {
+			now = time.Now().Unix()
+		}
+		task, err := s.p.checkMint(now)
+		if err != nil {
+			return true, nil
+		}
+		s.p.minting.task = task
+		return true, s.p.minting
+	case consensus.SyncStartEvent:
+		return true, s.p.syncing
+	case consensus.DisableMiningEvent:
+		return true, s.p.idle
+	}
+	return false, nil
+}
+
+func (s *waitingSlotState) Enter(data interface{}) {}
+func (s *waitingSlotState) Leave(data interface{}) {}
+func (s *waitingSlotState) String() string         { return WaitingSlotState }
+
+// mintingState packs and signs the block of the slot.
+type mintingState struct {
+	p    *Dpos
+	task *mintTask
+}
+
+func (s *mintingState) Event(e consensus.Event) (bool, consensus.State) {
+	switch e.EventType() {
+	case consensus.MintedEvent:
+		return true, s.p.broadcasting
+	case consensus.FailedEvent:
+		return true, s.p.waiting
+	}
+	return false, nil
+}
+
+func (s *mintingState) Enter(data interface{}) {
+	task := s.task
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":     task.tail,
+		"start":    task.now,
+		"deadline": task.deadline,
+		"expected": task.context.Proposer.Hex(),
+		"actual":   s.p.coinbase,
+	}).Info("My turn to mint block")
+	metricsBlockPackingTime.Update(task.deadline - task.now)
+
+	block, err := s.p.newBlock(task.tail, task.context, task.deadline)
+	if err != nil {
+		s.p.machine.Post(consensus.NewBaseEvent(consensus.FailedEvent, err))
+		return
+	}
+	s.p.broadcasting.task = task
+	s.p.machine.Post(consensus.NewBaseEvent(consensus.MintedEvent, block))
+}
+
+func (s *mintingState) Leave(data interface{}) {}
+func (s *mintingState) String() string         { return MintingState }
+
+// broadcastingState broadcasts the minted block when its slot comes,
+// the block is dropped if mining is disabled or the sync starts meanwhile.
+type broadcastingState struct {
+	p     *Dpos
+	task  *mintTask
+	block *core.Block
+}
+
+func (s *broadcastingState) Event(e consensus.Event) (bool, consensus.State) {
+	switch e.EventType() {
+	case consensus.SlotEvent:
+		// the slot of a block dropped before
+		if e.Data() != s.block {
+			return false, nil
+		}
+		s.broadcast()
+		return true, nil
+	case consensus.BroadcastedEvent, consensus.FailedEvent:
+		return true, s.p.waiting
+	case consensus.SyncStartEvent:
+		return true, s.p.syncing
+	case consensus.DisableMiningEvent:
+		return true, s.p.idle
+	}
+	return false, nil
+}
+
+func (s *broadcastingState) Enter(data interface{}) {
+	block := data.(*core.Block)
+	s.block = block
+
+	slot := s.p.nextSlot(s.task.now)
+	current := time.Now().Unix()
+	if slot <= current {
+		s.p.machine.Post(consensus.NewBaseEvent(consensus.SlotEvent, block))
+		return
+	}
+	time.AfterFunc(time.Duration(slot-current)*time.Second, func() {
+		s.p.machine.Fire(consensus.NewBaseEvent(consensus.SlotEvent, block))
+	})
+}
+
+func (s *broadcastingState) broadcast() {
+	block, task := s.block, s.task
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":     task.tail,
+		"block":    block,
+		"start":    task.now,
+		"deadline": task.deadline,
+		"slot":     s.p.nextSlot(task.now),
+		"end":      time.Now().Unix(),
+	}).Info("Minted new block")
+
+	if err := s.p.broadcast(task.tail, block); err != nil {
+		s.p.machine.Post(consensus.NewBaseEvent(consensus.FailedEvent, err))
+		return
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":  task.tail,
+		"block": block,
+	}).Info("Broadcasted new block")
+	s.p.machine.Post(consensus.NewBaseEvent(consensus.BroadcastedEvent, block))
+}
+
+func (s *broadcastingState) Leave(data interface{}) {
+	s.block = nil
+}
+
+func (s *broadcastingState) String() string { return BroadcastingState }
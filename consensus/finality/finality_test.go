@@ -0,0 +1,263 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/consensus/finality/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConsensus struct {
+	enable bool
+}
+
+func (c *mockConsensus) Start()                    {}
+func (c *mockConsensus) Stop()                     {}
+func (c *mockConsensus) EnableMining(string) error { return nil }
+func (c *mockConsensus) DisableMining() error      { return nil }
+func (c *mockConsensus) Enable() bool              { return c.enable }
+func (c *mockConsensus) ResumeMining()             {}
+func (c *mockConsensus) SuspendMining()            {}
+func (c *mockConsensus) Pending() bool             { return false }
+func (c *mockConsensus) ForkChoice() error         { return nil }
+
+func (c *mockConsensus) VerifyBlock(block *core.Block, parent *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c *mockConsensus) FastVerifyBlock(block *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c *mockConsensus) State() string                        { return "" }
+func (c *mockConsensus) Transitions() []*consensus.Transition { return nil }
+
+type mockNetService struct {
+	broadcasted []*Vote
+}
+
+func (n *mockNetService) Start() error                              { return nil }
+func (n *mockNetService) Stop()                                     {}
+func (n *mockNetService) Node() *net.Node                           { return nil }
+func (n *mockNetService) Register(...*net.Subscriber)               {}
+func (n *mockNetService) Deregister(...*net.Subscriber)             {}
+func (n *mockNetService) Relay(string, net.Serializable, int)       {}
+func (n *mockNetService) SendMsg(string, []byte, string, int) error { return nil }
+func (n *mockNetService) ClosePeer(string, error)                   {}
+func (n *mockNetService) BroadcastNetworkID([]byte)                 {}
+func (n *mockNetService) BuildRawMessageData([]byte, string) []byte {
+	return nil
+}
+func (n *mockNetService) SendMessageToPeers(string, []byte, int, net.PeerFilterAlgorithm) []string {
+	return nil
+}
+func (n *mockNetService) SendMessageToPeer(string, []byte, int, string) error {
+	return nil
+}
+
+func (n *mockNetService) Broadcast(name string, msg net.Serializable, priority int) {
+	if vote, ok := msg.(*Vote); ok {
+		n.broadcasted = append(n.broadcasted, vote)
+	}
+}
+
+type mockNeb struct {
+	genesis   *corepb.Genesis
+	config    *nebletpb.Config
+	storage   storage.Storage
+	emitter   *core.EventEmitter
+	chain     *core.BlockChain
+	ns        *mockNetService
+	am        *account.Manager
+	consensus *mockConsensus
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis         { return n.genesis }
+func (n *mockNeb) Config() *nebletpb.Config         { return n.config }
+func (n *mockNeb) Storage() storage.Storage         { return n.storage }
+func (n *mockNeb) EventEmitter() *core.EventEmitter { return n.emitter }
+func (n *mockNeb) BlockChain() *core.BlockChain     { return n.chain }
+func (n *mockNeb) NetService() net.Service          { return n.ns }
+func (n *mockNeb) AccountManager() *account.Manager { return n.am }
+func (n *mockNeb) Consensus() consensus.Consensus   { return n.consensus }
+
+func mockKey() (*core.Address, keystore.PrivateKey) {
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	addr, _ := core.NewAddressFromPublicKey(pubdata)
+	keystore.DefaultKS.SetKey(addr.String(), priv, []byte("passphrase"))
+	keystore.DefaultKS.Unlock(addr.String(), []byte("passphrase"), time.Hour)
+	return addr, priv
+}
+
+func signVote(t *testing.T, vote *Vote, addr *core.Address, priv keystore.PrivateKey) *Vote {
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	sign, err := signature.Sign(vote.Hash())
+	assert.Nil(t, err)
+	vote.SetSignature(addr, keystore.SECP256K1, sign)
+	return vote
+}
+
+func TestGadget(t *testing.T) {
+	keydir, err := ioutil.TempDir("", "keydir")
+	assert.Nil(t, err)
+	defer os.RemoveAll(keydir)
+
+	addrs := make([]*core.Address, 4)
+	privs := make([]keystore.PrivateKey, 4)
+	dynasty := []string{}
+	for i := range addrs {
+		addrs[i], privs[i] = mockKey()
+		dynasty = append(dynasty, addrs[i].String())
+	}
+	stor, _ := storage.NewMemoryStorage()
+	neb := &mockNeb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 100},
+			Consensus: &corepb.GenesisConsensus{
+				Dpos: &corepb.GenesisConsensusDpos{Dynasty: dynasty, DynastySize: 4},
+			},
+		},
+		config: &nebletpb.Config{Chain: &nebletpb.ChainConfig{
+			ChainId: 100,
+			Keydir:  keydir,
+			Miner:   addrs[0].String(),
+		}},
+		storage:   stor,
+		emitter:   core.NewEventEmitter(1024),
+		ns:        &mockNetService{},
+		consensus: &mockConsensus{enable: true},
+	}
+	neb.am = account.NewManager(neb)
+	neb.chain, err = core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain.BlockPool().RegisterInNetwork(neb.ns)
+	neb.chain.SetConsensusHandler(neb.consensus)
+	gadget, err := NewGadget(neb)
+	assert.Nil(t, err)
+
+	block, err := neb.chain.NewBlock(addrs[1])
+	assert.Nil(t, err)
+	block.SetTimestamp(core.BlockInterval)
+	block.SetMiner(addrs[1])
+	assert.Nil(t, block.Seal())
+	// a block at the same height on a fork
+	other, err := neb.chain.NewBlock(addrs[2])
+	assert.Nil(t, err)
+	other.SetTimestamp(2 * core.BlockInterval)
+	other.SetMiner(addrs[2])
+	assert.Nil(t, other.Seal())
+	assert.Nil(t, neb.chain.BlockPool().Push(other))
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Nil(t, neb.chain.SetTailBlock(block))
+	chainID := neb.chain.ChainID()
+
+	// votes arriving before their block are replayed once it's linked
+	late, err := neb.chain.NewBlockFromParent(addrs[3], neb.chain.GenesisBlock())
+	assert.Nil(t, err)
+	late.SetTimestamp(3 * core.BlockInterval)
+	late.SetMiner(addrs[3])
+	assert.Nil(t, late.Seal())
+	assert.Equal(t, ErrVotedBlockNotFound, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_PREVOTE, chainID, late), addrs[3], privs[3])))
+	gadget.replayPending()
+	assert.Equal(t, 1, gadget.pendingVotes)
+	assert.Nil(t, neb.chain.BlockPool().Push(late))
+	gadget.replayPending()
+	assert.Equal(t, 0, gadget.pendingVotes)
+	assert.Equal(t, 1, len(gadget.tallies[late.Hash().Hex()].prevotes))
+
+	// the local member prevotes the new tail
+	gadget.onNewTail()
+	assert.Equal(t, 1, len(neb.ns.broadcasted))
+	assert.Equal(t, finalitypb.VoteType_PREVOTE, neb.ns.broadcasted[0].Type())
+	assert.Nil(t, neb.ns.broadcasted[0].Verify())
+	// and only once for a height
+	gadget.onNewTail()
+	assert.Equal(t, 1, len(neb.ns.broadcasted))
+
+	// votes of non-members and forged votes are rejected
+	outsider, outsiderKey := mockKey()
+	assert.Equal(t, ErrVoterNotInDynasty, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_PREVOTE, chainID, block), outsider, outsiderKey)))
+	forged := signVote(t, NewVote(finalitypb.VoteType_PREVOTE, chainID, block), addrs[2], privs[1])
+	assert.Equal(t, ErrInvalidVoteSignature, gadget.onVote(forged))
+	assert.Equal(t, ErrInvalidVoteChainID, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_PREVOTE, chainID+1, block), addrs[1], privs[1])))
+
+	// 2/3+1 prevotes, the local member commits
+	assert.Nil(t, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_PREVOTE, chainID, block), addrs[1], privs[1])))
+	assert.Equal(t, 1, len(neb.ns.broadcasted))
+	// a second prevote of the member at the height is dropped
+	assert.Equal(t, ErrConflictingVote, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_PREVOTE, chainID, other), addrs[1], privs[1])))
+	_, ok := gadget.tallies[other.Hash().Hex()]
+	assert.False(t, ok)
+	assert.Nil(t, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_PREVOTE, chainID, block), addrs[2], privs[2])))
+	assert.Equal(t, 2, len(neb.ns.broadcasted))
+	assert.Equal(t, finalitypb.VoteType_COMMIT, neb.ns.broadcasted[1].Type())
+
+	// 2/3+1 commits, the block is final
+	assert.Nil(t, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_COMMIT, chainID, block), addrs[1], privs[1])))
+	assert.Equal(t, neb.chain.GenesisBlock().Hash(), neb.chain.LatestIrreversibleBlock().Hash())
+	_, err = gadget.Certificate(nil)
+	assert.Equal(t, ErrCertificateNotFound, err)
+	assert.Nil(t, gadget.onVote(signVote(t, NewVote(finalitypb.VoteType_COMMIT, chainID, block), addrs[3], privs[3])))
+	assert.Equal(t, block.Hash(), neb.chain.LatestIrreversibleBlock().Hash())
+
+	cert, err := gadget.Certificate(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, block.Height(), cert.Height())
+	assert.Equal(t, block.Hash(), cert.BlockHash())
+	assert.Equal(t, 3, len(cert.Commits()))
+	cert, err = gadget.Certificate(block.Hash())
+	assert.Nil(t, err)
+	members := []byteutils.Hash{addrs[0].Bytes(), addrs[1].Bytes(), addrs[2].Bytes(), addrs[3].Bytes()}
+	assert.Nil(t, cert.Verify(chainID, members))
+
+	// certificates are checked against the dynasty
+	assert.Equal(t, ErrVoterNotInDynasty, cert.Verify(chainID, members[1:]))
+	cert.commits = cert.commits[1:]
+	assert.Equal(t, ErrNotEnoughCommits, cert.Verify(chainID, members))
+	cert.commits = append(cert.commits, cert.commits[0])
+	assert.Equal(t, ErrDuplicatedCommit, cert.Verify(chainID, members))
+	cert.commits[0] = NewVote(finalitypb.VoteType_PREVOTE, chainID, block)
+	assert.Equal(t, ErrCommitNotMatch, cert.Verify(chainID, members))
+
+	// the last votes of the local voter survive a restart
+	restarted, err := NewGadget(neb)
+	assert.Nil(t, err)
+	assert.Equal(t, block.Height(), restarted.lastPrevote)
+	assert.Equal(t, block.Height(), restarted.lastCommit)
+}
@@ -0,0 +1,500 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/consensus/finality/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MessageTypeVote is the net message of prevotes and commits.
+const MessageTypeVote = "finvote"
+
+// MaxPendingVotes is the max count of votes kept for blocks not received yet.
+const MaxPendingVotes = 1024
+
+// storage: key -> value
+// finality_cert_ + block hash -> commit certificate
+// finality_latest -> hash of the latest finalized block
+// finality_last_prevote -> height of the last prevote of the local voter
+// finality_last_commit -> height of the last commit of the local voter
+const (
+	certificatePrefix = "finality_cert_"
+	latestKey         = "finality_latest"
+	lastPrevoteKey    = "finality_last_prevote"
+	lastCommitKey     = "finality_last_commit"
+)
+
+func certificateKey(hash byteutils.Hash) []byte {
+	return append([]byte(certificatePrefix), hash...)
+}
+
+// Neblet interface breaks cycle import dependency and hides unused services.
+type Neblet interface {
+	Config() *nebletpb.Config
+	BlockChain() *core.BlockChain
+	NetService() net.Service
+	AccountManager() *account.Manager
+	EventEmitter() *core.EventEmitter
+	Consensus() consensus.Consensus
+}
+
+type voteKey struct {
+	voter    byteutils.HexHash
+	voteType finalitypb.VoteType
+	height   uint64
+}
+
+type tally struct {
+	height    uint64
+	prevotes  map[byteutils.HexHash]*Vote
+	commits   map[byteutils.HexHash]*Vote
+	committed bool
+}
+
+// Gadget is the finality layer on top of the consensus. Dynasty members prevote the
+// tail block, commit a block once 2/3+1 members prevoted it, and the block becomes
+// final once 2/3+1 members committed it. The commits are kept as the certificate
+// which proves the block is irreversible.
+//
+// Two votes of a member of the same type for different blocks at a height are logged
+// and dropped, the first one is counted. Neither they nor a commit against a block the
+// member committed before are turned into evidence yet, so members are not slashed for
+// them, only the external signer keeps its own validator from signing conflicting votes.
+type Gadget struct {
+	quitCh chan bool
+
+	neb     Neblet
+	chain   *core.BlockChain
+	ns      net.Service
+	am      *account.Manager
+	emitter *core.EventEmitter
+
+	voter *core.Address
+
+	chainEvents *core.EventSubscriber
+	receiveCh   chan net.Message
+
+	dynasties *lru.Cache
+	tallies   map[byteutils.HexHash]*tally
+	votes     map[voteKey]*Vote
+
+	// votes for blocks not received yet, replayed once the blocks are linked
+	pending      map[byteutils.HexHash][]*Vote
+	pendingVotes int
+
+	// the heights last voted by the local voter, persisted so a restart can't vote below them
+	lastPrevote uint64
+	lastCommit  uint64
+}
+
+// NewGadget create Gadget instance.
+func NewGadget(neb Neblet) (*Gadget, error) {
+	voter, err := core.AddressParse(neb.Config().Chain.Miner)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"address": neb.Config().Chain.Miner,
+			"err":     err,
+		}).Error("Failed to parse miner address.")
+		return nil, err
+	}
+	dynasties, err := lru.New(128)
+	if err != nil {
+		return nil, err
+	}
+	stor := neb.BlockChain().Storage()
+	lastPrevote, err := loadHeight(stor, lastPrevoteKey)
+	if err != nil {
+		return nil, err
+	}
+	lastCommit, err := loadHeight(stor, lastCommitKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Gadget{
+		quitCh:      make(chan bool, 1),
+		neb:         neb,
+		chain:       neb.BlockChain(),
+		ns:          neb.NetService(),
+		am:          neb.AccountManager(),
+		emitter:     neb.EventEmitter(),
+		voter:       voter,
+		chainEvents: core.NewEventSubscriber(1024, []string{core.TopicLinkBlock}),
+		receiveCh:   make(chan net.Message, 1024),
+		dynasties:   dynasties,
+		tallies:     make(map[byteutils.HexHash]*tally),
+		votes:       make(map[voteKey]*Vote),
+		pending:     make(map[byteutils.HexHash][]*Vote),
+		lastPrevote: lastPrevote,
+		lastCommit:  lastCommit,
+	}, nil
+}
+
+func loadHeight(stor storage.Storage, key string) (uint64, error) {
+	value, err := stor.Get([]byte(key))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// Start start finality service.
+func (g *Gadget) Start() {
+	logging.CLog().Info("Starting Finality...")
+	g.ns.Register(net.NewSubscriber(g, g.receiveCh, true, MessageTypeVote, net.MessageWeightZero))
+	g.emitter.Register(g.chainEvents)
+	go g.loop()
+}
+
+// Stop stop finality service.
+func (g *Gadget) Stop() {
+	logging.CLog().Info("Stopping Finality...")
+	g.emitter.Deregister(g.chainEvents)
+	g.ns.Deregister(net.NewSubscriber(g, g.receiveCh, true, MessageTypeVote, net.MessageWeightZero))
+	g.quitCh <- true
+}
+
+func (g *Gadget) loop() {
+	logging.CLog().Info("Started Finality.")
+	for {
+		select {
+		case <-g.chainEvents.EventChan():
+			g.replayPending()
+			g.onNewTail()
+		case msg := <-g.receiveCh:
+			vote, err := parseVote(msg)
+			if err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"msg": msg,
+					"err": err,
+				}).Debug("Failed to parse vote.")
+				continue
+			}
+			if err := g.onVote(vote); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"vote": vote,
+					"err":  err,
+				}).Debug("Failed to handle vote.")
+				continue
+			}
+			g.ns.Relay(MessageTypeVote, vote, net.MessagePriorityHigh)
+		case <-g.quitCh:
+			logging.CLog().Info("Stopped Finality.")
+			return
+		}
+	}
+}
+
+func parseVote(msg net.Message) (*Vote, error) {
+	pbVote := new(finalitypb.Vote)
+	if err := proto.Unmarshal(msg.Data(), pbVote); err != nil {
+		return nil, err
+	}
+	vote := new(Vote)
+	if err := vote.FromProto(pbVote); err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+// dynasty returns the members who vote for the block.
+func (g *Gadget) dynasty(block *core.Block) ([]byteutils.Hash, error) {
+	if v, ok := g.dynasties.Get(block.Hash().Hex()); ok {
+		return v.([]byteutils.Hash), nil
+	}
+	context, err := block.NextDynastyContext(g.chain, 0)
+	if err != nil {
+		return nil, err
+	}
+	members, err := core.TraverseDynasty(context.DynastyTrie)
+	if err != nil {
+		return nil, err
+	}
+	g.dynasties.Add(block.Hash().Hex(), members)
+	return members, nil
+}
+
+func (g *Gadget) isMember(block *core.Block, addr *core.Address) (bool, error) {
+	members, err := g.dynasty(block)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		if member.Equals(addr.Bytes()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// vote signs and broadcasts a vote of the local voter, the vote is counted locally as well.
+func (g *Gadget) vote(voteType finalitypb.VoteType, block *core.Block) {
+	if !g.neb.Consensus().Enable() {
+		return
+	}
+	if ok, err := g.isMember(block, g.voter); err != nil || !ok {
+		return
+	}
+
+	vote := NewVote(voteType, g.chain.ChainID(), block)
+	alg, sign, err := g.am.SignVote(g.voter, int32(voteType), vote.chainID, vote.height, vote.hash)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"vote": vote,
+			"err":  err,
+		}).Error("Failed to sign vote.")
+		return
+	}
+	vote.SetSignature(g.voter, alg, sign)
+
+	key, last := lastPrevoteKey, &g.lastPrevote
+	if voteType == finalitypb.VoteType_COMMIT {
+		key, last = lastCommitKey, &g.lastCommit
+	}
+	if err := g.chain.Storage().Put([]byte(key), byteutils.FromUint64(block.Height())); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"vote": vote,
+			"err":  err,
+		}).Error("Failed to store the last vote.")
+		return
+	}
+	*last = block.Height()
+
+	g.ns.Broadcast(MessageTypeVote, vote, net.MessagePriorityHigh)
+	if err := g.onVote(vote); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"vote": vote,
+			"err":  err,
+		}).Debug("Failed to handle local vote.")
+	}
+}
+
+func (g *Gadget) onNewTail() {
+	tail := g.chain.TailBlock()
+	if tail.Height() <= g.lastPrevote || tail.Height() <= g.chain.LatestIrreversibleBlock().Height() {
+		return
+	}
+	g.vote(finalitypb.VoteType_PREVOTE, tail)
+}
+
+func (g *Gadget) onVote(vote *Vote) error {
+	if vote.chainID != g.chain.ChainID() {
+		return ErrInvalidVoteChainID
+	}
+	if vote.height <= g.chain.LatestIrreversibleBlock().Height() {
+		return nil
+	}
+	if err := vote.Verify(); err != nil {
+		return err
+	}
+	block := g.chain.GetBlock(vote.hash)
+	if block == nil {
+		g.queue(vote)
+		return ErrVotedBlockNotFound
+	}
+	ok, err := g.isMember(block, vote.voter)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVoterNotInDynasty
+	}
+	members, err := g.dynasty(block)
+	if err != nil {
+		return err
+	}
+
+	voter := byteutils.Hash(vote.voter.Bytes()).Hex()
+	vk := voteKey{voter: voter, voteType: vote.voteType, height: vote.height}
+	if first, ok := g.votes[vk]; ok {
+		if !first.hash.Equals(vote.hash) {
+			logging.VLog().WithFields(logrus.Fields{
+				"first":  first,
+				"second": vote,
+			}).Warn("Found conflicting votes of a member.")
+			return ErrConflictingVote
+		}
+	} else {
+		g.votes[vk] = vote
+	}
+
+	key := vote.hash.Hex()
+	t, ok := g.tallies[key]
+	if !ok {
+		t = &tally{
+			height:   vote.height,
+			prevotes: make(map[byteutils.HexHash]*Vote),
+			commits:  make(map[byteutils.HexHash]*Vote),
+		}
+		g.tallies[key] = t
+	}
+	switch vote.voteType {
+	case finalitypb.VoteType_PREVOTE:
+		t.prevotes[voter] = vote
+	case finalitypb.VoteType_COMMIT:
+		t.commits[voter] = vote
+	}
+
+	quorum := Quorum(len(members))
+	if len(t.prevotes) >= quorum && !t.committed && vote.height > g.lastCommit {
+		canonical := g.chain.GetBlockOnCanonicalChainByHeight(vote.height)
+		if canonical != nil && canonical.Hash().Equals(vote.hash) {
+			t.committed = true
+			g.vote(finalitypb.VoteType_COMMIT, block)
+		}
+	}
+	if len(t.commits) >= quorum {
+		return g.finalize(block, t)
+	}
+	return nil
+}
+
+// queue keeps the vote until its block is linked, votes may arrive before the block.
+func (g *Gadget) queue(vote *Vote) {
+	if g.pendingVotes >= MaxPendingVotes {
+		return
+	}
+	key := vote.hash.Hex()
+	g.pending[key] = append(g.pending[key], vote)
+	g.pendingVotes++
+}
+
+// replayPending handles the queued votes of the blocks linked since they arrived,
+// the votes at irreversible heights are dropped.
+func (g *Gadget) replayPending() {
+	lib := g.chain.LatestIrreversibleBlock().Height()
+	for key, votes := range g.pending {
+		if votes[0].height <= lib {
+			delete(g.pending, key)
+			g.pendingVotes -= len(votes)
+			continue
+		}
+		if g.chain.GetBlock(votes[0].hash) == nil {
+			continue
+		}
+		delete(g.pending, key)
+		g.pendingVotes -= len(votes)
+		for _, vote := range votes {
+			if err := g.onVote(vote); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"vote": vote,
+					"err":  err,
+				}).Debug("Failed to handle pending vote.")
+				continue
+			}
+			g.ns.Relay(MessageTypeVote, vote, net.MessagePriorityHigh)
+		}
+	}
+}
+
+func (g *Gadget) finalize(block *core.Block, t *tally) error {
+	if block.Height() <= g.chain.LatestIrreversibleBlock().Height() {
+		return nil
+	}
+	cert := &Certificate{
+		height: block.Height(),
+		hash:   block.Hash(),
+	}
+	for _, commit := range t.commits {
+		cert.commits = append(cert.commits, commit)
+	}
+	sort.Slice(cert.commits, func(i, j int) bool {
+		return bytes.Compare(cert.commits[i].voter.Bytes(), cert.commits[j].voter.Bytes()) < 0
+	})
+
+	if err := g.storeCertificate(cert); err != nil {
+		return err
+	}
+	if err := g.chain.FinalizeBlock(block); err != nil {
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"block":   block,
+		"commits": len(cert.commits),
+	}).Info("Block is finalized.")
+
+	for key, v := range g.tallies {
+		if v.height <= block.Height() {
+			delete(g.tallies, key)
+		}
+	}
+	for key := range g.votes {
+		if key.height <= block.Height() {
+			delete(g.votes, key)
+		}
+	}
+	return nil
+}
+
+func (g *Gadget) storeCertificate(cert *Certificate) error {
+	pbCert, err := cert.ToProto()
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(pbCert)
+	if err != nil {
+		return err
+	}
+	stor := g.chain.Storage()
+	if err := stor.Put(certificateKey(cert.hash), data); err != nil {
+		return err
+	}
+	return stor.Put([]byte(latestKey), cert.hash)
+}
+
+// Certificate returns the commit certificate of the block, the latest one if hash is empty.
+func (g *Gadget) Certificate(hash byteutils.Hash) (*Certificate, error) {
+	stor := g.chain.Storage()
+	if len(hash) == 0 {
+		latest, err := stor.Get([]byte(latestKey))
+		if err != nil {
+			return nil, ErrCertificateNotFound
+		}
+		hash = latest
+	}
+	data, err := stor.Get(certificateKey(hash))
+	if err != nil {
+		return nil, ErrCertificateNotFound
+	}
+	pbCert := new(finalitypb.Certificate)
+	if err := proto.Unmarshal(data, pbCert); err != nil {
+		return nil, err
+	}
+	cert := new(Certificate)
+	if err := cert.FromProto(pbCert); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finality.proto
+
+/*
+Package finalitypb is a generated protocol buffer package.
+
+It is generated from these files:
+	finality.proto
+
+It has these top-level messages:
+	Vote
+	Certificate
+*/
+package finalitypb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type VoteType int32
+
+const (
+	VoteType_PREVOTE VoteType = 0
+	VoteType_COMMIT  VoteType = 1
+)
+
+var VoteType_name = map[int32]string{
+	0: "PREVOTE",
+	1: "COMMIT",
+}
+var VoteType_value = map[string]int32{
+	"PREVOTE": 0,
+	"COMMIT":  1,
+}
+
+func (x VoteType) String() string {
+	return proto.EnumName(VoteType_name, int32(x))
+}
+func (VoteType) EnumDescriptor() ([]byte, []int) { return fileDescriptorFinality, []int{0} }
+
+// Vote is a signed prevote or commit of a dynasty member for a block.
+type Vote struct {
+	Type    VoteType `protobuf:"varint,1,opt,name=type,proto3,enum=finalitypb.VoteType" json:"type,omitempty"`
+	ChainId uint32   `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height  uint64   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Hash    []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Voter   []byte   `protobuf:"bytes,5,opt,name=voter,proto3" json:"voter,omitempty"`
+	Alg     uint32   `protobuf:"varint,6,opt,name=alg,proto3" json:"alg,omitempty"`
+	Sign    []byte   `protobuf:"bytes,7,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (m *Vote) Reset()                    { *m = Vote{} }
+func (m *Vote) String() string            { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()               {}
+func (*Vote) Descriptor() ([]byte, []int) { return fileDescriptorFinality, []int{0} }
+
+func (m *Vote) GetType() VoteType {
+	if m != nil {
+		return m.Type
+	}
+	return VoteType_PREVOTE
+}
+
+func (m *Vote) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *Vote) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Vote) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Vote) GetVoter() []byte {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
+}
+
+func (m *Vote) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *Vote) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+// Certificate is the aggregate commits which finalize a block.
+type Certificate struct {
+	Height  uint64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash    []byte  `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Commits []*Vote `protobuf:"bytes,3,rep,name=commits" json:"commits,omitempty"`
+}
+
+func (m *Certificate) Reset()                    { *m = Certificate{} }
+func (m *Certificate) String() string            { return proto.CompactTextString(m) }
+func (*Certificate) ProtoMessage()               {}
+func (*Certificate) Descriptor() ([]byte, []int) { return fileDescriptorFinality, []int{1} }
+
+func (m *Certificate) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Certificate) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Certificate) GetCommits() []*Vote {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Vote)(nil), "finalitypb.Vote")
+	proto.RegisterType((*Certificate)(nil), "finalitypb.Certificate")
+	proto.RegisterEnum("finalitypb.VoteType", VoteType_name, VoteType_value)
+}
+
+func init() { proto.RegisterFile("finality.proto", fileDescriptorFinality) }
+
+var fileDescriptorFinality = []byte{
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x6b, 0xc2, 0x30,
+	0x1c, 0xc5, 0x17, 0x1b, 0x5b, 0xf9, 0xbb, 0x49, 0xf9, 0x23, 0x23, 0xbb, 0x05, 0x77, 0x09, 0x1e,
+	0x7a, 0x70, 0x1f, 0x41, 0x3c, 0x78, 0x10, 0x47, 0x28, 0x5e, 0x47, 0xac, 0xb1, 0x09, 0x68, 0x53,
+	0xda, 0x30, 0xe8, 0xe7, 0xda, 0x17, 0x1c, 0x8d, 0x2b, 0x93, 0xb1, 0xdb, 0xfb, 0x25, 0xef, 0xe5,
+	0xf1, 0x02, 0xb3, 0xb3, 0xad, 0xd4, 0xc5, 0xfa, 0x2e, 0xab, 0x1b, 0xe7, 0x1d, 0xc2, 0xc0, 0xf5,
+	0x71, 0xf1, 0x45, 0x80, 0x1e, 0x9c, 0xd7, 0x28, 0x80, 0xfa, 0xae, 0xd6, 0x8c, 0x70, 0x22, 0x66,
+	0xab, 0x79, 0xf6, 0xeb, 0xc9, 0xfa, 0xfb, 0xbc, 0xab, 0xb5, 0x0c, 0x0e, 0x7c, 0x81, 0x49, 0x61,
+	0x94, 0xad, 0x3e, 0xec, 0x89, 0x8d, 0x38, 0x11, 0x4f, 0x32, 0x09, 0xbc, 0x3d, 0xe1, 0x33, 0xc4,
+	0x46, 0xdb, 0xd2, 0x78, 0x16, 0x71, 0x22, 0xa8, 0xfc, 0x21, 0x44, 0xa0, 0x46, 0xb5, 0x86, 0x51,
+	0x4e, 0xc4, 0xa3, 0x0c, 0x1a, 0xe7, 0x30, 0xfe, 0x74, 0x5e, 0x37, 0x6c, 0x1c, 0x0e, 0x6f, 0x80,
+	0x29, 0x44, 0xea, 0x52, 0xb2, 0x38, 0xbc, 0xdb, 0xcb, 0x3e, 0xdb, 0xda, 0xb2, 0x62, 0xc9, 0x2d,
+	0xdb, 0xeb, 0x85, 0x86, 0xe9, 0x5a, 0x37, 0xde, 0x9e, 0x6d, 0xa1, 0xbc, 0xbe, 0xab, 0x25, 0xff,
+	0xd6, 0x8e, 0xee, 0x6a, 0x97, 0x90, 0x14, 0xee, 0x7a, 0xb5, 0xbe, 0x65, 0x11, 0x8f, 0xc4, 0x74,
+	0x95, 0xfe, 0x9d, 0x2a, 0x07, 0xc3, 0xf2, 0x15, 0x26, 0xc3, 0x76, 0x9c, 0x42, 0xf2, 0x2e, 0x37,
+	0x87, 0x7d, 0xbe, 0x49, 0x1f, 0x10, 0x20, 0x5e, 0xef, 0x77, 0xbb, 0x6d, 0x9e, 0x92, 0x63, 0x1c,
+	0x3e, 0xf5, 0xed, 0x7b, 0x00, 0x13, 0xa1, 0xd8, 0x4b, 0x66, 0x01, 0x00, 0x00,
+}
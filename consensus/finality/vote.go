@@ -0,0 +1,279 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/account/signer"
+	"github.com/nebulasio/go-nebulas/consensus/finality/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors in finality
+var (
+	ErrInvalidProtoToVote        = errors.New("protobuf message cannot be converted into Vote")
+	ErrInvalidProtoToCertificate = errors.New("protobuf message cannot be converted into Certificate")
+	ErrInvalidVoteSignature      = errors.New("invalid vote signature")
+	ErrInvalidVoteChainID        = errors.New("invalid vote chain id")
+	ErrVotedBlockNotFound        = errors.New("voted block not found")
+	ErrVoterNotInDynasty         = errors.New("voter is not in the dynasty of the block")
+	ErrDuplicatedCommit          = errors.New("duplicated commit in certificate")
+	ErrCommitNotMatch            = errors.New("commit doesn't match the certificate")
+	ErrNotEnoughCommits          = errors.New("not enough commits in certificate")
+	ErrCertificateNotFound       = errors.New("commit certificate not found")
+	ErrConflictingVote           = errors.New("voter signed a different block of the same type at the height")
+)
+
+// Quorum returns the count of votes needed to pass in a dynasty of n members.
+func Quorum(n int) int {
+	return n*2/3 + 1
+}
+
+// Vote is a signed prevote or commit of a dynasty member for a block.
+type Vote struct {
+	voteType finalitypb.VoteType
+	chainID  uint32
+	height   uint64
+	hash     byteutils.Hash
+
+	voter *core.Address
+	alg   keystore.Algorithm
+	sign  byteutils.Hash
+}
+
+// NewVote returns an unsigned vote for the block.
+func NewVote(voteType finalitypb.VoteType, chainID uint32, block *core.Block) *Vote {
+	return &Vote{
+		voteType: voteType,
+		chainID:  chainID,
+		height:   block.Height(),
+		hash:     block.Hash(),
+	}
+}
+
+// Type returns the vote type.
+func (v *Vote) Type() finalitypb.VoteType {
+	return v.voteType
+}
+
+// Height returns the height of the voted block.
+func (v *Vote) Height() uint64 {
+	return v.height
+}
+
+// BlockHash returns the hash of the voted block.
+func (v *Vote) BlockHash() byteutils.Hash {
+	return v.hash
+}
+
+// Voter returns the voter.
+func (v *Vote) Voter() *core.Address {
+	return v.voter
+}
+
+// Alg returns the signature algorithm.
+func (v *Vote) Alg() keystore.Algorithm {
+	return v.alg
+}
+
+// Signature returns the signature.
+func (v *Vote) Signature() byteutils.Hash {
+	return v.sign
+}
+
+// Hash returns the hash signed by the voter.
+func (v *Vote) Hash() byteutils.Hash {
+	return signer.VoteHash(int32(v.voteType), v.chainID, v.height, v.hash)
+}
+
+// SetSignature sets the voter and the signature of the vote.
+func (v *Vote) SetSignature(voter *core.Address, alg keystore.Algorithm, sign byteutils.Hash) {
+	v.voter = voter
+	v.alg = alg
+	v.sign = sign
+}
+
+// Verify checks the vote is signed by the voter.
+func (v *Vote) Verify() error {
+	if v.voter == nil {
+		return ErrInvalidVoteSignature
+	}
+	signature, err := crypto.NewSignature(v.alg)
+	if err != nil {
+		return err
+	}
+	pub, err := signature.RecoverPublic(v.Hash(), v.sign)
+	if err != nil {
+		return err
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return err
+	}
+	signer, err := core.NewAddressFromPublicKey(pubdata)
+	if err != nil {
+		return err
+	}
+	if !signer.Equals(v.voter) {
+		return ErrInvalidVoteSignature
+	}
+	return nil
+}
+
+// ToProto converts domain Vote into proto Vote
+func (v *Vote) ToProto() (proto.Message, error) {
+	var voter []byte
+	if v.voter != nil {
+		voter = v.voter.Bytes()
+	}
+	return &finalitypb.Vote{
+		Type:    v.voteType,
+		ChainId: v.chainID,
+		Height:  v.height,
+		Hash:    v.hash,
+		Voter:   voter,
+		Alg:     uint32(v.alg),
+		Sign:    v.sign,
+	}, nil
+}
+
+// FromProto converts proto Vote into domain Vote
+func (v *Vote) FromProto(msg proto.Message) error {
+	msgVote, ok := msg.(*finalitypb.Vote)
+	if !ok {
+		return ErrInvalidProtoToVote
+	}
+	voter, err := core.AddressParseFromBytes(msgVote.Voter)
+	if err != nil {
+		return err
+	}
+	v.voteType = msgVote.Type
+	v.chainID = msgVote.ChainId
+	v.height = msgVote.Height
+	v.hash = msgVote.Hash
+	v.voter = voter
+	v.alg = keystore.Algorithm(msgVote.Alg)
+	v.sign = msgVote.Sign
+	return nil
+}
+
+func (v *Vote) String() string {
+	voter := ""
+	if v.voter != nil {
+		voter = v.voter.String()
+	}
+	return fmt.Sprintf(`{"type":"%s", "height":%d, "hash":"%s", "voter":"%s"}`,
+		v.voteType, v.height, v.hash, voter)
+}
+
+// Certificate is the aggregate commits which finalize a block.
+type Certificate struct {
+	height  uint64
+	hash    byteutils.Hash
+	commits []*Vote
+}
+
+// Height returns the height of the finalized block.
+func (c *Certificate) Height() uint64 {
+	return c.height
+}
+
+// BlockHash returns the hash of the finalized block.
+func (c *Certificate) BlockHash() byteutils.Hash {
+	return c.hash
+}
+
+// Commits returns the commits in the certificate.
+func (c *Certificate) Commits() []*Vote {
+	return c.commits
+}
+
+// Verify checks the certificate carries enough valid commits of the dynasty members.
+func (c *Certificate) Verify(chainID uint32, dynasty []byteutils.Hash) error {
+	members := make(map[byteutils.HexHash]bool)
+	for _, member := range dynasty {
+		members[member.Hex()] = true
+	}
+	voted := make(map[byteutils.HexHash]bool)
+	for _, commit := range c.commits {
+		if commit.voteType != finalitypb.VoteType_COMMIT || commit.height != c.height || !commit.hash.Equals(c.hash) {
+			return ErrCommitNotMatch
+		}
+		if commit.chainID != chainID {
+			return ErrInvalidVoteChainID
+		}
+		if err := commit.Verify(); err != nil {
+			return err
+		}
+		voter := byteutils.Hash(commit.voter.Bytes()).Hex()
+		if !members[voter] {
+			return ErrVoterNotInDynasty
+		}
+		if voted[voter] {
+			return ErrDuplicatedCommit
+		}
+		voted[voter] = true
+	}
+	if len(voted) < Quorum(len(dynasty)) {
+		return ErrNotEnoughCommits
+	}
+	return nil
+}
+
+// ToProto converts domain Certificate into proto Certificate
+func (c *Certificate) ToProto() (proto.Message, error) {
+	commits := make([]*finalitypb.Vote, len(c.commits))
+	for i, commit := range c.commits {
+		msg, err := commit.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		commits[i] = msg.(*finalitypb.Vote)
+	}
+	return &finalitypb.Certificate{
+		Height:  c.height,
+		Hash:    c.hash,
+		Commits: commits,
+	}, nil
+}
+
+// FromProto converts proto Certificate into domain Certificate
+func (c *Certificate) FromProto(msg proto.Message) error {
+	msgCert, ok := msg.(*finalitypb.Certificate)
+	if !ok {
+		return ErrInvalidProtoToCertificate
+	}
+	c.height = msgCert.Height
+	c.hash = msgCert.Hash
+	c.commits = make([]*Vote, len(msgCert.Commits))
+	for i, v := range msgCert.Commits {
+		commit := new(Vote)
+		if err := commit.FromProto(v); err != nil {
+			return err
+		}
+		c.commits[i] = commit
+	}
+	return nil
+}
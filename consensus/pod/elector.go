@@ -0,0 +1,81 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"math/big"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/nr"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Elector tallies candidates by their nebulas rank in the blocks before the election.
+type Elector struct {
+	chain  *core.BlockChain
+	window int
+
+	scores *lru.Cache // base block hash -> nr scores
+}
+
+// NewElector create a new Elector computing nebulas rank in window blocks.
+func NewElector(chain *core.BlockChain, window int) *Elector {
+	scores, _ := lru.New(16)
+	return &Elector{
+		chain:  chain,
+		window: window,
+		scores: scores,
+	}
+}
+
+func (e *Elector) rank(base *core.Block) (map[string]*util.Uint128, error) {
+	if v, ok := e.scores.Get(base.Hash().Hex()); ok {
+		return v.(map[string]*util.Uint128), nil
+	}
+	graph, err := nr.CollectGraph(e.chain, base, e.window)
+	if err != nil {
+		return nil, err
+	}
+	scores := nr.Rank(graph)
+	e.scores.Add(base.Hash().Hex(), scores)
+	return scores, nil
+}
+
+// Tally implements core.DynastyElector, the weight of a candidate is its nebulas rank.
+func (e *Elector) Tally(context *core.DynastyContext) (map[string]*util.Uint128, error) {
+	scores, err := e.rank(context.Base)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := core.TraverseCandidates(context.CandidateTrie)
+	if err != nil {
+		return nil, err
+	}
+	votes := make(map[string]*util.Uint128)
+	for _, candidate := range candidates {
+		addr := candidate.Address.String()
+		votes[addr] = util.NewUint128()
+		if score, ok := scores[addr]; ok {
+			votes[addr] = util.NewUint128FromBigInt(new(big.Int).Set(score.Int))
+		}
+	}
+	return votes, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Neblet interface breaks cycle import dependency and hides unused services.
+type Neblet interface {
+	dpos.Neblet
+	Genesis() *corepb.Genesis
+}
+
+// Pod Proof-of-Devotion, proposers mint blocks in their slots as in dpos,
+// but dynasties are elected by the nebulas rank of candidates instead of votes.
+type Pod struct {
+	*dpos.Dpos
+
+	elector *Elector
+}
+
+// NewPod create Pod instance.
+func NewPod(neblet Neblet) (*Pod, error) {
+	d, err := dpos.NewDpos(neblet)
+	if err != nil {
+		return nil, err
+	}
+
+	window := int(nr.GenesisWindow(neblet.Genesis()))
+	elector := NewElector(neblet.BlockChain(), window)
+	neblet.BlockChain().SetDynastyElector(elector)
+
+	logging.CLog().WithFields(logrus.Fields{
+		"nr.window": window,
+	}).Info("Dynasties are elected by nebulas rank.")
+
+	return &Pod{
+		Dpos:    d,
+		elector: elector,
+	}, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockNeb struct {
+	genesis *corepb.Genesis
+	config  *nebletpb.Config
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *mockNeb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *mockNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *mockNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+var dynasty = []string{
+	"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+	"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+	"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+	"48f981ed38910f1232c1bab124f650c482a57271632db9e3",
+	"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232",
+	"75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+}
+
+func mockAddress() *core.Address {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	addr, _ := core.NewAddressFromPublicKey(pubdata)
+	ks.SetKey(addr.String(), priv, []byte("passphrase"))
+	ks.Unlock(addr.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	return addr
+}
+
+func TestElector_Tally(t *testing.T) {
+	addrs := []*core.Address{mockAddress(), mockAddress(), mockAddress(), mockAddress()}
+	genesis := &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: 100},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{Dynasty: dynasty},
+			Pod:  &corepb.GenesisConsensusPod{NrWindow: 10},
+		},
+	}
+	for _, addr := range addrs {
+		genesis.TokenDistribution = append(genesis.TokenDistribution, &corepb.GenesisTokenDistribution{
+			Address: addr.String(),
+			Value:   "10000000000000000000000",
+		})
+	}
+	stor, _ := storage.NewMemoryStorage()
+	neb := &mockNeb{
+		genesis: genesis,
+		config:  &nebletpb.Config{Chain: &nebletpb.ChainConfig{ChainId: 100}},
+		storage: stor,
+		emitter: core.NewEventEmitter(1024),
+	}
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	transfers := []struct {
+		from, to int
+		value    int64
+		nonce    uint64
+	}{
+		{0, 1, 400, 1},
+		{1, 2, 100, 1},
+		{1, 0, 100, 2},
+		{2, 0, 100, 1},
+	}
+	for _, v := range transfers {
+		tx := core.NewTransaction(chain.ChainID(), addrs[v.from], addrs[v.to], util.NewUint128FromInt(v.value), v.nonce, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, util.NewUint128FromInt(200000))
+		key, _ := keystore.DefaultKS.GetUnlocked(addrs[v.from].String())
+		signature, _ := crypto.NewSignature(keystore.SECP256K1)
+		signature.InitSign(key.(keystore.PrivateKey))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, chain.TransactionPool().Push(tx))
+	}
+	block, err := core.NewBlock(chain.ChainID(), addrs[0], chain.TailBlock())
+	assert.Nil(t, err)
+	block.CollectTransactions(time.Now().Unix() + 1)
+	assert.Equal(t, len(transfers), len(block.Transactions()))
+
+	candidates, _ := trie.NewBatchTrie(nil, stor)
+	for _, addr := range addrs {
+		candidates.Put(addr.Bytes(), addr.Bytes())
+	}
+	elector := NewElector(chain, 10)
+	votes, err := elector.Tally(&core.DynastyContext{Base: block, CandidateTrie: candidates})
+	assert.Nil(t, err)
+	assert.Equal(t, len(addrs), len(votes))
+	// 3 degrees * sqrt(200) * (1 + log2(~10^22))
+	assert.Equal(t, util.NewUint128FromInt(3150), votes[addrs[0].String()])
+	assert.Equal(t, util.NewUint128FromInt(3150), votes[addrs[1].String()])
+	// 2 degrees * sqrt(100) * (1 + log2(~10^22))
+	assert.Equal(t, util.NewUint128FromInt(1500), votes[addrs[2].String()])
+	// no transfer
+	assert.Equal(t, util.NewUint128(), votes[addrs[3].String()])
+
+	// blocks out of window are not ranked
+	elector = NewElector(chain, 0)
+	votes, err = elector.Tally(&core.DynastyContext{Base: block, CandidateTrie: candidates})
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128(), votes[addrs[0].String()])
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxTransitions is the count of recent transitions kept by a StateMachine.
+const MaxTransitions = 64
+
+// Transition of the State-Machine from a state to another.
+type Transition struct {
+	From      string
+	To        string
+	Event     EventType
+	Timestamp int64
+}
+
+// StateMachine runs the consensus states, events are handled one by one in the
+// machine's loop. Events posted by states are handled right after the current one.
+type StateMachine struct {
+	state State
+
+	posted  []Event
+	eventCh chan Event
+	quitCh  chan bool
+
+	lock        sync.RWMutex
+	transitions []*Transition
+}
+
+// NewStateMachine create a new StateMachine starting from the initial state.
+func NewStateMachine(initial State) *StateMachine {
+	return &StateMachine{
+		state:       initial,
+		posted:      make([]Event, 0),
+		eventCh:     make(chan Event, 1024),
+		quitCh:      make(chan bool, 1),
+		transitions: make([]*Transition, 0),
+	}
+}
+
+// Start start the loop handling events.
+func (m *StateMachine) Start() {
+	go m.loop()
+}
+
+// Stop stop the loop.
+func (m *StateMachine) Stop() {
+	m.quitCh <- true
+}
+
+// Fire sends an event to the machine, it blocks until the machine accepts the event.
+// Ticks are dropped if the machine is busy, the next tick supersedes them.
+func (m *StateMachine) Fire(e Event) {
+	if e.EventType() != TickEvent {
+		m.eventCh <- e
+		return
+	}
+	select {
+	case m.eventCh <- e:
+	default:
+		logging.VLog().WithFields(logrus.Fields{
+			"event": e.EventType(),
+			"state": m.State(),
+		}).Warn("Dropped consensus tick, the state machine is busy.")
+	}
+}
+
+// Post queues an event from the states, only called in the machine's loop.
+func (m *StateMachine) Post(e Event) {
+	m.posted = append(m.posted, e)
+}
+
+// Handle handles the event and the events posted meanwhile.
+func (m *StateMachine) Handle(e Event) {
+	m.handle(e)
+	for len(m.posted) > 0 {
+		e, m.posted = m.posted[0], m.posted[1:]
+		m.handle(e)
+	}
+}
+
+func (m *StateMachine) handle(e Event) {
+	from := m.state
+	ok, to := from.Event(e)
+	if !ok || to == nil || to == from {
+		return
+	}
+
+	from.Leave(e.Data())
+	m.lock.Lock()
+	m.state = to
+	m.transitions = append(m.transitions, &Transition{
+		From:      from.String(),
+		To:        to.String(),
+		Event:     e.EventType(),
+		Timestamp: time.Now().Unix(),
+	})
+	if len(m.transitions) > MaxTransitions {
+		m.transitions = m.transitions[len(m.transitions)-MaxTransitions:]
+	}
+	m.lock.Unlock()
+
+	logging.VLog().WithFields(logrus.Fields{
+		"from":  from,
+		"to":    to,
+		"event": e.EventType(),
+	}).Info("Consensus state transited.")
+	to.Enter(e.Data())
+}
+
+// State returns the name of the current state.
+func (m *StateMachine) State() string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.state.String()
+}
+
+// Transitions returns the recent transitions, the latest is the last.
+func (m *StateMachine) Transitions() []*Transition {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	transitions := make([]*Transition, len(m.transitions))
+	copy(transitions, m.transitions)
+	return transitions
+}
+
+func (m *StateMachine) loop() {
+	for {
+		select {
+		case e := <-m.eventCh:
+			m.Handle(e)
+		case <-m.quitCh:
+			return
+		}
+	}
+}
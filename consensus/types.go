@@ -43,11 +43,28 @@ type Consensus interface {
 	VerifyBlock(block *core.Block, parent *core.Block) error
 	FastVerifyBlock(block *core.Block) error
 	ForkChoice() error
+
+	State() string
+	Transitions() []*Transition
 }
 
 // EventType of Events in Consensus State-Machine
 type EventType string
 
+// EventType of Consensus State-Machine
+const (
+	TickEvent          EventType = "event.tick"
+	NewTailEvent       EventType = "event.newtail"
+	SyncStartEvent     EventType = "event.sync.start"
+	SyncFinishEvent    EventType = "event.sync.finish"
+	EnableMiningEvent  EventType = "event.mining.enable"
+	DisableMiningEvent EventType = "event.mining.disable"
+	MintedEvent        EventType = "event.minted"
+	SlotEvent          EventType = "event.slot"
+	BroadcastedEvent   EventType = "event.broadcasted"
+	FailedEvent        EventType = "event.failed"
+)
+
 // Event in Consensus State-Machine
 type Event interface {
 	EventType() EventType
@@ -59,6 +76,7 @@ type State interface {
 	Event(e Event) (bool, State)
 	Enter(data interface{})
 	Leave(data interface{})
+	String() string
 }
 
 // BaseEvent is a kind of event structure
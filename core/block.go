@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/crypto"
@@ -58,10 +59,13 @@ type BlockHeader struct {
 	parentHash byteutils.Hash
 
 	// world state
-	stateRoot   byteutils.Hash
-	txsRoot     byteutils.Hash
-	eventsRoot  byteutils.Hash
-	dposContext *corepb.DposContext
+	stateRoot    byteutils.Hash
+	txsRoot      byteutils.Hash
+	eventsRoot   byteutils.Hash
+	dposContext  *corepb.DposContext
+	gasUsed      *util.Uint128
+	receiptsRoot byteutils.Hash
+	logsBloom    []byte
 
 	coinbase  *Address
 	nonce     uint64
@@ -75,19 +79,30 @@ type BlockHeader struct {
 
 // ToProto converts domain BlockHeader to proto BlockHeader
 func (b *BlockHeader) ToProto() (proto.Message, error) {
+	var gasUsed []byte
+	if b.gasUsed != nil {
+		bytes, err := b.gasUsed.ToFixedSizeByteSlice()
+		if err != nil {
+			return nil, err
+		}
+		gasUsed = bytes
+	}
 	return &corepb.BlockHeader{
-		Hash:        b.hash,
-		ParentHash:  b.parentHash,
-		StateRoot:   b.stateRoot,
-		TxsRoot:     b.txsRoot,
-		EventsRoot:  b.eventsRoot,
-		DposContext: b.dposContext,
-		Nonce:       b.nonce,
-		Coinbase:    b.coinbase.address,
-		Timestamp:   b.timestamp,
-		ChainId:     b.chainID,
-		Alg:         uint32(b.alg),
-		Sign:        b.sign,
+		Hash:         b.hash,
+		ParentHash:   b.parentHash,
+		StateRoot:    b.stateRoot,
+		TxsRoot:      b.txsRoot,
+		EventsRoot:   b.eventsRoot,
+		DposContext:  b.dposContext,
+		Nonce:        b.nonce,
+		Coinbase:     b.coinbase.address,
+		Timestamp:    b.timestamp,
+		ChainId:      b.chainID,
+		Alg:          uint32(b.alg),
+		Sign:         b.sign,
+		GasUsed:      gasUsed,
+		ReceiptsRoot: b.receiptsRoot,
+		LogsBloom:    b.logsBloom,
 	}, nil
 }
 
@@ -106,6 +121,16 @@ func (b *BlockHeader) FromProto(msg proto.Message) error {
 		b.chainID = msg.ChainId
 		b.alg = uint8(msg.Alg)
 		b.sign = msg.Sign
+		b.receiptsRoot = msg.ReceiptsRoot
+		b.logsBloom = msg.LogsBloom
+		b.gasUsed = nil
+		if len(msg.GasUsed) > 0 {
+			gasUsed, err := util.NewUint128().FromFixedSizeByteSlice(msg.GasUsed)
+			if err != nil {
+				return err
+			}
+			b.gasUsed = gasUsed
+		}
 		return nil
 	}
 	return errors.New("Protobuf message cannot be converted into BlockHeader")
@@ -116,15 +141,21 @@ type Block struct {
 	header       *BlockHeader
 	transactions Transactions
 
-	sealed      bool
-	height      uint64
-	parentBlock *Block
-	accState    state.AccountState
-	txsTrie     *trie.BatchTrie
-	eventsTrie  *trie.BatchTrie
-	dposContext *DposContext
-	txPool      *TransactionPool
-	miner       *Address
+	sealed       bool
+	height       uint64
+	parentBlock  *Block
+	accState     state.AccountState
+	txsTrie      *trie.BatchTrie
+	eventsTrie   *trie.BatchTrie
+	receiptsTrie *trie.BatchTrie
+	dposContext  *DposContext
+	txPool       *TransactionPool
+	miner        *Address
+
+	// gas used and bytes of the txs executed in the block
+	txsGasUsed *util.Uint128
+	txsSize    uint64
+	txsParams  *GovernedParams
 
 	storage      storage.Storage
 	eventEmitter *EventEmitter
@@ -205,6 +236,10 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 	if err != nil {
 		return nil, err
 	}
+	receiptsTrie, err := parent.receiptsTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
 	dposContext, err := parent.dposContext.Clone()
 	if err != nil {
 		return nil, err
@@ -223,16 +258,25 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 		accState:     accState,
 		txsTrie:      txsTrie,
 		eventsTrie:   eventsTrie,
+		receiptsTrie: receiptsTrie,
 		dposContext:  dposContext,
 		txPool:       parent.txPool,
 		height:       parent.height + 1,
 		sealed:       false,
+		txsGasUsed:   util.NewUint128(),
 		storage:      parent.storage,
 		eventEmitter: parent.eventEmitter,
 	}
 
 	block.begin()
-	block.rewardCoinbase()
+	if err := block.rewardCoinbase(); err != nil {
+		block.rollback()
+		return nil, err
+	}
+	if err := block.rewardDevelopers(); err != nil {
+		block.rollback()
+		return nil, err
+	}
 	block.commit()
 
 	return block, nil
@@ -249,6 +293,12 @@ func (block *Block) Sign(signature keystore.Signature) error {
 	return nil
 }
 
+// SetSignature set the signature of the block signed outside, e.g. by an external signer.
+func (block *Block) SetSignature(alg keystore.Algorithm, sign byteutils.Hash) {
+	block.header.alg = uint8(alg)
+	block.header.sign = sign
+}
+
 // ChainID returns block's chainID
 func (block *Block) ChainID() uint32 {
 	return block.header.chainID
@@ -329,6 +379,24 @@ func (block *Block) EventsRoot() byteutils.Hash {
 	return block.header.eventsRoot
 }
 
+// ReceiptsRoot return receipts root hash, empty before ForkReceipts.
+func (block *Block) ReceiptsRoot() byteutils.Hash {
+	return block.header.receiptsRoot
+}
+
+// LogsBloom return the bloom over the event topics and emitting contracts, empty before ForkLogsBloom.
+func (block *Block) LogsBloom() []byte {
+	return block.header.logsBloom
+}
+
+// GasUsed return the gas used by the txs in the block, zero before ForkBlockLimits.
+func (block *Block) GasUsed() *util.Uint128 {
+	if block.header.gasUsed == nil {
+		return util.NewUint128()
+	}
+	return block.header.gasUsed
+}
+
 // DposContext return dpos context
 func (block *Block) DposContext() *corepb.DposContext {
 	return block.header.dposContext
@@ -344,6 +412,7 @@ func (block *Block) DposContextHash() byteutils.Hash {
 	hasher.Write(block.header.dposContext.VoteRoot)
 	hasher.Write(block.header.dposContext.CandidateRoot)
 	hasher.Write(block.header.dposContext.MintCntRoot)
+	hasher.Write(block.header.dposContext.StakeRoot)
 
 	return hasher.Sum(nil)
 }
@@ -358,6 +427,25 @@ func (block *Block) Height() uint64 {
 	return block.height
 }
 
+// chain returns the chain of the block, the rules of another chain would split the consensus,
+// so a block not linked to a chain must never be executed or verified.
+func (block *Block) chain() *BlockChain {
+	if block.txPool == nil || block.txPool.bc == nil {
+		panic(ErrBlockWithoutChain)
+	}
+	return block.txPool.bc
+}
+
+// ChainRules returns the chain rules at block height
+func (block *Block) ChainRules() *ChainRules {
+	return block.chain().ChainRules(block.height)
+}
+
+// DposParams returns the dpos params of the chain
+func (block *Block) DposParams() *DposParams {
+	return block.chain().DposParams()
+}
+
 // Transactions returns block transactions
 func (block *Block) Transactions() Transactions {
 	return block.transactions
@@ -395,6 +483,9 @@ func (block *Block) LinkParentBlock(chain *BlockChain, parentBlock *Block) error
 	if block.eventsTrie, err = parentBlock.eventsTrie.Clone(); err != nil {
 		return ErrCloneEventsState
 	}
+	if block.receiptsTrie, err = parentBlock.receiptsTrie.Clone(); err != nil {
+		return ErrCloneReceiptsState
+	}
 
 	elapsedSecond := block.Timestamp() - parentBlock.Timestamp()
 	context, err := parentBlock.NextDynastyContext(chain, elapsedSecond)
@@ -419,6 +510,7 @@ func (block *Block) begin() {
 	block.accState.BeginBatch()
 	block.txsTrie.BeginBatch()
 	block.eventsTrie.BeginBatch()
+	block.receiptsTrie.BeginBatch()
 	block.dposContext.BeginBatch()
 }
 
@@ -426,6 +518,7 @@ func (block *Block) commit() {
 	block.accState.Commit()
 	block.txsTrie.Commit()
 	block.eventsTrie.Commit()
+	block.receiptsTrie.Commit()
 	block.dposContext.Commit()
 }
 
@@ -433,6 +526,7 @@ func (block *Block) rollback() {
 	block.accState.RollBack()
 	block.txsTrie.RollBack()
 	block.eventsTrie.RollBack()
+	block.receiptsTrie.RollBack()
 	block.dposContext.RollBack()
 }
 
@@ -617,18 +711,38 @@ func (block *Block) Seal() error {
 
 	block.begin()
 	err := block.recordMintCnt()
+	if err == nil {
+		err = block.releaseUnbondedStakes()
+	}
+	if err == nil {
+		err = block.shareRewards()
+	}
+	if err == nil {
+		err = block.executeGovernance()
+	}
 	if err != nil {
 		block.rollback()
 		return err
 	}
 	block.commit()
 
+	if block.ChainRules().IsBlockLimits {
+		block.header.gasUsed = block.TxsGasUsed()
+	}
 	block.header.stateRoot, err = block.accState.RootHash()
 	if err != nil {
 		return err
 	}
 	block.header.txsRoot = block.txsTrie.RootHash()
 	block.header.eventsRoot = block.eventsTrie.RootHash()
+	block.header.receiptsRoot = block.receiptsTrie.RootHash()
+	if block.ChainRules().IsLogsBloom {
+		bloom, err := block.logsBloom()
+		if err != nil {
+			return err
+		}
+		block.header.logsBloom = bloom.Bytes()
+	}
 	if block.header.dposContext, err = block.dposContext.ToProto(); err != nil {
 		return err
 	}
@@ -814,6 +928,44 @@ func (block *Block) verifyState() error {
 		return ErrInvalidBlockEventsRoot
 	}
 
+	// verify receipts root.
+	if !byteutils.Equal(block.receiptsTrie.RootHash(), block.ReceiptsRoot()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"expect": block.ReceiptsRoot(),
+			"actual": block.receiptsTrie.RootHash(),
+		}).Debug("Failed to verify receipts.")
+		return ErrInvalidBlockReceiptsRoot
+	}
+
+	// verify logs bloom, empty before ForkLogsBloom.
+	var logsBloom []byte
+	if block.ChainRules().IsLogsBloom {
+		bloom, err := block.logsBloom()
+		if err != nil {
+			return err
+		}
+		logsBloom = bloom.Bytes()
+	}
+	if !byteutils.Equal(logsBloom, block.LogsBloom()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+		}).Debug("Failed to verify logs bloom.")
+		return ErrInvalidBlockLogsBloom
+	}
+
+	// verify gas used, zero before ForkBlockLimits.
+	gasUsed := util.NewUint128()
+	if block.ChainRules().IsBlockLimits {
+		gasUsed = block.TxsGasUsed()
+	}
+	if gasUsed.Cmp(block.GasUsed().Int) != 0 {
+		logging.VLog().WithFields(logrus.Fields{
+			"expect": block.GasUsed(),
+			"actual": gasUsed,
+		}).Debug("Failed to verify gas used.")
+		return ErrInvalidBlockGasUsed
+	}
+
 	// verify transaction root.
 	if !byteutils.Equal(block.dposContext.RootHash(), block.DposContextHash()) {
 		logging.VLog().WithFields(logrus.Fields{
@@ -829,7 +981,13 @@ func (block *Block) verifyState() error {
 // Execute block and return result.
 func (block *Block) execute() error {
 	startAt := time.Now().UnixNano()
-	block.rewardCoinbase()
+	block.txsGasUsed, block.txsSize, block.txsParams = util.NewUint128(), 0, nil
+	if err := block.rewardCoinbase(); err != nil {
+		return err
+	}
+	if err := block.rewardDevelopers(); err != nil {
+		return err
+	}
 
 	start := time.Now().UnixNano()
 	for _, tx := range block.transactions {
@@ -858,6 +1016,15 @@ func (block *Block) execute() error {
 	if err := block.recordMintCnt(); err != nil {
 		return err
 	}
+	if err := block.releaseUnbondedStakes(); err != nil {
+		return err
+	}
+	if err := block.shareRewards(); err != nil {
+		return err
+	}
+	if err := block.executeGovernance(); err != nil {
+		return err
+	}
 
 	endAt := time.Now().UnixNano()
 	metricsBlockVerifiedTime.Update(endAt - startAt)
@@ -884,6 +1051,15 @@ func (block *Block) GetNonce(address byteutils.Hash) (uint64, error) {
 	return account.Nonce(), nil
 }
 
+// GetStorage returns the value of the key in the storage of the given address on this block.
+func (block *Block) GetStorage(address byteutils.Hash, key []byte) ([]byte, error) {
+	account, err := block.accState.GetOrCreateUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	return account.Get(key)
+}
+
 // RecordEvent record event's topic and data with txHash
 func (block *Block) RecordEvent(txHash byteutils.Hash, topic, data string) error {
 	event := &Event{Topic: topic, Data: data}
@@ -951,7 +1127,7 @@ func (block *Block) FetchEvents(txHash byteutils.Hash) ([]*Event, error) {
 }
 
 func (block *Block) recordMintCnt() error {
-	key := append(byteutils.FromInt64(block.Timestamp()/DynastyInterval), block.miner.Bytes()...)
+	key := append(byteutils.FromInt64(block.Timestamp()/block.DposParams().DynastyInterval), block.miner.Bytes()...)
 	bytes, err := block.dposContext.mintCntTrie.Get(key)
 	if err != nil && err != storage.ErrKeyNotFound {
 		return err
@@ -970,15 +1146,72 @@ func (block *Block) recordMintCnt() error {
 }
 
 func (block *Block) rewardCoinbase() error {
+	// the reward is shared with the delegators when the block is minted.
+	if block.ChainRules().IsRewardSharing {
+		return nil
+	}
 	coinbaseAddr := block.header.coinbase.address
 	coinbaseAcc, err := block.accState.GetOrCreateUserAccount(coinbaseAddr)
 	if err != nil {
 		return err
 	}
-	coinbaseAcc.AddBalance(BlockReward)
+	params, err := block.GovernedParams()
+	if err != nil {
+		return err
+	}
+	coinbaseAcc.AddBalance(params.BlockReward)
+	return nil
+}
+
+// rewardDevelopers mints the developer rewards if dip is enabled in genesis,
+// a chain missing the rewarder would mint nothing and split the consensus.
+func (block *Block) rewardDevelopers() error {
+	bc := block.chain()
+	if bc.genesis.GetConsensus().GetDip() == nil {
+		return nil
+	}
+	if bc.devRewarder == nil {
+		return ErrMissingDeveloperRewarder
+	}
+	rewards, err := bc.devRewarder.Rewards(block)
+	if err != nil {
+		return err
+	}
+	developers := make([]string, 0, len(rewards))
+	for developer := range rewards {
+		developers = append(developers, developer)
+	}
+	sort.Strings(developers)
+	for _, developer := range developers {
+		addr, err := AddressParse(developer)
+		if err != nil {
+			return err
+		}
+		acc, err := block.accState.GetOrCreateUserAccount(addr.Bytes())
+		if err != nil {
+			return err
+		}
+		acc.AddBalance(rewards[developer])
+	}
 	return nil
 }
 
+// ContractDeployer returns the address deployed the contract.
+func (block *Block) ContractDeployer(addr *Address) (*Address, error) {
+	contract, err := block.accState.GetContractAccount(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return nil, ErrContractNotFound
+	}
+	tx, err := block.GetTransaction(contract.BirthPlace())
+	if err != nil {
+		return nil, err
+	}
+	return tx.From(), nil
+}
+
 // GetTransaction from txs Trie
 func (block *Block) GetTransaction(hash byteutils.Hash) (*Transaction, error) {
 	txBytes, err := block.txsTrie.Get(hash)
@@ -1035,6 +1268,15 @@ func (block *Block) checkTransaction(tx *Transaction) (bool, uint64, error) {
 		return true, currentNonce, ErrLargeTransactionNonce
 	}
 
+	// check the min gas price set by governance, zero means no limit in consensus.
+	params, err := block.txsGovernedParams()
+	if err != nil {
+		return true, currentNonce, err
+	}
+	if params.MinGasPrice.Sign() > 0 && tx.GasPrice().Cmp(params.MinGasPrice.Int) < 0 {
+		return false, currentNonce, ErrBelowGasPrice
+	}
+
 	return false, currentNonce, nil
 }
 
@@ -1043,17 +1285,70 @@ func (block *Block) executeTransaction(tx *Transaction) (bool, uint64, error) {
 		return giveback, currentNonce, err
 	}
 
-	if _, err := tx.VerifyExecution(block); err != nil {
+	// the tx exceeding the block limits is given back for the next block,
+	// unless it can't fit in any block.
+	limited := block.ChainRules().IsBlockLimits
+	params, err := block.txsGovernedParams()
+	if err != nil {
+		return true, uint64(0), err
+	}
+	size, err := txSize(tx)
+	if err != nil {
 		return false, uint64(0), err
 	}
+	if limited && size > params.BlockSizeLimit {
+		return false, uint64(0), ErrTxExceedBlockSizeLimit
+	}
+	if limited && block.txsSize+size > params.BlockSizeLimit {
+		return true, uint64(0), ErrExceedBlockSizeLimit
+	}
+
+	if limited && tx.gasLimit.Cmp(params.BlockGasLimit.Int) > 0 {
+		return false, uint64(0), ErrTxExceedBlockGasLimit
+	}
+
+	gasUsed, err := tx.VerifyExecution(block)
+	if err != nil {
+		return false, uint64(0), err
+	}
+	txsGasUsed := block.TxsGasUsed()
+	txsGasUsed.Add(txsGasUsed.Int, gasUsed.Int)
+	if limited && txsGasUsed.Cmp(params.BlockGasLimit.Int) > 0 {
+		return true, uint64(0), ErrExceedBlockGasLimit
+	}
 
 	if err := block.acceptTransaction(tx); err != nil {
 		return false, uint64(0), err
 	}
+	if block.ChainRules().IsReceipts {
+		if err := block.recordReceipt(tx, gasUsed, txsGasUsed); err != nil {
+			return false, uint64(0), err
+		}
+	}
+	block.txsGasUsed = txsGasUsed
+	block.txsSize += size
 
 	return false, uint64(0), nil
 }
 
+// TxsGasUsed return the gas used by the txs executed in the block.
+func (block *Block) TxsGasUsed() *util.Uint128 {
+	gasUsed := util.NewUint128()
+	if block.txsGasUsed != nil {
+		gasUsed.Set(block.txsGasUsed.Int)
+	}
+	return gasUsed
+}
+
+// txSize returns the bytes of the tx in the block.
+func txSize(tx *Transaction) (uint64, error) {
+	pbTx, err := tx.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(proto.Size(pbTx)), nil
+}
+
 // CheckContract check if contract is valid
 func (block *Block) CheckContract(addr *Address) error {
 
@@ -1107,6 +1402,20 @@ func HashBlock(block *Block) byteutils.Hash {
 	hasher.Write(byteutils.FromInt64(block.header.timestamp))
 	hasher.Write(byteutils.FromUint32(block.header.chainID))
 
+	// the gas used is recorded since ForkBlockLimits, blocks before it are hashed as they were.
+	if gasUsed := block.GasUsed(); gasUsed.Sign() > 0 {
+		bytes, _ := gasUsed.ToFixedSizeByteSlice()
+		hasher.Write(bytes)
+	}
+	// the receipts root is recorded since ForkReceipts.
+	if receiptsRoot := block.ReceiptsRoot(); len(receiptsRoot) > 0 {
+		hasher.Write(receiptsRoot)
+	}
+	// the logs bloom is recorded since ForkLogsBloom.
+	if logsBloom := block.LogsBloom(); len(logsBloom) > 0 {
+		hasher.Write(logsBloom)
+	}
+
 	for _, tx := range block.transactions {
 		hasher.Write(tx.Hash())
 	}
@@ -1114,6 +1423,22 @@ func HashBlock(block *Block) byteutils.Hash {
 	return hasher.Sum(nil)
 }
 
+// HashBlockHeader return the hash of the block with the header and tx hashes,
+// e.g. computed by an external signer instead of trusting the hash in the header.
+func HashBlockHeader(header *corepb.BlockHeader, txHashes []byteutils.Hash) (byteutils.Hash, error) {
+	if header.DposContext == nil {
+		return nil, ErrInvalidBlockHeader
+	}
+	block := &Block{header: new(BlockHeader)}
+	if err := block.header.FromProto(header); err != nil {
+		return nil, err
+	}
+	for _, hash := range txHashes {
+		block.transactions = append(block.transactions, &Transaction{hash: hash})
+	}
+	return HashBlock(block), nil
+}
+
 // HashPbBlock return the hash of pb block.
 func HashPbBlock(pbBlock *corepb.Block) byteutils.Hash {
 	block := new(Block)
@@ -1168,6 +1493,10 @@ func LoadBlockFromStorage(hash byteutils.Hash, storage storage.Storage, txPool *
 	if err != nil {
 		return nil, err
 	}
+	block.receiptsTrie, err = trie.NewBatchTrie(block.ReceiptsRoot(), storage)
+	if err != nil {
+		return nil, err
+	}
 	if block.dposContext, err = NewDposContext(storage); err != nil {
 		return nil, err
 	}
@@ -1198,6 +1527,11 @@ func (block *Block) Clone() (*Block, error) {
 		return nil, ErrCloneEventsState
 	}
 
+	receiptsTrie, err := block.receiptsTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneReceiptsState
+	}
+
 	dposContext, err := block.dposContext.Clone()
 	if err != nil {
 		return nil, err
@@ -1210,14 +1544,18 @@ func (block *Block) Clone() (*Block, error) {
 		parentBlock:  block.parentBlock,
 		txPool:       block.txPool,
 		miner:        block.miner,
+		txsGasUsed:   block.TxsGasUsed(),
+		txsSize:      block.txsSize,
+		txsParams:    block.txsParams,
 		storage:      block.storage,
 		eventEmitter: block.eventEmitter,
 		transactions: make(Transactions, 0),
 
-		accState:    accState,
-		txsTrie:     txsTrie,
-		eventsTrie:  eventsTrie,
-		dposContext: dposContext,
+		accState:     accState,
+		txsTrie:      txsTrie,
+		eventsTrie:   eventsTrie,
+		receiptsTrie: receiptsTrie,
+		dposContext:  dposContext,
 	}, nil
 }
 
@@ -1226,7 +1564,11 @@ func (block *Block) Merge(source *Block) {
 	block.accState = source.accState
 	block.txsTrie = source.txsTrie
 	block.eventsTrie = source.eventsTrie
+	block.receiptsTrie = source.receiptsTrie
 	block.dposContext = source.dposContext
+	block.txsGasUsed = source.txsGasUsed
+	block.txsSize = source.txsSize
+	block.txsParams = source.txsParams
 	block.transactions = append(block.transactions, source.transactions...)
 }
 
@@ -123,6 +123,40 @@ func (pool *BlockPool) Stop() {
 	pool.quitCh <- 0
 }
 
+// reportDoubleSign packages the blocks as evidence if they are signed by the same miner,
+// the mining consensus submits it in an evidence transaction, anyone else can too.
+func (pool *BlockPool) reportDoubleSign(preBlock *Block, block *Block) {
+	payload, err := NewEvidencePayload(preBlock, block)
+	if err != nil {
+		return
+	}
+	miner, err := payload.Verify(pool.bc.chainID)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"curBlock": block,
+			"preBlock": preBlock,
+			"err":      err,
+		}).Debug("Blocks in the same slot are not double sign evidence.")
+		return
+	}
+	data, err := payload.ToBytes()
+	if err != nil {
+		return
+	}
+
+	metricsDoubleSignEvidence.Inc(1)
+	logging.CLog().WithFields(logrus.Fields{
+		"curBlock": block,
+		"preBlock": preBlock,
+		"miner":    miner,
+	}).Warn("Found double sign evidence.")
+
+	pool.bc.eventEmitter.Trigger(&Event{
+		Topic: TopicDoubleSign,
+		Data:  string(data),
+	})
+}
+
 func (pool *BlockPool) handleBlock(msg net.Message) {
 	if msg.MessageType() != MessageTypeNewBlock && msg.MessageType() != MessageTypeDownloadedBlockReply {
 		logging.VLog().WithFields(logrus.Fields{
@@ -350,7 +384,7 @@ func (pool *BlockPool) download(sender string, block *Block) error {
 		"block":  block,
 		"tail":   pool.bc.TailBlock(),
 		"gap":    strconv.Itoa(int(block.Timestamp()-pool.bc.TailBlock().Timestamp())) + "s",
-		"limit":  strconv.Itoa(int(DynastyInterval)) + "s",
+		"limit":  strconv.Itoa(int(pool.bc.DposParams().DynastyInterval)) + "s",
 	}).Info("Send download request.")
 
 	return nil
@@ -390,6 +424,7 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 			"preBlock": preBlock.(*Block),
 			"sender":   sender,
 		}).Warn("Found someone minted multiple blocks at same time.")
+		pool.reportDoubleSign(preBlock.(*Block), lb.block)
 		return ErrDoubleBlockMinted
 	}
 	pool.slot.Add(lb.block.Timestamp(), lb.block)
@@ -446,7 +481,7 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 					"tail":    bc.tailBlock,
 					"block":   block,
 					"offline": strconv.Itoa(int(lb.block.Timestamp()-bc.TailBlock().Timestamp())) + "s",
-					"limit":   strconv.Itoa(int(DynastyInterval)) + "s",
+					"limit":   strconv.Itoa(int(bc.DposParams().DynastyInterval)) + "s",
 				}).Warn("Offline too long, pend mining and restart sync from others.")
 			}
 			return ErrInvalidBlockCannotFindParentInLocalAndTrySync
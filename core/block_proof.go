@@ -0,0 +1,95 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Signer verifies the block hash against the header and the txs' hashes,
+// and returns the miner who signed the block.
+func (e *BlockEvidence) Signer() (*Address, error) {
+	block, err := e.toBlock()
+	if err != nil {
+		return nil, err
+	}
+	if !HashBlock(block).Equals(block.Hash()) {
+		return nil, ErrInvalidBlockHash
+	}
+	return RecoverMiner(block)
+}
+
+// ProveAccount returns the account in the state of the block and the merkle proof
+// from the state root to the account.
+func (block *Block) ProveAccount(addr byteutils.Hash) ([]byte, trie.MerkleProof, error) {
+	stateTrie, err := trie.NewBatchTrie(block.StateRoot(), block.storage)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, err := stateTrie.Get(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := stateTrie.Prove(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, proof, nil
+}
+
+// ProveStorage returns the value of the key in the account's storage and the merkle proof
+// from the account's vars hash to the value.
+func (block *Block) ProveStorage(addr byteutils.Hash, key []byte) ([]byte, trie.MerkleProof, error) {
+	bytes, _, err := block.ProveAccount(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	account := new(corepb.Account)
+	if err := proto.Unmarshal(bytes, account); err != nil {
+		return nil, nil, err
+	}
+	varsTrie, err := trie.NewBatchTrie(account.VarsHash, block.storage)
+	if err != nil {
+		return nil, nil, err
+	}
+	value, err := varsTrie.Get(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := varsTrie.Prove(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, proof, nil
+}
+
+// VerifyAccountProof checks the account is in the state with the root and returns it.
+func VerifyAccountProof(stateRoot byteutils.Hash, addr byteutils.Hash, bytes []byte, proof trie.MerkleProof) (*corepb.Account, error) {
+	if err := trie.VerifyProof(stateRoot, addr, bytes, proof); err != nil {
+		return nil, err
+	}
+	account := new(corepb.Account)
+	if err := proto.Unmarshal(bytes, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
@@ -117,6 +117,8 @@ func TestBlock(t *testing.T) {
 						1,
 						util.NewUint128(),
 						util.NewUint128(),
+						0,
+						0,
 						uint8(keystore.SECP256K1),
 						nil,
 					},
@@ -131,6 +133,8 @@ func TestBlock(t *testing.T) {
 						2,
 						util.NewUint128(),
 						util.NewUint128(),
+						0,
+						0,
 						uint8(keystore.SECP256K1),
 						nil,
 					},
@@ -570,6 +574,271 @@ func TestBlockVerifyIntegrityDup(t *testing.T) {
 	assert.Equal(t, block.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()), ErrSmallTransactionNonce)
 }
 
+func TestBlock_Limits(t *testing.T) {
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	to := mockAddress()
+
+	// collect returns the block packed from 3 txs of 20003 gas in the pool,
+	// the limits are set below the genesis minimums to keep the txs small.
+	collect := func(gasLimit int64, sizeLimit uint64) (*BlockChain, *Block) {
+		neb := testNeb()
+		neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkBlockLimits, Height: 0}}
+		bc, err := NewBlockChain(neb)
+		assert.Nil(t, err)
+		if gasLimit > 0 {
+			bc.dposParams.BlockGasLimit = util.NewUint128FromInt(gasLimit)
+		}
+		if sizeLimit > 0 {
+			bc.dposParams.BlockSizeLimit = sizeLimit
+		}
+		bc.SetConsensusHandler(MockConsensus{})
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), nonce, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(30000))
+			assert.Nil(t, tx.Sign(signature))
+			assert.Nil(t, bc.txPool.Push(tx))
+		}
+		block, err := bc.NewBlock(from)
+		assert.Nil(t, err)
+		block.header.timestamp = BlockInterval
+		block.CollectTransactions(time.Now().Unix() + 2)
+		block.SetMiner(from)
+		assert.Nil(t, block.Seal())
+		return bc, block
+	}
+
+	bc, block := collect(40006, 0)
+	assert.Equal(t, 2, len(block.transactions))
+	assert.Equal(t, "40006", block.GasUsed().String())
+	assert.Equal(t, HashBlock(block), block.Hash())
+
+	received, _ := mockBlockFromNetwork(block)
+	assert.Equal(t, "40006", received.GasUsed().String())
+	assert.Nil(t, received.LinkParentBlock(bc, bc.tailBlock))
+	assert.Nil(t, received.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
+	received, _ = mockBlockFromNetwork(block)
+	received.header.gasUsed = util.NewUint128FromInt(40005)
+	assert.Nil(t, received.LinkParentBlock(bc, bc.tailBlock))
+	assert.Equal(t, ErrInvalidBlockGasUsed, received.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
+
+	size, err := txSize(block.transactions[0])
+	assert.Nil(t, err)
+	bc, block = collect(0, size)
+	assert.Equal(t, 1, len(block.transactions))
+	assert.Equal(t, "20003", block.GasUsed().String())
+
+	// txs never fitting in a block are rejected by the pool
+	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 4, TxPayloadBinaryType, []byte("nas!"), TransactionGasPrice, util.NewUint128FromInt(30000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Equal(t, ErrTxExceedBlockSizeLimit, bc.txPool.Push(tx))
+	bc.dposParams.BlockGasLimit = util.NewUint128FromInt(20003)
+	bc.txPool.tailParams = nil
+	tx = NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 4, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(30000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Equal(t, ErrTxExceedBlockGasLimit, bc.txPool.Push(tx))
+}
+
+func TestBlock_Receipts(t *testing.T) {
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	to := mockAddress()
+
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkReceipts, Height: 0}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(MockConsensus{})
+	var txs []*Transaction
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), nonce, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, bc.txPool.Push(tx))
+		txs = append(txs, tx)
+	}
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(time.Now().Unix() + 2)
+	block.SetMiner(from)
+	assert.Nil(t, block.Seal())
+	assert.Equal(t, 2, len(block.transactions))
+	assert.NotEqual(t, 0, len(block.ReceiptsRoot()))
+	assert.Equal(t, HashBlock(block), block.Hash())
+
+	receipt, err := block.GetReceipt(txs[1].Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, int8(TxExecutionSuccess), receipt.Status)
+	assert.Equal(t, "20003", receipt.GasUsed.String())
+	assert.Equal(t, "40006", receipt.CumulativeGasUsed.String())
+	assert.Nil(t, receipt.ContractAddress)
+	assert.Equal(t, "", receipt.Error)
+	_, err = block.GetReceipt(to.Bytes())
+	assert.Equal(t, ErrReceiptNotFound, err)
+
+	data, proof, err := block.ProveReceipt(txs[0].Hash())
+	assert.Nil(t, err)
+	receipt, err = VerifyReceiptProof(block.ReceiptsRoot(), txs[0].Hash(), data, proof)
+	assert.Nil(t, err)
+	assert.Equal(t, "20003", receipt.CumulativeGasUsed.String())
+	_, err = VerifyReceiptProof(block.ReceiptsRoot(), txs[1].Hash(), data, proof)
+	assert.NotNil(t, err)
+
+	received, _ := mockBlockFromNetwork(block)
+	assert.Equal(t, block.ReceiptsRoot(), received.ReceiptsRoot())
+	assert.Nil(t, received.LinkParentBlock(bc, bc.tailBlock))
+	assert.Nil(t, received.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
+	received, _ = mockBlockFromNetwork(block)
+	received.header.receiptsRoot = txs[0].Hash()
+	assert.Nil(t, received.LinkParentBlock(bc, bc.tailBlock))
+	assert.Equal(t, ErrInvalidBlockReceiptsRoot, received.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
+}
+
+func TestBlock_Logs(t *testing.T) {
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	to := mockAddress()
+	other := mockAddress()
+
+	var bloom Bloom
+	bloom.Add(to.Bytes())
+	assert.True(t, bloom.Test(to.Bytes()))
+	assert.False(t, bloom.Test([]byte("not added")))
+	assert.Equal(t, bloom, BytesToBloom(bloom.Bytes()))
+
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkLogsBloom, Height: 0}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(MockConsensus{})
+	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, bc.txPool.Push(tx))
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(time.Now().Unix() + 2)
+	block.SetMiner(from)
+	assert.Nil(t, block.Seal())
+	assert.Equal(t, 1, len(block.transactions))
+	assert.Equal(t, BloomByteLength, len(block.LogsBloom()))
+	assert.Equal(t, HashBlock(block), block.Hash())
+
+	events, err := block.FetchEvents(tx.Hash())
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(events))
+	topic := events[0].Topic
+	bloom = BytesToBloom(block.LogsBloom())
+	assert.True(t, bloom.Test([]byte(topic)))
+
+	logs, err := block.Logs(&LogFilter{Topics: []string{topic}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, tx.Hash(), logs[0].TxHash)
+	assert.Equal(t, block.Hash(), logs[0].BlockHash)
+	// the logs of a tx calling no contract have no address
+	assert.Nil(t, logs[0].Address)
+	logs, err = block.Logs(&LogFilter{Address: to, Topics: []string{topic}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(logs))
+	logs, err = block.Logs(&LogFilter{Address: other})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(logs))
+	logs, err = block.Logs(&LogFilter{Topics: []string{"chain.contract.unknown"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(logs))
+	// the execution result of every tx is only matched if listed
+	assert.True(t, isExecutionResult(topic))
+	logs, err = block.Logs(&LogFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(logs))
+
+	received, _ := mockBlockFromNetwork(block)
+	assert.Nil(t, received.LinkParentBlock(bc, bc.tailBlock))
+	assert.Nil(t, received.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
+	received, _ = mockBlockFromNetwork(block)
+	received.header.logsBloom = make([]byte, BloomByteLength)
+	assert.Nil(t, received.LinkParentBlock(bc, bc.tailBlock))
+	assert.Equal(t, ErrInvalidBlockLogsBloom, received.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
+
+	_, _, err = bc.GetLogs(&LogFilter{FromHeight: 2, ToHeight: 1})
+	assert.Equal(t, ErrInvalidLogsRange, err)
+	_, next, err := bc.GetLogs(&LogFilter{FromHeight: 1, ToHeight: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), next)
+	// an unset from height is the genesis
+	_, next, err = bc.GetLogs(&LogFilter{ToHeight: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), next)
+}
+
+func TestBlock_ContractCalls(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkBatchCallUsage, Height: 2}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	contract, caller, other := mockAddress(), mockAddress(), mockAddress()
+	call, _ := NewCallPayload("f", "").ToBytes()
+	batch, _ := NewBatchPayload([]*BatchItem{
+		{To: other.String(), Value: "1"},
+		{To: contract.String(), Function: "g"},
+	}).ToBytes()
+
+	mint := func() *Block {
+		block, err := bc.NewBlock(mockAddress())
+		assert.Nil(t, err)
+		succeed := NewTransaction(bc.ChainID(), caller, contract, util.NewUint128(), 1, TxPayloadCallType, call, TransactionGasPrice, TransactionMaxGas)
+		failed := NewTransaction(bc.ChainID(), other, contract, util.NewUint128(), 1, TxPayloadCallType, call, TransactionGasPrice, TransactionMaxGas)
+		batched := NewTransaction(bc.ChainID(), caller, caller, util.NewUint128(), 2, TxPayloadBatchType, batch, TransactionGasPrice, TransactionMaxGas)
+		for _, tx := range []*Transaction{succeed, failed, batched} {
+			tx.hash, _ = HashTransaction(tx)
+			var err error
+			if tx == failed {
+				err = ErrInsufficientBalance
+			}
+			tx.recordResultEvent(block, util.NewUint128(), err, nil)
+			block.transactions = append(block.transactions, tx)
+		}
+		return block
+	}
+
+	// the batch items are not counted before the fork.
+	block := mint()
+	calls, err := block.ContractCalls()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(calls))
+	assert.True(t, contract.Equals(calls[0].Contract))
+	assert.True(t, caller.Equals(calls[0].Caller))
+
+	block.SetMiner(caller)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, bc.SetTailBlock(block))
+	block = mint()
+	calls, err = block.ContractCalls()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(calls))
+	assert.True(t, contract.Equals(calls[1].Contract))
+	assert.True(t, caller.Equals(calls[1].Caller))
+
+	// the calls are derived from the block, a node that did not execute it gets the same calls.
+	block.SetMiner(caller)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, bc.storeBlockToStorage(block))
+	loaded, err := LoadBlockFromStorage(block.Hash(), bc.storage, bc.txPool, bc.eventEmitter)
+	assert.Nil(t, err)
+	loadedCalls, err := loaded.ContractCalls()
+	assert.Nil(t, err)
+	assert.Equal(t, calls, loadedCalls)
+}
+
 func TestBlockVerifyExecution(t *testing.T) {
 	var cons MockConsensus
 	bc, err := NewBlockChain(testNeb())
@@ -643,3 +912,29 @@ func TestBlock_String(t *testing.T) {
 	logging.CLog().Info(bc.genesisBlock)
 	assert.NotNil(t, bc.genesisBlock.String())
 }
+
+type mockRewarder map[string]*util.Uint128
+
+func (r mockRewarder) Rewards(block *Block) (map[string]*util.Uint128, error) {
+	return r, nil
+}
+
+func TestBlock_RewardDevelopers(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Consensus.Dip = &corepb.GenesisConsensusDip{Reward: "100"}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	// a chain with dip must not mint blocks without the rewarder.
+	_, err = bc.NewBlock(mockAddress())
+	assert.Equal(t, ErrMissingDeveloperRewarder, err)
+
+	developer := mockAddress()
+	bc.SetDeveloperRewarder(mockRewarder{developer.String(): util.NewUint128FromInt(100)})
+
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	balance, err := block.GetBalance(developer.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128FromInt(100), balance)
+}
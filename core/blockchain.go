@@ -21,6 +21,7 @@ package core
 import (
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -37,6 +38,7 @@ import (
 // scheme -> scheme version
 // genesis hash -> genesis block
 // blockchain_tail -> tail block hash
+// genesis_consensus -> consensus config of genesis
 // block hash -> block
 // height -> block hash
 
@@ -44,7 +46,9 @@ import (
 type BlockChain struct {
 	chainID uint32
 
-	genesis *corepb.Genesis
+	genesis    *corepb.Genesis
+	forks      *ForkSchedule
+	dposParams *DposParams
 
 	genesisBlock *Block
 	tailBlock    *Block
@@ -52,13 +56,19 @@ type BlockChain struct {
 	bkPool *BlockPool
 	txPool *TransactionPool
 
+	gasPriceOracle *GasPriceOracle
+
 	consensusHandler Consensus
+	forkChoiceRule   ForkChoiceRule
+	dynastyElector   DynastyElector
+	devRewarder      DeveloperRewarder
 	syncService      SyncService
 
 	cachedBlocks       *lru.Cache
 	detachedTailBlocks *lru.Cache
 
 	latestIrreversibleBlock *Block
+	libLock                 sync.Mutex
 
 	storage storage.Storage
 	neb     Neblet
@@ -83,6 +93,12 @@ const (
 
 	// LIB (latest irreversible block) in storage
 	LIB = "blockchain_lib"
+
+	// GenesisConsensus is the consensus config of genesis in storage
+	GenesisConsensus = "genesis_consensus"
+
+	// GenesisForks is the fork schedule of genesis in storage
+	GenesisForks = "genesis_forks"
 )
 
 // NewBlockChain create new #BlockChain instance.
@@ -97,9 +113,20 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 	}
 	txPool.setEventEmitter(neb.EventEmitter())
 
+	forks, err := NewForkSchedule(neb.Genesis().Forks)
+	if err != nil {
+		return nil, err
+	}
+	dposParams, err := NewDposParams(neb.Genesis().Consensus.Dpos)
+	if err != nil {
+		return nil, err
+	}
+
 	var bc = &BlockChain{
 		chainID:      neb.Genesis().Meta.ChainId,
 		genesis:      neb.Genesis(),
+		forks:        forks,
+		dposParams:   dposParams,
 		bkPool:       blockPool,
 		txPool:       txPool,
 		storage:      neb.Storage(),
@@ -137,6 +164,9 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		"token.distribution":     neb.Genesis().TokenDistribution,
 	}).Info("Genesis Configuration.")
 
+	// the blocks loaded below read the rules and params of the chain through the pool.
+	bc.txPool.setBlockChain(bc)
+
 	bc.genesisBlock, err = bc.loadGenesisFromStorage()
 	if err != nil {
 		return nil, err
@@ -159,7 +189,8 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 	}).Info("Latest Irreversible Block.")
 
 	bc.bkPool.setBlockChain(bc)
-	bc.txPool.setBlockChain(bc)
+	bc.gasPriceOracle = NewGasPriceOracle(bc, GasPriceOracleBlocks)
+	bc.forkChoiceRule = &longestChain{}
 
 	return bc, nil
 }
@@ -210,6 +241,44 @@ func (bc *BlockChain) CheckChainConfig(neb Neblet) error {
 			return ErrGenesisConfNotMatch
 		}
 
+		// check dpos params equal
+		confParams, err := NewDposParams(neb.Genesis().Consensus.Dpos)
+		if err != nil {
+			return err
+		}
+		params, err := NewDposParams(genesis.Consensus.Dpos)
+		if err != nil {
+			return err
+		}
+		if !proto.Equal(confParams.ToProto(), params.ToProto()) {
+			return ErrGenesisConfNotMatch
+		}
+
+		// check consensus algorithm equal
+		if !proto.Equal(neb.Genesis().Consensus.Pod, genesis.Consensus.Pod) {
+			return ErrGenesisConfNotMatch
+		}
+		if !proto.Equal(neb.Genesis().Consensus.Dip, genesis.Consensus.Dip) {
+			return ErrGenesisConfNotMatch
+		}
+
+		// check fork schedule equal, the schedule is stored on the first start of chains created before it.
+		schedule, err := loadForksFromStorage(bc.storage)
+		if err != nil {
+			return err
+		}
+		if schedule == nil {
+			if err := bc.storeForksToStorage(); err != nil {
+				return err
+			}
+		} else if !bc.forks.Match(schedule) {
+			logging.CLog().WithFields(logrus.Fields{
+				"stored": schedule.Forks,
+				"conf":   bc.forks.ToProto().Forks,
+			}).Error("Found unmatched fork schedule.")
+			return ErrGenesisConfNotMatch
+		}
+
 		// check dpos equal
 		for _, confDposAddr := range neb.Genesis().Consensus.Dpos.Dynasty {
 			contains := false
@@ -359,22 +428,23 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 
 func (bc *BlockChain) updateLatestIrreversibleBlock(tail *Block) {
 	lib := bc.latestIrreversibleBlock
+	consensusSize := bc.dposParams.ConsensusSize
 	cur := tail
 	miners := make(map[string]bool)
 	dynasty := int64(0)
 	for !cur.Hash().Equals(lib.Hash()) {
-		curDynasty := cur.header.timestamp / DynastyInterval
+		curDynasty := cur.header.timestamp / bc.dposParams.DynastyInterval
 		if curDynasty != dynasty {
 			miners = make(map[string]bool)
 			dynasty = curDynasty
 		}
 		// fast prune
-		if int(cur.height)-int(lib.height) < ConsensusSize-len(miners) {
+		if int(cur.height)-int(lib.height) < consensusSize-len(miners) {
 			return
 		}
 		miners[cur.miner.String()] = true
-		if len(miners) >= ConsensusSize {
-			if err := bc.storeLIBToStorage(cur); err != nil {
+		if len(miners) >= consensusSize {
+			if err := bc.setLatestIrreversibleBlock(cur); err != nil {
 				logging.VLog().WithFields(logrus.Fields{
 					"tail": tail,
 					"lib":  cur,
@@ -382,19 +452,11 @@ func (bc *BlockChain) updateLatestIrreversibleBlock(tail *Block) {
 				return
 			}
 			logging.VLog().WithFields(logrus.Fields{
-				"lib.new":          cur,
-				"lib.old":          bc.latestIrreversibleBlock,
+				"lib":              cur,
 				"tail":             tail,
-				"miners.limit":     ConsensusSize,
+				"miners.limit":     consensusSize,
 				"miners.supported": len(miners),
 			}).Info("Succeed to update latest irreversible block.")
-			bc.latestIrreversibleBlock = cur
-
-			e := &Event{
-				Topic: TopicLibBlock,
-				Data:  bc.latestIrreversibleBlock.String(),
-			}
-			bc.eventEmitter.Trigger(e)
 			return
 		}
 
@@ -414,11 +476,55 @@ func (bc *BlockChain) updateLatestIrreversibleBlock(tail *Block) {
 		"lib":              bc.latestIrreversibleBlock,
 		"tail":             tail,
 		"err":              "supported miners is not enough",
-		"miners.limit":     ConsensusSize,
+		"miners.limit":     consensusSize,
 		"miners.supported": len(miners),
 	}).Warn("Failed to update latest irreversible block.")
 }
 
+func (bc *BlockChain) setLatestIrreversibleBlock(lib *Block) error {
+	bc.libLock.Lock()
+	defer bc.libLock.Unlock()
+	return bc.setLatestIrreversibleBlockLocked(lib)
+}
+
+// setLatestIrreversibleBlockLocked must be called with libLock held.
+func (bc *BlockChain) setLatestIrreversibleBlockLocked(lib *Block) error {
+	if lib.height <= bc.latestIrreversibleBlock.height {
+		return nil
+	}
+	if err := bc.storeLIBToStorage(lib); err != nil {
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"lib.new": lib,
+		"lib.old": bc.latestIrreversibleBlock,
+	}).Info("Latest irreversible block changed.")
+	bc.latestIrreversibleBlock = lib
+
+	e := &Event{
+		Topic: TopicLibBlock,
+		Data:  lib.String(),
+	}
+	bc.eventEmitter.Trigger(e)
+	return nil
+}
+
+// FinalizeBlock make a block on the canonical chain irreversible, it's used by
+// the finality gadget once the block is committed by the dynasty.
+func (bc *BlockChain) FinalizeBlock(block *Block) error {
+	bc.libLock.Lock()
+	defer bc.libLock.Unlock()
+
+	if block.height <= bc.latestIrreversibleBlock.height {
+		return nil
+	}
+	canonical := bc.GetBlockOnCanonicalChainByHeight(block.height)
+	if canonical == nil || !canonical.Hash().Equals(block.Hash()) {
+		return ErrFinalizeNotCanonicalBlock
+	}
+	return bc.setLatestIrreversibleBlockLocked(block)
+}
+
 // LatestIrreversibleBlock return the latest irreversible block
 func (bc *BlockChain) LatestIrreversibleBlock() *Block {
 	return bc.latestIrreversibleBlock
@@ -527,6 +633,41 @@ func (bc *BlockChain) BlockPool() *BlockPool {
 	return bc.bkPool
 }
 
+// ForkSchedule return the fork schedule of the chain.
+func (bc *BlockChain) ForkSchedule() *ForkSchedule {
+	return bc.forks
+}
+
+// SetForkChoiceRule set the rule picking the new tail among forks.
+func (bc *BlockChain) SetForkChoiceRule(rule ForkChoiceRule) {
+	bc.forkChoiceRule = rule
+}
+
+// ForkChoiceRule return the fork choice rule.
+func (bc *BlockChain) ForkChoiceRule() ForkChoiceRule {
+	return bc.forkChoiceRule
+}
+
+// SetDynastyElector set the elector of dynasties.
+func (bc *BlockChain) SetDynastyElector(elector DynastyElector) {
+	bc.dynastyElector = elector
+}
+
+// SetDeveloperRewarder set the rewarder of contract developers.
+func (bc *BlockChain) SetDeveloperRewarder(rewarder DeveloperRewarder) {
+	bc.devRewarder = rewarder
+}
+
+// DposParams return the dpos params of the chain.
+func (bc *BlockChain) DposParams() *DposParams {
+	return bc.dposParams
+}
+
+// ChainRules returns the chain rules at height.
+func (bc *BlockChain) ChainRules(height uint64) *ChainRules {
+	return bc.forks.ChainRules(height)
+}
+
 // TransactionPool return block pool.
 func (bc *BlockChain) TransactionPool() *TransactionPool {
 	return bc.txPool
@@ -669,6 +810,11 @@ func (bc *BlockChain) GasPrice() *util.Uint128 {
 	return gasPrice
 }
 
+// GasPriceOracle returns the gas price oracle.
+func (bc *BlockChain) GasPriceOracle() *GasPriceOracle {
+	return bc.gasPriceOracle
+}
+
 // EstimateGas returns the transaction gas cost
 func (bc *BlockChain) EstimateGas(tx *Transaction) (*util.Uint128, error) {
 	gas, _, err := tx.LocalExecution(bc.tailBlock)
@@ -753,6 +899,12 @@ func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
 		if err := bc.storeBlockToStorage(genesis); err != nil {
 			return nil, err
 		}
+		if err := bc.storeConsensusToStorage(); err != nil {
+			return nil, err
+		}
+		if err := bc.storeForksToStorage(); err != nil {
+			return nil, err
+		}
 		heightKey := byteutils.FromUint64(genesis.height)
 		if err := bc.storage.Put(heightKey, genesis.Hash()); err != nil {
 			return nil, err
@@ -761,6 +913,27 @@ func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
 	return genesis, nil
 }
 
+func (bc *BlockChain) storeConsensusToStorage() error {
+	consensus := &corepb.GenesisConsensus{
+		Dpos: bc.dposParams.ToProto(),
+		Pod:  bc.genesis.Consensus.Pod,
+		Dip:  bc.genesis.Consensus.Dip,
+	}
+	bytes, err := proto.Marshal(consensus)
+	if err != nil {
+		return err
+	}
+	return bc.storage.Put([]byte(GenesisConsensus), bytes)
+}
+
+func (bc *BlockChain) storeForksToStorage() error {
+	bytes, err := proto.Marshal(bc.forks.ToProto())
+	if err != nil {
+		return err
+	}
+	return bc.storage.Put([]byte(GenesisForks), bytes)
+}
+
 func (bc *BlockChain) loadLIBFromStorage() (*Block, error) {
 	hash, err := bc.storage.Get([]byte(LIB))
 	if err != nil && err != storage.ErrKeyNotFound {
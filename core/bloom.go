@@ -0,0 +1,79 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+)
+
+const (
+	// BloomByteLength is the byte length of the logs bloom in the block header.
+	BloomByteLength = 256
+
+	// bloomBitLength is the number of bits in the bloom.
+	bloomBitLength = 8 * BloomByteLength
+
+	// bloomHashes is the number of bits set in the bloom by each item.
+	bloomHashes = 3
+)
+
+// Bloom is a bloom filter over the event topics and emitting contracts of a block.
+// A negative Test means the item is not in the block, a positive one may be false.
+type Bloom [BloomByteLength]byte
+
+// BytesToBloom converts the bytes in block header to Bloom.
+func BytesToBloom(bytes []byte) Bloom {
+	var bloom Bloom
+	copy(bloom[:], bytes)
+	return bloom
+}
+
+// Add adds the item to the bloom.
+func (b *Bloom) Add(data []byte) {
+	for _, bit := range bloomBits(data) {
+		b[BloomByteLength-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test returns if the item may be in the bloom.
+func (b *Bloom) Test(data []byte) bool {
+	for _, bit := range bloomBits(data) {
+		if b[BloomByteLength-1-bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Bytes returns the bytes of the bloom.
+func (b *Bloom) Bytes() []byte {
+	bytes := make([]byte, BloomByteLength)
+	copy(bytes, b[:])
+	return bytes
+}
+
+// bloomBits returns the bits of the item, each taken from 2 bytes of its hash.
+func bloomBits(data []byte) []uint {
+	h := hash.Sha3256(data)
+	bits := make([]uint, bloomHashes)
+	for i := 0; i < bloomHashes; i++ {
+		bits[i] = (uint(h[2*i])<<8 | uint(h[2*i+1])) % bloomBitLength
+	}
+	return bits
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// Fork names used in the genesis fork schedule
+const (
+	// ForkOptimize updates transaction execution result event and binary transaction payload.
+	ForkOptimize = "optimize"
+
+	// ForkDeployAddressCheck requires from & to of deploy transactions to be equal.
+	ForkDeployAddressCheck = "deploy_address_check"
+
+	// ForkBatchPayload accepts batch transaction payload.
+	ForkBatchPayload = "batch_payload"
+
+	// ForkValidityWindow accepts transaction validity window.
+	ForkValidityWindow = "validity_window"
+
+	// ForkDoubleSignEvidence accepts double sign evidence transaction payload.
+	ForkDoubleSignEvidence = "double_sign_evidence"
+
+	// ForkStaking locks delegated stake and tallies votes by bonded stake.
+	ForkStaking = "staking"
+
+	// ForkRewardSharing shares block rewards between validators and their delegators.
+	ForkRewardSharing = "reward_sharing"
+
+	// ForkCandidateMetadata stores candidate metadata and locks a registration deposit.
+	ForkCandidateMetadata = "candidate_metadata"
+
+	// ForkJailing jails offline validators instead of kicking them out and records their liveness.
+	ForkJailing = "jailing"
+
+	// ForkGovernance accepts governance proposals changing the governed params.
+	ForkGovernance = "governance"
+
+	// ForkBlockLimits enforces the block gas and size limits and records the gas used in the block header.
+	ForkBlockLimits = "block_limits"
+
+	// ForkReceipts records a receipt of each transaction under the receipts root in the block header.
+	ForkReceipts = "receipts"
+
+	// ForkLogsBloom records a bloom filter over the event topics and emitting contracts in the block header.
+	ForkLogsBloom = "logs_bloom"
+
+	// ForkBatchCallUsage counts the contract calls made as batch items in the developer rewards.
+	ForkBatchCallUsage = "batch_call_usage"
+)
+
+// DefaultForkHeights is the activation heights of the forks not listed in genesis.
+var DefaultForkHeights = map[string]uint64{
+	ForkOptimize:           OptimizeHeight,
+	ForkDeployAddressCheck: NewOptimizeHeight,
+	ForkBatchPayload:       BatchPayloadHeight,
+	ForkValidityWindow:     ValidityWindowHeight,
+	ForkDoubleSignEvidence: DoubleSignEvidenceHeight,
+	ForkStaking:            StakingHeight,
+	ForkRewardSharing:      RewardSharingHeight,
+	ForkCandidateMetadata:  CandidateMetadataHeight,
+	ForkJailing:            JailingHeight,
+	ForkGovernance:         GovernanceHeight,
+	ForkBlockLimits:        BlockLimitsHeight,
+	ForkReceipts:           ReceiptsHeight,
+	ForkLogsBloom:          LogsBloomHeight,
+	ForkBatchCallUsage:     BatchCallUsageHeight,
+}
+
+// DefaultForkSchedule is the schedule of a genesis without forks.
+var DefaultForkSchedule, _ = NewForkSchedule(nil)
+
+// ChainRules is the features activated at a height.
+type ChainRules struct {
+	IsOptimize           bool
+	IsDeployAddressCheck bool
+	IsBatchPayload       bool
+	IsValidityWindow     bool
+	IsDoubleSignEvidence bool
+	IsStaking            bool
+	IsRewardSharing      bool
+	IsCandidateMetadata  bool
+	IsJailing            bool
+	IsGovernance         bool
+	IsBlockLimits        bool
+	IsReceipts           bool
+	IsLogsBloom          bool
+	IsBatchCallUsage     bool
+}
+
+// ForkSchedule is the activation heights of forks, a fork is activated after its height.
+type ForkSchedule struct {
+	heights map[string]uint64
+}
+
+// NewForkSchedule create a new ForkSchedule from genesis forks.
+func NewForkSchedule(forks []*corepb.GenesisFork) (*ForkSchedule, error) {
+	heights := make(map[string]uint64)
+	for name, height := range DefaultForkHeights {
+		heights[name] = height
+	}
+
+	scheduled := make(map[string]bool)
+	for _, fork := range forks {
+		if _, ok := DefaultForkHeights[fork.Name]; !ok {
+			return nil, ErrUnknownFork
+		}
+		if scheduled[fork.Name] {
+			return nil, ErrDuplicatedFork
+		}
+		scheduled[fork.Name] = true
+		heights[fork.Name] = fork.Height
+	}
+	return &ForkSchedule{heights: heights}, nil
+}
+
+// Height returns the activation height of the fork.
+func (s *ForkSchedule) Height(name string) uint64 {
+	return s.heights[name]
+}
+
+// ToProto returns the activation heights of all forks, sorted by name.
+func (s *ForkSchedule) ToProto() *corepb.GenesisForkSchedule {
+	schedule := new(corepb.GenesisForkSchedule)
+	for name, height := range s.heights {
+		schedule.Forks = append(schedule.Forks, &corepb.GenesisFork{Name: name, Height: height})
+	}
+	sort.Slice(schedule.Forks, func(i, j int) bool {
+		return schedule.Forks[i].Name < schedule.Forks[j].Name
+	})
+	return schedule
+}
+
+// Match returns if the forks in the stored schedule activate at the same heights,
+// forks added after the schedule was stored are not compared.
+func (s *ForkSchedule) Match(schedule *corepb.GenesisForkSchedule) bool {
+	for _, fork := range schedule.Forks {
+		height, ok := s.heights[fork.Name]
+		if !ok || height != fork.Height {
+			return false
+		}
+	}
+	return true
+}
+
+// IsActive return if the fork is activated at height.
+func (s *ForkSchedule) IsActive(name string, height uint64) bool {
+	return height > s.heights[name]
+}
+
+// ChainRules returns the rules at height.
+func (s *ForkSchedule) ChainRules(height uint64) *ChainRules {
+	return &ChainRules{
+		IsOptimize:           s.IsActive(ForkOptimize, height),
+		IsDeployAddressCheck: s.IsActive(ForkDeployAddressCheck, height),
+		IsBatchPayload:       s.IsActive(ForkBatchPayload, height),
+		IsValidityWindow:     s.IsActive(ForkValidityWindow, height),
+		IsDoubleSignEvidence: s.IsActive(ForkDoubleSignEvidence, height),
+		IsStaking:            s.IsActive(ForkStaking, height),
+		IsRewardSharing:      s.IsActive(ForkRewardSharing, height),
+		IsCandidateMetadata:  s.IsActive(ForkCandidateMetadata, height),
+		IsJailing:            s.IsActive(ForkJailing, height),
+		IsGovernance:         s.IsActive(ForkGovernance, height),
+		IsBlockLimits:        s.IsActive(ForkBlockLimits, height),
+		IsReceipts:           s.IsActive(ForkReceipts, height),
+		IsLogsBloom:          s.IsActive(ForkLogsBloom, height),
+		IsBatchCallUsage:     s.IsActive(ForkBatchCallUsage, height),
+	}
+}
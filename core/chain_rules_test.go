@@ -0,0 +1,168 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewForkSchedule(t *testing.T) {
+	schedule, err := NewForkSchedule(nil)
+	assert.Nil(t, err)
+	for name, height := range DefaultForkHeights {
+		assert.Equal(t, height, schedule.Height(name))
+		assert.False(t, schedule.IsActive(name, height))
+		assert.True(t, schedule.IsActive(name, height+1))
+	}
+
+	schedule, err = NewForkSchedule([]*corepb.GenesisFork{{Name: ForkBatchPayload, Height: 10}})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), schedule.Height(ForkBatchPayload))
+	assert.Equal(t, uint64(OptimizeHeight), schedule.Height(ForkOptimize))
+
+	_, err = NewForkSchedule([]*corepb.GenesisFork{{Name: "unknown", Height: 10}})
+	assert.Equal(t, ErrUnknownFork, err)
+
+	_, err = NewForkSchedule([]*corepb.GenesisFork{{Name: ForkOptimize, Height: 10}, {Name: ForkOptimize, Height: 20}})
+	assert.Equal(t, ErrDuplicatedFork, err)
+
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: "unknown", Height: 10}}
+	_, err = NewBlockChain(neb)
+	assert.Equal(t, ErrUnknownFork, err)
+}
+
+func TestForkSchedule_Genesis(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkBatchPayload, Height: 10}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	// the schedule is persisted with genesis
+	genesis, err := DumpGenesis(bc.storage)
+	assert.Nil(t, err)
+	assert.Equal(t, len(DefaultForkHeights), len(genesis.Forks))
+	schedule, err := NewForkSchedule(genesis.Forks)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), schedule.Height(ForkBatchPayload))
+
+	// the same storage can't be opened with different fork heights
+	another := testNeb()
+	another.storage = neb.storage
+	_, err = NewBlockChain(another)
+	assert.Equal(t, ErrGenesisConfNotMatch, err)
+
+	another.genesis.Forks = genesis.Forks
+	_, err = NewBlockChain(another)
+	assert.Nil(t, err)
+
+	// chains created before the schedule is stored store it on the next start
+	assert.Nil(t, neb.storage.Del([]byte(GenesisForks)))
+	another.genesis.Forks = nil
+	_, err = NewBlockChain(another)
+	assert.Nil(t, err)
+	another.genesis.Forks = []*corepb.GenesisFork{{Name: ForkBatchPayload, Height: 10}}
+	_, err = NewBlockChain(another)
+	assert.Equal(t, ErrGenesisConfNotMatch, err)
+}
+
+func TestChainRules_Replay(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{
+		{Name: ForkOptimize, Height: 10},
+		{Name: ForkDeployAddressCheck, Height: 20},
+		{Name: ForkBatchPayload, Height: 30},
+		{Name: ForkValidityWindow, Height: 40},
+		{Name: ForkDoubleSignEvidence, Height: 50},
+	}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// blockAt returns a block at height, the same tx is replayed at the fork height and the next one.
+	blockAt := func(height uint64) *Block {
+		block, err := NewBlock(bc.ChainID(), from, bc.tailBlock)
+		assert.Nil(t, err)
+		block.height = height
+		return block
+	}
+
+	binary, _ := NewBinaryPayload([]byte("data")).ToBytes()
+	tx := NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128(), 1, TxPayloadBinaryType, binary, TransactionGasPrice, util.NewUint128FromInt(200000))
+	payload, err := tx.LoadPayload(blockAt(10))
+	assert.Nil(t, err)
+	assert.IsType(t, &BinaryPayloadDeprecated{}, payload)
+	payload, err = tx.LoadPayload(blockAt(11))
+	assert.Nil(t, err)
+	assert.IsType(t, &BinaryPayload{}, payload)
+
+	deploy, _ := NewDeployPayload("var a = 1;", "js", "").ToBytes()
+	tx = NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128(), 1, TxPayloadDeployType, deploy, TransactionGasPrice, util.NewUint128FromInt(200000))
+	block := blockAt(20)
+	block.begin()
+	_, err = generateDeployContext(block, tx)
+	assert.Nil(t, err)
+	block.rollback()
+	block = blockAt(21)
+	block.begin()
+	_, err = generateDeployContext(block, tx)
+	assert.Equal(t, ErrContractTransactionAddressNotEqual, err)
+	block.rollback()
+
+	batch, _ := NewBatchPayload([]*BatchItem{{To: mockAddress().String(), Value: "1"}}).ToBytes()
+	tx = NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBatchType, batch, TransactionGasPrice, util.NewUint128FromInt(200000))
+	_, err = tx.LoadPayload(blockAt(30))
+	assert.Equal(t, ErrInvalidTxPayloadType, err)
+	payload, err = tx.LoadPayload(blockAt(31))
+	assert.Nil(t, err)
+	assert.IsType(t, &BatchPayload{}, payload)
+
+	tx = NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128(), 1, TxPayloadBinaryType, binary, TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.SetValidUntil(50, 0)
+	assert.Nil(t, tx.Sign(signature))
+	block = blockAt(40)
+	assert.Equal(t, ErrValidityWindowNotActivated, tx.CheckValidityWindow(block.ChainRules(), block.height, 0))
+	block = blockAt(41)
+	assert.Nil(t, tx.CheckValidityWindow(block.ChainRules(), block.height, 0))
+
+	evidence, _ := (&EvidencePayload{}).ToBytes()
+	tx = NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadEvidenceType, evidence, TransactionGasPrice, util.NewUint128FromInt(200000))
+	_, err = tx.LoadPayload(blockAt(50))
+	assert.Equal(t, ErrInvalidTxPayloadType, err)
+	payload, err = tx.LoadPayload(blockAt(51))
+	assert.Nil(t, err)
+	assert.IsType(t, &EvidencePayload{}, payload)
+
+	// blocks without chain have no rules, the default schedule would split the consensus
+	block = blockAt(41)
+	block.txPool = nil
+	assert.PanicsWithValue(t, ErrBlockWithoutChain, func() { block.ChainRules() })
+	assert.PanicsWithValue(t, ErrBlockWithoutChain, func() { block.DposParams() })
+}
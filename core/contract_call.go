@@ -0,0 +1,81 @@
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// ContractCall is a successful call of a contract made by a tx in a block.
+// Calls made as batch items are counted since ForkBatchCallUsage.
+type ContractCall struct {
+	Contract *Address
+	Caller   *Address
+}
+
+// ContractCalls is the contract calls in a block, in tx order.
+type ContractCalls []*ContractCall
+
+// ContractCalls returns the successful contract calls in the block. The calls are derived
+// from the txs and their execution results in the events trie, so every node gets the same
+// calls whether it executed the block or not.
+func (block *Block) ContractCalls() (ContractCalls, error) {
+	batchUsage := block.ChainRules().IsBatchCallUsage
+	calls := ContractCalls{}
+	for _, tx := range block.transactions {
+		if tx.Type() != TxPayloadCallType && (tx.Type() != TxPayloadBatchType || !batchUsage) {
+			continue
+		}
+		succeed, err := block.executionSucceed(tx.hash)
+		if err != nil {
+			return nil, err
+		}
+		if !succeed {
+			continue
+		}
+		if tx.Type() == TxPayloadCallType {
+			calls = append(calls, &ContractCall{Contract: tx.to, Caller: tx.from})
+			continue
+		}
+
+		// the items of a successful batch are all executed successfully.
+		payload, err := LoadBatchPayload(tx.data.Payload)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range payload.Items {
+			if len(item.Function) == 0 {
+				continue
+			}
+			to, err := AddressParse(item.To)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, &ContractCall{Contract: to, Caller: tx.from})
+		}
+	}
+	return calls, nil
+}
+
+// executionSucceed returns whether the tx in the block is executed successfully.
+func (block *Block) executionSucceed(txHash byteutils.Hash) (bool, error) {
+	events, err := block.FetchEvents(txHash)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range events {
+		switch v.Topic {
+		case TopicTransactionExecutionResult:
+			txEvent := TransactionEvent{}
+			if err := json.Unmarshal([]byte(v.Data), &txEvent); err != nil {
+				return false, err
+			}
+			return txEvent.Status == TxExecutionSuccess, nil
+		case TopicExecuteTxSuccess:
+			return true, nil
+		case TopicExecuteTxFailed:
+			return false, nil
+		}
+	}
+	return false, nil
+}
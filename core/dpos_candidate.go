@@ -0,0 +1,238 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// candidate metadata limits
+const (
+	MaxCandidateNameLength = 64
+	MaxCandidateURLLength  = 256
+)
+
+// CandidateInfo is the registration of a candidate kept in the candidate trie.
+// Candidates registered before ForkCandidateMetadata only have the address.
+type CandidateInfo struct {
+	Address    *Address
+	Name       string
+	URL        string
+	Endpoint   string
+	Commission uint32
+	Deposit    *util.Uint128
+}
+
+func (c *CandidateInfo) verify() error {
+	if len(c.Name) > MaxCandidateNameLength || len(c.URL) > MaxCandidateURLLength || len(c.Endpoint) > MaxCandidateURLLength {
+		return ErrInvalidCandidateMetadata
+	}
+	if c.Commission > MaxCommission {
+		return ErrInvalidCommission
+	}
+	return nil
+}
+
+// ToBytes serialize the candidate info as the candidate trie value
+func (c *CandidateInfo) ToBytes() ([]byte, error) {
+	deposit, err := c.Deposit.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&corepb.Candidate{
+		Address:    c.Address.Bytes(),
+		Name:       c.Name,
+		Url:        c.URL,
+		Endpoint:   c.Endpoint,
+		Commission: c.Commission,
+		Deposit:    deposit,
+	})
+}
+
+// parseCandidateInfo parses a candidate trie value, which is the bare address before ForkCandidateMetadata.
+func parseCandidateInfo(value []byte) (*CandidateInfo, error) {
+	if len(value) == AddressLength {
+		addr, err := AddressParseFromBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return &CandidateInfo{Address: addr, Deposit: util.NewUint128()}, nil
+	}
+	msg := new(corepb.Candidate)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+	addr, err := AddressParseFromBytes(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := util.NewUint128FromFixedSizeByteSlice(msg.Deposit)
+	if err != nil {
+		return nil, err
+	}
+	return &CandidateInfo{
+		Address:    addr,
+		Name:       msg.Name,
+		URL:        msg.Url,
+		Endpoint:   msg.Endpoint,
+		Commission: msg.Commission,
+		Deposit:    deposit,
+	}, nil
+}
+
+func getCandidateInfo(candidateTrie *trie.BatchTrie, candidate byteutils.Hash) (*CandidateInfo, error) {
+	bytes, err := candidateTrie.Get(candidate)
+	if err != nil {
+		return nil, err
+	}
+	return parseCandidateInfo(bytes)
+}
+
+// refundDeposit returns the deposit of a leaving candidate after the unbonding period from release.
+func refundDeposit(candidateTrie *trie.BatchTrie, stakeTrie *trie.BatchTrie, candidate byteutils.Hash, release uint64) error {
+	info, err := getCandidateInfo(candidateTrie, candidate)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return unbondDeposit(stakeTrie, candidate, info.Deposit, release)
+}
+
+// unbondDeposit returns value of the candidate deposit at the release height.
+func unbondDeposit(stakeTrie *trie.BatchTrie, candidate byteutils.Hash, value *util.Uint128, release uint64) error {
+	if value.Sign() == 0 {
+		return nil
+	}
+	key := unbondingStakeKey(release, candidate)
+	unbonding, err := getIndexedStake(stakeTrie, key)
+	if err != nil {
+		return err
+	}
+	unbonding.Add(unbonding.Int, value.Int)
+	return putIndexedStake(stakeTrie, key, candidate, unbonding)
+}
+
+// registerCandidate stores the candidate with its metadata, the deposit is locked at the first registration.
+// Candidates registered before ForkCandidateMetadata hold no deposit, it is locked when they register again.
+func (block *Block) registerCandidate(info *CandidateInfo) error {
+	if err := info.verify(); err != nil {
+		return err
+	}
+	candidate := info.Address.Bytes()
+	registered, err := getCandidateInfo(block.dposContext.candidateTrie, candidate)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == nil && registered.Deposit.Sign() > 0 {
+		info.Deposit = registered.Deposit
+	} else {
+		params, err := block.GovernedParams()
+		if err != nil {
+			return err
+		}
+		info.Deposit = params.CandidateDeposit
+		acc, err := block.accState.GetOrCreateUserAccount(candidate)
+		if err != nil {
+			return err
+		}
+		if err := acc.SubBalance(info.Deposit); err != nil {
+			return err
+		}
+	}
+	bytes, err := info.ToBytes()
+	if err != nil {
+		return err
+	}
+	_, err = block.dposContext.candidateTrie.Put(candidate, bytes)
+	return err
+}
+
+// logoutCandidate removes the candidate and schedules the return of its deposit.
+func (block *Block) logoutCandidate(candidate byteutils.Hash) error {
+	params, err := block.GovernedParams()
+	if err != nil {
+		return err
+	}
+	release := block.height + params.UnbondingPeriod
+	if err := refundDeposit(block.dposContext.candidateTrie, block.dposContext.stakeTrie, candidate, release); err != nil {
+		return err
+	}
+	return block.dposContext.kickoutCandidate(candidate)
+}
+
+// slashDeposit burns percent of the deposit of a candidate proved double signing,
+// the rest is returned after the unbonding period like a logout.
+func (block *Block) slashDeposit(candidate byteutils.Hash, percent int) (*util.Uint128, error) {
+	burned := util.NewUint128()
+	info, err := getCandidateInfo(block.dposContext.candidateTrie, candidate)
+	if err == storage.ErrKeyNotFound {
+		return burned, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	burned.Mul(info.Deposit.Int, big.NewInt(int64(percent)))
+	burned.Div(burned.Int, big.NewInt(100))
+	rest := util.NewUint128()
+	rest.Sub(info.Deposit.Int, burned.Int)
+
+	params, err := block.GovernedParams()
+	if err != nil {
+		return nil, err
+	}
+	release := block.height + params.UnbondingPeriod
+	if err := unbondDeposit(block.dposContext.stakeTrie, candidate, rest, release); err != nil {
+		return nil, err
+	}
+	return burned, nil
+}
+
+// TraverseCandidates return all candidates with their metadata
+func TraverseCandidates(candidates *trie.BatchTrie) ([]*CandidateInfo, error) {
+	infos := []*CandidateInfo{}
+	iter, err := candidates.Iterator(nil)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return infos, nil
+	}
+	exist, err := iter.Next()
+	for exist {
+		info, perr := parseCandidateInfo(iter.Value())
+		if perr != nil {
+			return nil, perr
+		}
+		infos = append(infos, info)
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandidatePayload_Metadata(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkCandidateMetadata, Height: 0}}
+	neb.genesis.Consensus.Dpos.CandidateDeposit = "500"
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	candidate, _ := NewAddressFromPublicKey(pubdata)
+	block, err := NewBlock(bc.ChainID(), mockAddress(), bc.tailBlock)
+	assert.Nil(t, err)
+	block.begin()
+	acc, _ := block.accState.GetOrCreateUserAccount(candidate.Bytes())
+	acc.AddBalance(util.NewUint128FromInt(1000))
+	balance := func() string {
+		balance, _ := block.GetBalance(candidate.Bytes())
+		return balance.String()
+	}
+	execute := func(payload *CandidatePayload) error {
+		tx := NewTransaction(bc.ChainID(), candidate, candidate, util.NewUint128(), 1, TxPayloadCandidateType, nil, TransactionGasPrice, TransactionMaxGas)
+		_, _, err := payload.Execute(block, tx)
+		return err
+	}
+
+	assert.Equal(t, ErrInvalidCandidateMetadata, execute(&CandidatePayload{Action: LoginAction, Name: strings.Repeat("n", MaxCandidateNameLength+1)}))
+	assert.Nil(t, execute(&CandidatePayload{Action: LoginAction, Name: "node", URL: "https://node.io", Endpoint: "/ip4/127.0.0.1/tcp/8680", Commission: 10}))
+	assert.Equal(t, "500", balance())
+
+	// registering again updates the metadata without another deposit
+	assert.Nil(t, execute(&CandidatePayload{Action: LoginAction, Name: "renamed", Commission: 10}))
+	assert.Equal(t, "500", balance())
+	infos, err := TraverseCandidates(block.dposContext.candidateTrie)
+	assert.Nil(t, err)
+	assert.Equal(t, len(MockDynasty)+1, len(infos))
+	info, err := getCandidateInfo(block.dposContext.candidateTrie, candidate.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, candidate.String(), info.Address.String())
+	assert.Equal(t, "renamed", info.Name)
+	assert.Equal(t, uint32(10), info.Commission)
+	assert.Equal(t, "500", info.Deposit.String())
+	commission, err := block.Commission(candidate)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(10), commission)
+
+	// candidates with metadata are tallied
+	dc := &DynastyContext{
+		DelegateTrie:  block.dposContext.delegateTrie,
+		CandidateTrie: block.dposContext.candidateTrie,
+		StakeTrie:     block.dposContext.stakeTrie,
+		Accounts:      block.accState,
+		Base:          block,
+	}
+	votes, err := dc.tallyVotes()
+	assert.Nil(t, err)
+	assert.Equal(t, "0", votes[candidate.String()].String())
+
+	// the deposit is returned after the unbonding period
+	assert.Nil(t, execute(&CandidatePayload{Action: LogoutAction}))
+	_, err = block.dposContext.candidateTrie.Get(candidate.Bytes())
+	assert.NotNil(t, err)
+	release := block.height + block.DposParams().UnbondingPeriod
+	deposit, _ := block.UnbondingStake(candidate, release)
+	assert.Equal(t, "500", deposit.String())
+	block.height = release
+	assert.Nil(t, block.releaseUnbondedStakes())
+	assert.Equal(t, "1000", balance())
+}
+
+func TestCandidatePayload_PreForkCandidate(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkCandidateMetadata, Height: 0}}
+	neb.genesis.Consensus.Dpos.CandidateDeposit = "500"
+	neb.genesis.Consensus.Dpos.DoubleSignBurnPercent = 30
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	candidate := mockAddress()
+	block, err := NewBlock(bc.ChainID(), mockAddress(), bc.tailBlock)
+	assert.Nil(t, err)
+	block.begin()
+	acc, _ := block.accState.GetOrCreateUserAccount(candidate.Bytes())
+	acc.AddBalance(util.NewUint128FromInt(1000))
+	// registered as a bare address before the fork, with a commission in the stake trie
+	_, err = block.dposContext.candidateTrie.Put(candidate.Bytes(), candidate.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, block.dposContext.setCommission(candidate.Bytes(), 20))
+	commission, err := block.Commission(candidate)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(20), commission)
+
+	// the deposit is locked when it registers again
+	payload := &CandidatePayload{Action: LoginAction, Name: "node", Commission: 10}
+	tx := NewTransaction(bc.ChainID(), candidate, candidate, util.NewUint128(), 1, TxPayloadCandidateType, nil, TransactionGasPrice, TransactionMaxGas)
+	_, _, err = payload.Execute(block, tx)
+	assert.Nil(t, err)
+	balance, _ := block.GetBalance(candidate.Bytes())
+	assert.Equal(t, "500", balance.String())
+	commission, err = block.Commission(candidate)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(10), commission)
+
+	// double signing burns part of the deposit, the rest is unbonded
+	burned, err := block.slashDeposit(candidate.Bytes(), block.DposParams().DoubleSignBurnPercent)
+	assert.Nil(t, err)
+	assert.Equal(t, "150", burned.String())
+	release := block.height + block.DposParams().UnbondingPeriod
+	deposit, _ := block.UnbondingStake(candidate, release)
+	assert.Equal(t, "350", deposit.String())
+}
@@ -35,25 +35,38 @@ import (
 )
 
 // Consensus Related Constants
+// BlockInterval, DynastyInterval, DynastySize, SafeSize and ConsensusSize
+// are the default values of the dpos params in genesis.
+// OfflineDynasties is the consecutive offline dynasties a validator is jailed after.
 const (
 	BlockInterval        = int64(5)
 	AcceptedNetWorkDelay = int64(2)
 	MaxMintDuration      = int64(2)
 	MinMintDuration      = int64(1)
-	DynastyInterval      = int64(60) // TODO(roy): 3600
-	DynastySize          = 6         // TODO(roy): 21
+	DynastyInterval      = int64(60)
+	DynastySize          = 6
 	SafeSize             = DynastySize/3 + 1
 	ConsensusSize        = DynastySize*2/3 + 1
+	UnbondingPeriod      = uint64(2160)
+	JailDynasties        = int64(6)
+	OfflineDynasties     = int64(3)
+	VotingPeriod         = uint64(2160)
+	BlockSizeLimit       = uint64(4 * 1024 * 1024)
+	MinBlockSizeLimit    = uint64(128 * 1024)
 )
 
+// BlockGasLimit is the default max gas used by the txs in a block, 10 times the max gas of a tx.
+var BlockGasLimit = util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionMaxGas.Int, util.NewUint128FromInt(10).Int))
+
 // DposContext carry context in dpos consensus
 type DposContext struct {
 	dynastyTrie     *trie.BatchTrie // key: delegatee, val: delegatee
 	nextDynastyTrie *trie.BatchTrie // key: delegatee, val: delegatee
 	delegateTrie    *trie.BatchTrie // key: delegatee + delegator, val: delegator
 	voteTrie        *trie.BatchTrie // key: delegator, val: delegatee
-	candidateTrie   *trie.BatchTrie // key: delegatee, val: delegatee
+	candidateTrie   *trie.BatchTrie // key: delegatee, val: delegatee or its metadata
 	mintCntTrie     *trie.BatchTrie // key: dynastyId + delegatee, val: count
+	stakeTrie       *trie.BatchTrie // staking state keyed by prefixes: stakes, rewards and validator liveness
 
 	storage storage.Storage
 }
@@ -84,6 +97,10 @@ func NewDposContext(storage storage.Storage) (*DposContext, error) {
 	if err != nil {
 		return nil, err
 	}
+	stakeTrie, err := trie.NewBatchTrie(nil, storage)
+	if err != nil {
+		return nil, err
+	}
 	return &DposContext{
 		dynastyTrie:     dynastyTrie,
 		nextDynastyTrie: nextDynastyTrie,
@@ -91,6 +108,7 @@ func NewDposContext(storage storage.Storage) (*DposContext, error) {
 		voteTrie:        voteTrie,
 		candidateTrie:   candidateTrie,
 		mintCntTrie:     mintCntTrie,
+		stakeTrie:       stakeTrie,
 		storage:         storage,
 	}, nil
 }
@@ -105,6 +123,7 @@ func (dc *DposContext) RootHash() byteutils.Hash {
 	hasher.Write(dc.voteTrie.RootHash())
 	hasher.Write(dc.candidateTrie.RootHash())
 	hasher.Write(dc.mintCntTrie.RootHash())
+	hasher.Write(dc.stakeTrie.RootHash())
 
 	return hasher.Sum(nil)
 }
@@ -118,6 +137,7 @@ func (dc *DposContext) BeginBatch() {
 	dc.candidateTrie.BeginBatch()
 	dc.voteTrie.BeginBatch()
 	dc.mintCntTrie.BeginBatch()
+	dc.stakeTrie.BeginBatch()
 }
 
 // Commit a batch task
@@ -128,6 +148,7 @@ func (dc *DposContext) Commit() {
 	dc.candidateTrie.Commit()
 	dc.voteTrie.Commit()
 	dc.mintCntTrie.Commit()
+	dc.stakeTrie.Commit()
 	// logging.VLog().Debug("DposContext Commit.")
 }
 
@@ -139,6 +160,7 @@ func (dc *DposContext) RollBack() {
 	dc.candidateTrie.RollBack()
 	dc.voteTrie.RollBack()
 	dc.mintCntTrie.RollBack()
+	dc.stakeTrie.RollBack()
 	// logging.VLog().Debug("DposContext RollBack.")
 }
 
@@ -167,6 +189,9 @@ func (dc *DposContext) Clone() (*DposContext, error) {
 	if context.mintCntTrie, err = dc.mintCntTrie.Clone(); err != nil {
 		return nil, ErrCloneMintCntTrie
 	}
+	if context.stakeTrie, err = dc.stakeTrie.Clone(); err != nil {
+		return nil, ErrCloneStakeTrie
+	}
 	return context, nil
 }
 
@@ -179,6 +204,7 @@ func (dc *DposContext) ToProto() (*corepb.DposContext, error) {
 		CandidateRoot:   dc.candidateTrie.RootHash(),
 		VoteRoot:        dc.voteTrie.RootHash(),
 		MintCntRoot:     dc.mintCntTrie.RootHash(),
+		StakeRoot:       dc.stakeTrie.RootHash(),
 	}, nil
 }
 
@@ -203,6 +229,9 @@ func (dc *DposContext) FromProto(msg *corepb.DposContext) error {
 	if dc.mintCntTrie, err = trie.NewBatchTrie(msg.MintCntRoot, dc.storage); err != nil {
 		return err
 	}
+	if dc.stakeTrie, err = trie.NewBatchTrie(msg.StakeRoot, dc.storage); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -217,8 +246,25 @@ type DynastyContext struct {
 	ProtectTrie     *trie.BatchTrie
 	VoteTrie        *trie.BatchTrie
 	MintCntTrie     *trie.BatchTrie
+	StakeTrie       *trie.BatchTrie
 	Accounts        state.AccountState
 	Storage         storage.Storage
+	Params          *DposParams
+
+	// Base is the block the context is built on.
+	Base    *Block
+	Elector DynastyElector
+}
+
+func (dc *DynastyContext) jailing() bool {
+	return dc.Base != nil && dc.Base.ChainRules().IsJailing
+}
+
+func (dc *DynastyContext) tally() (map[string]*util.Uint128, error) {
+	if dc.Elector != nil {
+		return dc.Elector.Tally(dc)
+	}
+	return dc.tallyVotes()
 }
 
 func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
@@ -226,6 +272,7 @@ func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
 	delegate := dc.DelegateTrie
 	candidates := dc.CandidateTrie
 	accounts := dc.Accounts
+	staking := dc.Base != nil && dc.Base.ChainRules().IsStaking
 	iterCandidates, err := candidates.Iterator(nil)
 	if err != nil && err != storage.ErrKeyNotFound {
 		return nil, err
@@ -238,10 +285,11 @@ func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
 		return nil, err
 	}
 	for existCandidates {
-		delegatee, err := AddressParseFromBytes(iterCandidates.Value())
+		info, err := parseCandidateInfo(iterCandidates.Value())
 		if err != nil {
 			return nil, err
 		}
+		delegatee := info.Address
 		iterDelegate, err := delegate.Iterator(delegatee.Bytes())
 		if err != nil && err != storage.ErrKeyNotFound {
 			return nil, err
@@ -267,11 +315,20 @@ func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
 			if !ok {
 				score = util.NewUint128()
 			}
-			acc, err := accounts.GetOrCreateUserAccount(delegator.Bytes())
-			if err != nil {
-				return nil, err
+			var weight *util.Uint128
+			if staking {
+				// only the bonded stake counts once staking is enabled
+				weight, err = getStake(dc.StakeTrie, bondedStakeKey(delegator.Bytes()))
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				acc, err := accounts.GetOrCreateUserAccount(delegator.Bytes())
+				if err != nil {
+					return nil, err
+				}
+				weight = acc.Balance()
 			}
-			weight := acc.Balance()
 			score.Add(score.Int, weight.Int)
 			votes[delegatee.String()] = score
 			existDelegate, err = iterDelegate.Next()
@@ -465,10 +522,26 @@ func (dc *DposContext) kickoutCandidate(candidate byteutils.Hash) error {
 }
 
 func (dc *DynastyContext) kickoutCandidate(candidate byteutils.Hash) error {
+	// the deposit of a kicked out candidate is returned as if it logged out in the next block.
+	if dc.Base != nil {
+		params, err := loadGovernedParams(dc.StakeTrie, dc.Params)
+		if err != nil {
+			return err
+		}
+		release := dc.Base.height + 1 + params.UnbondingPeriod
+		if err := refundDeposit(dc.CandidateTrie, dc.StakeTrie, candidate, release); err != nil {
+			return err
+		}
+	}
 	return kickout(dc.Storage, dc.CandidateTrie, dc.DelegateTrie, dc.VoteTrie, candidate)
 }
 
 func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
+	// offline validators are jailed instead since ForkJailing.
+	if dc.jailing() {
+		return dc.jailDynasty(dynastyID)
+	}
+
 	// startAt := time.Now().Unix()
 
 	dynastyTrie := dc.DynastyTrie
@@ -497,7 +570,7 @@ func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
 		}
 		if err != storage.ErrKeyNotFound {
 			cnt := byteutils.Int64(bytes)
-			if cnt >= DynastyInterval/BlockInterval/DynastySize/2 {
+			if cnt >= dc.Params.DynastyInterval/dc.Params.BlockInterval/int64(dc.Params.DynastySize)/2 {
 				exist, err = iter.Next()
 				if err != nil {
 					return err
@@ -568,17 +641,22 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 		}
 		// kickAt := time.Now().Unix()
 
-		votes, err := dc.tallyVotes()
+		votes, err := dc.tally()
 		if err != nil {
 			return err
 		}
+		if dc.jailing() {
+			if err := dc.excludeJailed(votes); err != nil {
+				return err
+			}
+		}
 		// tallyAt := time.Now().Unix()
 
 		candidates, err := dc.chooseCandidates(votes)
 		if err != nil {
 			return err
 		}
-		if len(candidates) < SafeSize {
+		if len(candidates) < dc.Params.SafeSize {
 			return ErrTooFewCandidates
 		}
 		// chooseAt := time.Now().Unix()
@@ -586,7 +664,7 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 		// Top 20 are selected directly
 		newDynasty := []string{}
 		nextDynastyTrie, err := trie.NewBatchTrie(nil, dc.Storage)
-		directSelected := DynastySize - 1
+		directSelected := dc.Params.DynastySize - 1
 		for i := 0; i < directSelected && i < len(candidates); i++ {
 			delegatee := candidates[i].Address.Bytes()
 			_, err := nextDynastyTrie.Put(delegatee, delegatee)
@@ -607,7 +685,7 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 			hasher.Write(byteutils.FromInt64(nextDynastyID))
 			hasher.Write(accState)
 			result := int(hasher.Sum32()) % (len(candidates) - directSelected)
-			offset := result + DynastySize - 1
+			offset := result + dc.Params.DynastySize - 1
 			delegatee := candidates[offset].Address.Bytes()
 			_, err = nextDynastyTrie.Put(delegatee, delegatee)
 			if err != nil {
@@ -666,6 +744,10 @@ func (block *Block) LoadDynastyContext(context *DynastyContext) error {
 	if err != nil {
 		return err
 	}
+	stakeTrie, err := context.StakeTrie.Clone()
+	if err != nil {
+		return err
+	}
 	block.dposContext = &DposContext{
 		dynastyTrie:     dynastyTrie,
 		nextDynastyTrie: nextDynastyTrie,
@@ -673,6 +755,7 @@ func (block *Block) LoadDynastyContext(context *DynastyContext) error {
 		candidateTrie:   candidateTrie,
 		voteTrie:        voteTrie,
 		mintCntTrie:     mintCntTrie,
+		stakeTrie:       stakeTrie,
 		storage:         block.storage,
 	}
 	return nil
@@ -700,7 +783,15 @@ func GenesisDynastyContext(chain *BlockChain, conf *corepb.Genesis) (*DynastyCon
 	if err != nil {
 		return nil, err
 	}
-	if len(conf.Consensus.Dpos.Dynasty) < SafeSize {
+	stakeTrie, err := trie.NewBatchTrie(nil, chain.storage)
+	if err != nil {
+		return nil, err
+	}
+	params, err := NewDposParams(conf.Consensus.Dpos)
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.Consensus.Dpos.Dynasty) < params.SafeSize {
 		return nil, ErrInitialDynastyNotEnough
 	}
 	for i := 0; i < len(conf.Consensus.Dpos.Dynasty); i++ {
@@ -710,7 +801,7 @@ func GenesisDynastyContext(chain *BlockChain, conf *corepb.Genesis) (*DynastyCon
 			return nil, err
 		}
 		v := member.Bytes()
-		if i < DynastySize {
+		if i < params.DynastySize {
 			if _, err = dynastyTrie.Put(v, v); err != nil {
 				return nil, err
 			}
@@ -743,17 +834,19 @@ func GenesisDynastyContext(chain *BlockChain, conf *corepb.Genesis) (*DynastyCon
 		ProtectTrie:     protectTrie,
 		MintCntTrie:     mintTrie,
 		VoteTrie:        voteTrie,
+		StakeTrie:       stakeTrie,
+		Params:          params,
 	}, nil
 }
 
 // FindProposer for now in given dynasty
-func FindProposer(now int64, dynasty *trie.BatchTrie) (proposer byteutils.Hash, err error) {
-	offset := now % DynastyInterval
-	if offset%BlockInterval != 0 {
+func (p *DposParams) FindProposer(now int64, dynasty *trie.BatchTrie) (proposer byteutils.Hash, err error) {
+	offset := now % p.DynastyInterval
+	if offset%p.BlockInterval != 0 {
 		return nil, ErrNotBlockForgTime
 	}
-	offset /= BlockInterval
-	offset %= DynastySize
+	offset /= p.BlockInterval
+	offset %= int64(p.DynastySize)
 	delegatees, err := TraverseDynasty(dynasty)
 	if err != nil {
 		return nil, err
@@ -773,7 +866,8 @@ func FindProposer(now int64, dynasty *trie.BatchTrie) (proposer byteutils.Hash,
 
 // NextDynastyContext when some seconds elapsed
 func (block *Block) NextDynastyContext(chain *BlockChain, elapsedSecond int64) (*DynastyContext, error) {
-	if elapsedSecond%BlockInterval != 0 {
+	params := chain.DposParams()
+	if elapsedSecond%params.BlockInterval != 0 {
 		return nil, ErrNotBlockForgTime
 	}
 
@@ -805,6 +899,10 @@ func (block *Block) NextDynastyContext(chain *BlockChain, elapsedSecond int64) (
 	if err != nil {
 		return nil, err
 	}
+	stakeTrie, err := block.dposContext.stakeTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
 
 	context := &DynastyContext{
 		TimeStamp:       block.header.timestamp + elapsedSecond,
@@ -815,13 +913,22 @@ func (block *Block) NextDynastyContext(chain *BlockChain, elapsedSecond int64) (
 		ProtectTrie:     protectTrie,
 		VoteTrie:        voteTrie,
 		MintCntTrie:     mintCntTrie,
+		StakeTrie:       stakeTrie,
 		Accounts:        block.accState,
 		Storage:         block.storage,
+		Params:          params,
+		Base:            block,
+		Elector:         chain.dynastyElector,
 	}
 
-	baseDynastyID := block.header.timestamp / DynastyInterval
-	newDynastyID := context.TimeStamp / DynastyInterval
+	baseDynastyID := block.header.timestamp / params.DynastyInterval
+	newDynastyID := context.TimeStamp / params.DynastyInterval
 	if baseDynastyID < newDynastyID {
+		if chain.ChainRules(block.height + 1).IsRewardSharing {
+			if err := context.distributeRewards(baseDynastyID); err != nil {
+				return nil, err
+			}
+		}
 		if baseDynastyID+1 < newDynastyID {
 			// do not kickout genesis dynasty
 			err = context.electNextDynastyOnBaseDynasty(baseDynastyID, newDynastyID-1, baseDynastyID == 0)
@@ -836,7 +943,7 @@ func (block *Block) NextDynastyContext(chain *BlockChain, elapsedSecond int64) (
 		}
 	}
 
-	context.Proposer, err = FindProposer(context.TimeStamp, context.DynastyTrie)
+	context.Proposer, err = params.FindProposer(context.TimeStamp, context.DynastyTrie)
 	if err != nil {
 		return nil, err
 	}
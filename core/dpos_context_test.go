@@ -282,3 +282,43 @@ func TestInitialDynastyNotEnough(t *testing.T) {
 	_, err := NewBlockChain(neb)
 	assert.Equal(t, err, ErrInitialDynastyNotEnough)
 }
+
+func TestBlock_ProposerSchedule(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	current, next, err := bc.tailBlock.ProposerSchedule(bc)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), current.Serial)
+	assert.Equal(t, int64(1), next.Serial)
+	assert.Equal(t, DynastyInterval, next.Start)
+	assert.Equal(t, int(DynastyInterval/BlockInterval), len(current.Slots))
+	assert.Equal(t, int(DynastyInterval/BlockInterval), len(next.Slots))
+	assert.Equal(t, BlockInterval, current.Slots[1].Timestamp)
+	for i, slot := range current.Slots {
+		assert.Equal(t, current.Slots[i%DynastySize].Proposer, slot.Proposer)
+	}
+	assert.Equal(t, DynastySize, len(current.Stats))
+	for _, stat := range current.Stats {
+		assert.Equal(t, int64(0), stat.Expected)
+	}
+
+	// mint in the 3rd slot, the 2nd slot is missed
+	context, err := bc.tailBlock.NextDynastyContext(bc, 2*BlockInterval)
+	assert.Nil(t, err)
+	miner, err := AddressParseFromBytes(context.Proposer)
+	assert.Nil(t, err)
+	block, err := NewBlock(bc.ChainID(), miner, bc.tailBlock)
+	assert.Nil(t, err)
+	block.SetTimestamp(2 * BlockInterval)
+	assert.Nil(t, block.LoadDynastyContext(context))
+	block.SetMiner(miner)
+	assert.Nil(t, block.Seal())
+
+	current, _, err = block.ProposerSchedule(bc)
+	assert.Nil(t, err)
+	assert.Equal(t, &MintStat{Validator: current.Slots[0].Proposer}, current.Stats[0])
+	assert.Equal(t, &MintStat{Validator: current.Slots[1].Proposer, Expected: 1, Missed: 1}, current.Stats[1])
+	assert.Equal(t, &MintStat{Validator: current.Slots[2].Proposer, Expected: 1, Minted: 1}, current.Stats[2])
+	assert.Equal(t, byteutils.Hash(miner.Bytes()), current.Stats[2].Validator)
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// LivenessRecord is the slots a validator was expected to produce and produced in a dynasty.
+type LivenessRecord struct {
+	Serial   int64
+	Expected int64
+	Produced int64
+}
+
+// Offline returns true if the validator produced less than half of its slots.
+func (r *LivenessRecord) Offline() bool {
+	return r.Produced*2 < r.Expected
+}
+
+// JailRecord is a jailed validator and the dynasty it can unjail from.
+type JailRecord struct {
+	Validator byteutils.Hash
+	Release   int64
+}
+
+func livenessKey(validator byteutils.Hash, serial int64) []byte {
+	key := append([]byte{livenessPrefix}, validator...)
+	return append(key, byteutils.FromInt64(serial)...)
+}
+
+func jailKey(validator byteutils.Hash) []byte {
+	return append([]byte{jailPrefix}, validator...)
+}
+
+func putLiveness(stakeTrie *trie.BatchTrie, validator byteutils.Hash, record *LivenessRecord) error {
+	value := append(byteutils.FromInt64(record.Serial), byteutils.FromInt64(record.Expected)...)
+	value = append(value, byteutils.FromInt64(record.Produced)...)
+	_, err := stakeTrie.Put(livenessKey(validator, record.Serial), value)
+	return err
+}
+
+func livenessRecords(stakeTrie *trie.BatchTrie, validator byteutils.Hash) ([]*LivenessRecord, error) {
+	records := []*LivenessRecord{}
+	iter, err := stakeTrie.Iterator(append([]byte{livenessPrefix}, validator...))
+	if err == storage.ErrKeyNotFound {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		value := iter.Value()
+		records = append(records, &LivenessRecord{
+			Serial:   byteutils.Int64(value[:8]),
+			Expected: byteutils.Int64(value[8:16]),
+			Produced: byteutils.Int64(value[16:]),
+		})
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func getJail(stakeTrie *trie.BatchTrie, validator byteutils.Hash) (*JailRecord, error) {
+	bytes, err := stakeTrie.Get(jailKey(validator))
+	if err != nil {
+		return nil, err
+	}
+	return parseJail(bytes)
+}
+
+func parseJail(value []byte) (*JailRecord, error) {
+	if len(value) != AddressLength+8 {
+		return nil, ErrInvalidAddressDataLength
+	}
+	return &JailRecord{Validator: value[:AddressLength], Release: byteutils.Int64(value[AddressLength:])}, nil
+}
+
+func putJail(stakeTrie *trie.BatchTrie, record *JailRecord) error {
+	value := append(append([]byte{}, record.Validator...), byteutils.FromInt64(record.Release)...)
+	_, err := stakeTrie.Put(jailKey(record.Validator), value)
+	return err
+}
+
+// jailDynasty records the liveness of the validators in the dynasty and prunes
+// the records older than the last OfflineDynasties dynasties, the validators
+// offline in all of them except active bootstrap ones are jailed.
+func (dc *DynastyContext) jailDynasty(serial int64) error {
+	schedule, err := newDynastySchedule(dc.Params, serial, dc.DynastyTrie)
+	if err != nil {
+		return err
+	}
+	params, err := loadGovernedParams(dc.StakeTrie, dc.Params)
+	if err != nil {
+		return err
+	}
+	expected := make(map[byteutils.HexHash]int64)
+	for _, slot := range schedule.Slots {
+		if slot.Proposer != nil {
+			expected[slot.Proposer.Hex()]++
+		}
+	}
+
+	validators, err := TraverseDynasty(dc.DynastyTrie)
+	if err != nil {
+		return err
+	}
+	for _, validator := range validators {
+		record := &LivenessRecord{Serial: serial, Expected: expected[validator.Hex()]}
+		bytes, err := dc.MintCntTrie.Get(append(byteutils.FromInt64(serial), validator...))
+		if err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		if err == nil {
+			record.Produced = byteutils.Int64(bytes)
+		}
+		if err := putLiveness(dc.StakeTrie, validator, record); err != nil {
+			return err
+		}
+		records, err := livenessRecords(dc.StakeTrie, validator)
+		if err != nil {
+			return err
+		}
+		window := []*LivenessRecord{}
+		offline := int64(0)
+		for _, r := range records {
+			if r.Serial <= serial-OfflineDynasties {
+				if _, err := dc.StakeTrie.Del(livenessKey(validator, r.Serial)); err != nil {
+					return err
+				}
+				continue
+			}
+			window = append(window, r)
+			if r.Offline() {
+				offline++
+			}
+		}
+		if offline < OfflineDynasties {
+			continue
+		}
+
+		isActiveBootstrapValidator, err := checkActiveBootstrapValidator(validator, dc.ProtectTrie, dc.CandidateTrie)
+		if err != nil {
+			return err
+		}
+		if isActiveBootstrapValidator {
+			continue
+		}
+		jail := &JailRecord{Validator: validator, Release: serial + params.JailDynasties}
+		if err := putJail(dc.StakeTrie, jail); err != nil {
+			return err
+		}
+		// a released validator starts with a clean liveness history
+		for _, r := range window {
+			if _, err := dc.StakeTrie.Del(livenessKey(validator, r.Serial)); err != nil {
+				return err
+			}
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"validator": validator.Hex(),
+			"dynasty":   serial,
+			"expected":  record.Expected,
+			"produced":  record.Produced,
+			"release":   jail.Release,
+		}).Info("Jailed offline validator.")
+	}
+	return nil
+}
+
+// excludeJailed removes the jailed candidates from the election.
+func (dc *DynastyContext) excludeJailed(votes map[string]*util.Uint128) error {
+	for candidate := range votes {
+		addr, err := AddressParse(candidate)
+		if err != nil {
+			return err
+		}
+		_, err = dc.StakeTrie.Get(jailKey(addr.Bytes()))
+		if err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		if err == nil {
+			delete(votes, candidate)
+		}
+	}
+	return nil
+}
+
+// LivenessRecords returns the liveness history of the validator in the last dynasties.
+func (block *Block) LivenessRecords(addr *Address) ([]*LivenessRecord, error) {
+	return livenessRecords(block.dposContext.stakeTrie, addr.Bytes())
+}
+
+// Jail returns the jail record of the validator, nil if not jailed.
+func (block *Block) Jail(addr *Address) (*JailRecord, error) {
+	record, err := getJail(block.dposContext.stakeTrie, addr.Bytes())
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	return record, err
+}
+
+// JailedValidators returns the validators jailed at the block.
+func (block *Block) JailedValidators() ([]*JailRecord, error) {
+	records := []*JailRecord{}
+	iter, err := block.dposContext.stakeTrie.Iterator([]byte{jailPrefix})
+	if err == storage.ErrKeyNotFound {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		record, perr := parseJail(iter.Value())
+		if perr != nil {
+			return nil, perr
+		}
+		records = append(records, record)
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
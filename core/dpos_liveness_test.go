@@ -0,0 +1,122 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynastyContext_JailDynasty(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkJailing, Height: 0}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	block, err := NewBlock(bc.ChainID(), mockAddress(), bc.tailBlock)
+	assert.Nil(t, err)
+	block.begin()
+	validators, err := TraverseDynasty(block.dposContext.dynastyTrie)
+	assert.Nil(t, err)
+	online, offline := validators[0], validators[1]
+
+	params := bc.DposParams()
+	// no bootstrap validator is protected
+	protectTrie, _ := trie.NewBatchTrie(nil, bc.storage)
+	dc := &DynastyContext{
+		DynastyTrie:   block.dposContext.dynastyTrie,
+		CandidateTrie: block.dposContext.candidateTrie,
+		ProtectTrie:   protectTrie,
+		MintCntTrie:   block.dposContext.mintCntTrie,
+		StakeTrie:     block.dposContext.stakeTrie,
+		Params:        params,
+		Base:          block,
+	}
+	assert.True(t, dc.jailing())
+	onlineAddr, _ := AddressParseFromBytes(online)
+	offlineAddr, _ := AddressParseFromBytes(offline)
+
+	// the validators are jailed after consecutive offline dynasties
+	expected := make(map[int64]int64)
+	for serial := int64(1); serial <= OfflineDynasties+1; serial++ {
+		schedule, err := newDynastySchedule(params, serial, block.dposContext.dynastyTrie)
+		assert.Nil(t, err)
+		for _, slot := range schedule.Slots {
+			if online.Equals(slot.Proposer) {
+				expected[serial]++
+			}
+		}
+		_, err = block.dposContext.mintCntTrie.Put(append(byteutils.FromInt64(serial), online...), byteutils.FromInt64(expected[serial]))
+		assert.Nil(t, err)
+		assert.Nil(t, dc.jailDynasty(serial))
+
+		if serial < OfflineDynasties {
+			jail, err := block.Jail(offlineAddr)
+			assert.Nil(t, err)
+			assert.Nil(t, jail)
+		}
+	}
+	serial := OfflineDynasties
+
+	// the records out of the window are pruned
+	records, err := block.LivenessRecords(onlineAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, int(OfflineDynasties), len(records))
+	assert.Equal(t, &LivenessRecord{Serial: 2, Expected: expected[2], Produced: expected[2]}, records[0])
+	jail, err := block.Jail(onlineAddr)
+	assert.Nil(t, err)
+	assert.Nil(t, jail)
+
+	// the history of a jailed validator is cleared
+	records, _ = block.LivenessRecords(offlineAddr)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, serial+1, records[0].Serial)
+	assert.True(t, records[0].Offline())
+	jail, err = block.Jail(offlineAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, serial+params.JailDynasties, jail.Release)
+	jailed, err := block.JailedValidators()
+	assert.Nil(t, err)
+	assert.Equal(t, len(validators)-1, len(jailed))
+
+	// jailed validators are not elected
+	votes := map[string]*util.Uint128{onlineAddr.String(): util.NewUint128(), offlineAddr.String(): util.NewUint128()}
+	assert.Nil(t, dc.excludeJailed(votes))
+	assert.Equal(t, 1, len(votes))
+	assert.NotNil(t, votes[onlineAddr.String()])
+
+	// the validator can unjail after the jail period
+	unjail := func() error {
+		tx := NewTransaction(bc.ChainID(), offlineAddr, offlineAddr, util.NewUint128(), 1, TxPayloadUnjailType, nil, TransactionGasPrice, TransactionMaxGas)
+		_, _, err := NewUnjailPayload().Execute(block, tx)
+		return err
+	}
+	block.header.timestamp = (jail.Release - 1) * params.DynastyInterval
+	assert.Equal(t, ErrValidatorStillJailed, unjail())
+	block.header.timestamp = jail.Release * params.DynastyInterval
+	assert.Nil(t, unjail())
+	assert.Equal(t, ErrValidatorNotJailed, unjail())
+	jail, _ = block.Jail(offlineAddr)
+	assert.Nil(t, jail)
+}
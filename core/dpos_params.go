@@ -0,0 +1,175 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// DposParams is the dpos consensus parameters configured in genesis.
+type DposParams struct {
+	BlockInterval   int64
+	DynastyInterval int64
+	DynastySize     int
+	SafeSize        int
+	ConsensusSize   int
+
+	// DoubleSignBurnPercent of the deposit and bonded stake is burned when a miner is proved double signing.
+	DoubleSignBurnPercent int
+
+	// UnbondingPeriod is the blocks before undelegated stake becomes spendable.
+	UnbondingPeriod uint64
+
+	// CandidateDeposit is locked by a candidate registration.
+	CandidateDeposit *util.Uint128
+
+	// JailDynasties is the dynasties an offline validator is jailed.
+	JailDynasties int64
+
+	// VotingPeriod is the blocks a governance proposal is open for voting.
+	VotingPeriod uint64
+
+	// BlockGasLimit is the max gas used by the txs in a block, at least the max gas of a tx.
+	BlockGasLimit *util.Uint128
+
+	// BlockSizeLimit is the max bytes of the txs in a block, at least MinBlockSizeLimit.
+	BlockSizeLimit uint64
+}
+
+// DefaultDposParams is the params of chains created before the dpos config is stored in genesis.
+var DefaultDposParams, _ = NewDposParams(nil)
+
+// NewDposParams create dpos params from genesis, params not set use the default values.
+func NewDposParams(conf *corepb.GenesisConsensusDpos) (*DposParams, error) {
+	if conf == nil {
+		conf = &corepb.GenesisConsensusDpos{}
+	}
+
+	params := &DposParams{
+		BlockInterval:   BlockInterval,
+		DynastyInterval: DynastyInterval,
+		DynastySize:     DynastySize,
+	}
+	if conf.BlockInterval != 0 {
+		params.BlockInterval = conf.BlockInterval
+	}
+	if conf.DynastyInterval != 0 {
+		params.DynastyInterval = conf.DynastyInterval
+	}
+	if conf.DynastySize != 0 {
+		params.DynastySize = int(conf.DynastySize)
+	}
+	params.SafeSize = params.DynastySize/3 + 1
+	if conf.SafeSize != 0 {
+		params.SafeSize = int(conf.SafeSize)
+	}
+	params.ConsensusSize = params.DynastySize*2/3 + 1
+	if conf.ConsensusSize != 0 {
+		params.ConsensusSize = int(conf.ConsensusSize)
+	}
+	params.DoubleSignBurnPercent = int(conf.DoubleSignBurnPercent)
+	params.UnbondingPeriod = UnbondingPeriod
+	if conf.UnbondingPeriod != 0 {
+		params.UnbondingPeriod = conf.UnbondingPeriod
+	}
+	params.CandidateDeposit = util.NewUint128()
+	if len(conf.CandidateDeposit) > 0 {
+		deposit, ok := util.NewUint128().FromString(conf.CandidateDeposit)
+		if !ok || deposit.Sign() < 0 || deposit.Validate() != nil {
+			return nil, ErrInvalidGenesisCandidateDeposit
+		}
+		params.CandidateDeposit = deposit
+	}
+	params.JailDynasties = JailDynasties
+	if conf.JailDynasties != 0 {
+		params.JailDynasties = int64(conf.JailDynasties)
+	}
+	params.VotingPeriod = VotingPeriod
+	if conf.VotingPeriod != 0 {
+		params.VotingPeriod = conf.VotingPeriod
+	}
+	params.BlockGasLimit = BlockGasLimit
+	if len(conf.BlockGasLimit) > 0 {
+		limit, ok := util.NewUint128().FromString(conf.BlockGasLimit)
+		if !ok || limit.Validate() != nil {
+			return nil, ErrInvalidGenesisBlockGasLimit
+		}
+		params.BlockGasLimit = limit
+	}
+	params.BlockSizeLimit = BlockSizeLimit
+	if conf.BlockSizeLimit != 0 {
+		params.BlockSizeLimit = conf.BlockSizeLimit
+	}
+
+	if err := params.verify(); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func (p *DposParams) verify() error {
+	if p.BlockInterval <= 0 {
+		return ErrInvalidGenesisBlockInterval
+	}
+	if p.DynastySize <= 0 {
+		return ErrInvalidGenesisDynastySize
+	}
+	// each validator mints at least once in a dynasty
+	if p.DynastyInterval%p.BlockInterval != 0 || p.DynastyInterval < p.BlockInterval*int64(p.DynastySize) {
+		return ErrInvalidGenesisDynastyInterval
+	}
+	if p.SafeSize <= 0 || p.SafeSize > p.DynastySize {
+		return ErrInvalidGenesisSafeSize
+	}
+	// more than 2/3 miners are required to make blocks irreversible
+	if p.ConsensusSize <= p.DynastySize*2/3 || p.ConsensusSize > p.DynastySize {
+		return ErrInvalidGenesisConsensusSize
+	}
+	if p.DoubleSignBurnPercent > 100 {
+		return ErrInvalidGenesisBurnPercent
+	}
+	// a tx with the max gas limit always fits in a block
+	if p.BlockGasLimit.Cmp(TransactionMaxGas.Int) < 0 {
+		return ErrInvalidGenesisBlockGasLimit
+	}
+	if p.BlockSizeLimit < MinBlockSizeLimit {
+		return ErrInvalidGenesisBlockSizeLimit
+	}
+	return nil
+}
+
+// ToProto converts params to proto, all params are set.
+func (p *DposParams) ToProto() *corepb.GenesisConsensusDpos {
+	return &corepb.GenesisConsensusDpos{
+		BlockInterval:   p.BlockInterval,
+		DynastyInterval: p.DynastyInterval,
+		DynastySize:     uint32(p.DynastySize),
+		SafeSize:        uint32(p.SafeSize),
+		ConsensusSize:   uint32(p.ConsensusSize),
+
+		DoubleSignBurnPercent: uint32(p.DoubleSignBurnPercent),
+		UnbondingPeriod:       p.UnbondingPeriod,
+		CandidateDeposit:      p.CandidateDeposit.String(),
+		JailDynasties:         uint32(p.JailDynasties),
+		VotingPeriod:          p.VotingPeriod,
+		BlockGasLimit:         p.BlockGasLimit.String(),
+		BlockSizeLimit:        p.BlockSizeLimit,
+	}
+}
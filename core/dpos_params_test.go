@@ -0,0 +1,95 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDposParams(t *testing.T) {
+	params, err := NewDposParams(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, &DposParams{BlockInterval, DynastyInterval, DynastySize, SafeSize, ConsensusSize, 0, UnbondingPeriod, util.NewUint128(), JailDynasties, VotingPeriod, BlockGasLimit, BlockSizeLimit}, params)
+
+	params, err = NewDposParams(&corepb.GenesisConsensusDpos{BlockInterval: 15, DynastyInterval: 3150, DynastySize: 21})
+	assert.Nil(t, err)
+	assert.Equal(t, &DposParams{15, 3150, 21, 8, 15, 0, UnbondingPeriod, util.NewUint128(), JailDynasties, VotingPeriod, BlockGasLimit, BlockSizeLimit}, params)
+
+	tests := []struct {
+		name   string
+		conf   *corepb.GenesisConsensusDpos
+		wanted error
+	}{
+		{"negative block interval", &corepb.GenesisConsensusDpos{BlockInterval: -1}, ErrInvalidGenesisBlockInterval},
+		{"dynasty interval not multiple of block interval", &corepb.GenesisConsensusDpos{DynastyInterval: 62}, ErrInvalidGenesisDynastyInterval},
+		{"dynasty interval too short", &corepb.GenesisConsensusDpos{DynastyInterval: 20}, ErrInvalidGenesisDynastyInterval},
+		{"safe size too large", &corepb.GenesisConsensusDpos{SafeSize: 7}, ErrInvalidGenesisSafeSize},
+		{"consensus size too small", &corepb.GenesisConsensusDpos{ConsensusSize: 4}, ErrInvalidGenesisConsensusSize},
+		{"consensus size too large", &corepb.GenesisConsensusDpos{ConsensusSize: 7}, ErrInvalidGenesisConsensusSize},
+		{"burn percent too large", &corepb.GenesisConsensusDpos{DoubleSignBurnPercent: 101}, ErrInvalidGenesisBurnPercent},
+		{"invalid candidate deposit", &corepb.GenesisConsensusDpos{CandidateDeposit: "-1"}, ErrInvalidGenesisCandidateDeposit},
+		{"zero block gas limit", &corepb.GenesisConsensusDpos{BlockGasLimit: "0"}, ErrInvalidGenesisBlockGasLimit},
+		{"block gas limit below tx max gas", &corepb.GenesisConsensusDpos{BlockGasLimit: "1000"}, ErrInvalidGenesisBlockGasLimit},
+		{"block size limit too small", &corepb.GenesisConsensusDpos{BlockSizeLimit: 1024}, ErrInvalidGenesisBlockSizeLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDposParams(tt.conf)
+			assert.Equal(t, tt.wanted, err)
+		})
+	}
+}
+
+func TestDposParams_Genesis(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Consensus.Dpos.BlockInterval = 10
+	neb.genesis.Consensus.Dpos.DynastyInterval = 120
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), bc.DposParams().BlockInterval)
+
+	// the params are persisted with genesis
+	genesis, err := DumpGenesis(bc.storage)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), genesis.Consensus.Dpos.BlockInterval)
+	assert.Equal(t, int64(120), genesis.Consensus.Dpos.DynastyInterval)
+	assert.Equal(t, uint32(ConsensusSize), genesis.Consensus.Dpos.ConsensusSize)
+
+	// blocks are minted at the configured interval
+	_, err = bc.tailBlock.NextDynastyContext(bc, BlockInterval)
+	assert.Equal(t, ErrNotBlockForgTime, err)
+	context, err := bc.tailBlock.NextDynastyContext(bc, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, bc.DposParams(), context.Params)
+
+	// the same storage can't be opened with different params
+	another := testNeb()
+	another.storage = neb.storage
+	_, err = NewBlockChain(another)
+	assert.Equal(t, ErrGenesisConfNotMatch, err)
+
+	another.genesis.Consensus.Dpos.BlockInterval = 10
+	another.genesis.Consensus.Dpos.DynastyInterval = 120
+	_, err = NewBlockChain(another)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,271 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxCommission is the max percent of rewards a validator keeps before sharing with delegators.
+const MaxCommission = 100
+
+func commissionKey(candidate byteutils.Hash) []byte {
+	return append([]byte{commissionPrefix}, candidate...)
+}
+
+func accruedRewardKey(dynastyID int64, miner byteutils.Hash) []byte {
+	key := append([]byte{accruedRewardPrefix}, byteutils.FromInt64(dynastyID)...)
+	return append(key, miner...)
+}
+
+func claimableRewardKey(addr byteutils.Hash) []byte {
+	return append([]byte{claimableRewardPrefix}, addr...)
+}
+
+// blockFeesKey keeps the fees collected by the transactions until the block is minted.
+func blockFeesKey() []byte {
+	return []byte{blockFeesPrefix}
+}
+
+// commission returns the commission registered by the candidate, a candidate not registered keeps nothing.
+// The commission is kept with the candidate metadata since ForkCandidateMetadata, in the stake trie before.
+func (dc *DposContext) commission(candidate byteutils.Hash) (uint32, error) {
+	value, err := dc.candidateTrie.Get(candidate)
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(value) != AddressLength {
+		info, err := parseCandidateInfo(value)
+		if err != nil {
+			return 0, err
+		}
+		return info.Commission, nil
+	}
+	bytes, err := dc.stakeTrie.Get(commissionKey(candidate))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint32(bytes), nil
+}
+
+func (dc *DposContext) setCommission(candidate byteutils.Hash, commission uint32) error {
+	if commission > MaxCommission {
+		return ErrInvalidCommission
+	}
+	if commission == 0 {
+		if _, err := dc.stakeTrie.Del(commissionKey(candidate)); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		return nil
+	}
+	_, err := dc.stakeTrie.Put(commissionKey(candidate), byteutils.FromUint32(commission))
+	return err
+}
+
+func (dc *DposContext) addClaimableReward(addr byteutils.Hash, value *util.Uint128) error {
+	if value.Sign() == 0 {
+		return nil
+	}
+	reward, err := getStake(dc.stakeTrie, claimableRewardKey(addr))
+	if err != nil {
+		return err
+	}
+	reward.Add(reward.Int, value.Int)
+	return putStake(dc.stakeTrie, claimableRewardKey(addr), reward)
+}
+
+// collectFees keeps the transaction fees to be shared with the block reward.
+func (block *Block) collectFees(fees *util.Uint128) error {
+	stakeTrie := block.dposContext.stakeTrie
+	collected, err := getStake(stakeTrie, blockFeesKey())
+	if err != nil {
+		return err
+	}
+	collected.Add(collected.Int, fees.Int)
+	return putStake(stakeTrie, blockFeesKey(), collected)
+}
+
+// shareRewards accrues the block reward and fees to the miner in the dynasty, the rewards accrued
+// in a dynasty are shared when the dynasty context of the next one is built, see NextDynastyContext.
+// The rewards are accrued to the miner rather than the coinbase, since the delegators delegate to
+// the miner, so the coinbase configured by the node receives no block reward after ForkRewardSharing.
+func (block *Block) shareRewards() error {
+	if !block.ChainRules().IsRewardSharing {
+		return nil
+	}
+	dynastyID := block.Timestamp() / block.DposParams().DynastyInterval
+
+	stakeTrie := block.dposContext.stakeTrie
+	reward, err := getStake(stakeTrie, blockFeesKey())
+	if err != nil {
+		return err
+	}
+	if err := putStake(stakeTrie, blockFeesKey(), util.NewUint128()); err != nil {
+		return err
+	}
+	params, err := block.GovernedParams()
+	if err != nil {
+		return err
+	}
+	reward.Add(reward.Int, params.BlockReward.Int)
+
+	key := accruedRewardKey(dynastyID, block.miner.Bytes())
+	accrued, err := getIndexedStake(stakeTrie, key)
+	if err != nil {
+		return err
+	}
+	accrued.Add(accrued.Int, reward.Int)
+	return putIndexedStake(stakeTrie, key, block.miner.Bytes(), accrued)
+}
+
+// distributeRewards shares the rewards accrued in the dynasty in the context of the next dynasty,
+// the context is built before the txs of its first block, so stake bonded by them takes no share.
+func (context *DynastyContext) distributeRewards(dynastyID int64) error {
+	dc := &DposContext{
+		delegateTrie:  context.DelegateTrie,
+		candidateTrie: context.CandidateTrie,
+		stakeTrie:     context.StakeTrie,
+		storage:       context.Storage,
+	}
+	return dc.distributeRewards(dynastyID)
+}
+
+// distributeRewards makes the rewards accrued by miners in the dynasty claimable.
+func (dc *DposContext) distributeRewards(dynastyID int64) error {
+	prefix := append([]byte{accruedRewardPrefix}, byteutils.FromInt64(dynastyID)...)
+	iter, err := dc.stakeTrie.Iterator(prefix)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var (
+		miners  []byteutils.Hash
+		rewards []*util.Uint128
+	)
+	exist, err := iter.Next()
+	for exist {
+		miner, reward, perr := parseIndexedStake(iter.Value())
+		if perr != nil {
+			return perr
+		}
+		miners = append(miners, miner)
+		rewards = append(rewards, reward)
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, miner := range miners {
+		if _, err := dc.stakeTrie.Del(accruedRewardKey(dynastyID, miner)); err != nil {
+			return err
+		}
+		if err := dc.shareReward(miner, rewards[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shareReward splits the reward after the validator's commission between its delegators pro-rata to bonded stake,
+// the commission and the rounding remainder go to the validator.
+func (dc *DposContext) shareReward(validator byteutils.Hash, reward *util.Uint128) error {
+	var (
+		delegators []byteutils.Hash
+		stakes     []*util.Uint128
+	)
+	total := util.NewUint128()
+	iter, err := dc.delegateTrie.Iterator(validator)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		exist, err := iter.Next()
+		for exist {
+			delegator := byteutils.Hash(iter.Value())
+			stake, serr := getStake(dc.stakeTrie, bondedStakeKey(delegator))
+			if serr != nil {
+				return serr
+			}
+			if stake.Sign() > 0 {
+				delegators = append(delegators, delegator)
+				stakes = append(stakes, stake)
+				total.Add(total.Int, stake.Int)
+			}
+			exist, err = iter.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if total.Sign() == 0 {
+		return dc.addClaimableReward(validator, reward)
+	}
+
+	commission, err := dc.commission(validator)
+	if err != nil {
+		return err
+	}
+	pool := new(big.Int).Mul(reward.Int, big.NewInt(int64(MaxCommission-commission)))
+	pool.Div(pool, big.NewInt(MaxCommission))
+
+	remainder := new(big.Int).Set(reward.Int)
+	for i, delegator := range delegators {
+		share := new(big.Int).Mul(pool, stakes[i].Int)
+		share.Div(share, total.Int)
+		remainder.Sub(remainder, share)
+		if err := dc.addClaimableReward(delegator, util.NewUint128FromBigInt(share)); err != nil {
+			return err
+		}
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"validator":  validator.Hex(),
+		"reward":     reward.String(),
+		"commission": commission,
+		"delegators": len(delegators),
+	}).Debug("Shared validator reward.")
+
+	return dc.addClaimableReward(validator, util.NewUint128FromBigInt(remainder))
+}
+
+// ClaimableReward returns the shared rewards the address can claim.
+func (block *Block) ClaimableReward(addr *Address) (*util.Uint128, error) {
+	return getStake(block.dposContext.stakeTrie, claimableRewardKey(addr.Bytes()))
+}
+
+// Commission returns the percent of rewards the candidate keeps before sharing.
+func (block *Block) Commission(addr *Address) (uint32, error) {
+	return block.dposContext.commission(addr.Bytes())
+}
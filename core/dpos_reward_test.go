@@ -0,0 +1,112 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_ShareRewards(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{
+		{Name: ForkStaking, Height: 0},
+		{Name: ForkRewardSharing, Height: 0},
+	}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	newAddress := func() *Address {
+		pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+		addr, _ := NewAddressFromPublicKey(pubdata)
+		return addr
+	}
+	validator, delegator1, delegator2 := newAddress(), newAddress(), newAddress()
+
+	// the block reward is not paid to the coinbase directly
+	block, err := NewBlock(bc.ChainID(), validator, bc.tailBlock)
+	assert.Nil(t, err)
+	balance := func(addr *Address) string {
+		balance, _ := block.GetBalance(addr.Bytes())
+		return balance.String()
+	}
+	assert.Equal(t, "0", balance(validator))
+
+	block.begin()
+	execute := func(from *Address, payload TxPayload) error {
+		tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas)
+		_, _, err := payload.Execute(block, tx)
+		return err
+	}
+	assert.Equal(t, ErrInvalidCommission, execute(validator, &CandidatePayload{Action: LoginAction, Commission: 101}))
+	assert.Nil(t, execute(validator, &CandidatePayload{Action: LoginAction, Commission: 20}))
+	commission, err := block.Commission(validator)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(20), commission)
+
+	for addr, stake := range map[*Address]string{delegator1: "100", delegator2: "300"} {
+		acc, _ := block.accState.GetOrCreateUserAccount(addr.Bytes())
+		acc.AddBalance(util.NewUint128FromInt(1000))
+		payload := NewDelegatePayload(DelegateAction, validator.String())
+		payload.Value = stake
+		assert.Nil(t, execute(addr, payload))
+	}
+
+	// the commission and rounding remainder go to the validator
+	assert.Nil(t, block.dposContext.shareReward(validator.Bytes(), util.NewUint128FromInt(1001)))
+	reward := func(addr *Address) string {
+		reward, _ := block.ClaimableReward(addr)
+		return reward.String()
+	}
+	assert.Equal(t, "200", reward(delegator1))
+	assert.Equal(t, "600", reward(delegator2))
+	assert.Equal(t, "201", reward(validator))
+
+	assert.Nil(t, execute(delegator1, NewClaimPayload()))
+	assert.Equal(t, "1100", balance(delegator1))
+	assert.Equal(t, "0", reward(delegator1))
+	assert.Equal(t, ErrNoRewardToClaim, execute(delegator1, NewClaimPayload()))
+
+	// block reward and fees are accrued in the dynasty and shared at the next dynasty
+	params := block.DposParams()
+	block.header.timestamp = params.DynastyInterval - params.BlockInterval
+	block.miner = validator
+	assert.Nil(t, block.collectFees(util.NewUint128FromInt(4000)))
+	assert.Nil(t, block.shareRewards())
+	dynastyID := block.Timestamp() / params.DynastyInterval
+	accrued, err := getIndexedStake(block.dposContext.stakeTrie, accruedRewardKey(dynastyID, validator.Bytes()))
+	assert.Nil(t, err)
+	expected := util.NewUint128FromBigInt(util.NewUint128().Add(BlockReward.Int, util.NewUint128FromInt(4000).Int))
+	assert.Equal(t, expected.String(), accrued.String())
+	block.commit()
+
+	// the rewards are shared in the context of the next dynasty, before the txs of its first block
+	context, err := block.NextDynastyContext(bc, params.BlockInterval)
+	assert.Nil(t, err)
+	accrued, _ = getIndexedStake(context.StakeTrie, accruedRewardKey(dynastyID, validator.Bytes()))
+	assert.Equal(t, "0", accrued.String())
+	shared, err := getStake(context.StakeTrie, claimableRewardKey(delegator1.Bytes()))
+	assert.Nil(t, err)
+	assert.NotEqual(t, "0", shared.String())
+	assert.Equal(t, "0", reward(delegator1))
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// ProposerSlot is a block slot and the validator proposing in it.
+type ProposerSlot struct {
+	Timestamp int64
+	Proposer  byteutils.Hash // nil if no validator for the slot
+}
+
+// MintStat is the count of blocks a validator minted and missed in a dynasty.
+type MintStat struct {
+	Validator byteutils.Hash
+	Expected  int64
+	Minted    int64
+	Missed    int64
+}
+
+// DynastySchedule is the proposer schedule of a dynasty.
+type DynastySchedule struct {
+	Serial int64
+	Start  int64
+	End    int64
+	Slots  []*ProposerSlot
+	Stats  []*MintStat
+}
+
+func newDynastySchedule(params *DposParams, serial int64, dynasty *trie.BatchTrie) (*DynastySchedule, error) {
+	schedule := &DynastySchedule{
+		Serial: serial,
+		Start:  serial * params.DynastyInterval,
+		End:    (serial+1)*params.DynastyInterval - params.BlockInterval,
+	}
+	for slot := schedule.Start; slot <= schedule.End; slot += params.BlockInterval {
+		proposer, err := params.FindProposer(slot, dynasty)
+		if err != nil && err != ErrFoundNilProposer {
+			return nil, err
+		}
+		schedule.Slots = append(schedule.Slots, &ProposerSlot{Timestamp: slot, Proposer: proposer})
+	}
+	return schedule, nil
+}
+
+// ProposerSchedule returns the proposer schedules of the block's dynasty and the next dynasty.
+// The mint stats of the block's dynasty count the slots up to the block.
+func (block *Block) ProposerSchedule(chain *BlockChain) (*DynastySchedule, *DynastySchedule, error) {
+	params := chain.DposParams()
+	serial := block.Timestamp() / params.DynastyInterval
+
+	context, err := block.NextDynastyContext(chain, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := newDynastySchedule(params, serial, context.DynastyTrie)
+	if err != nil {
+		return nil, nil, err
+	}
+	if current.Stats, err = block.mintStats(chain, current); err != nil {
+		return nil, nil, err
+	}
+
+	context, err = block.NextDynastyContext(chain, (serial+1)*params.DynastyInterval-block.Timestamp())
+	if err != nil {
+		return nil, nil, err
+	}
+	next, err := newDynastySchedule(params, serial+1, context.DynastyTrie)
+	if err != nil {
+		return nil, nil, err
+	}
+	return current, next, nil
+}
+
+// mintStats counts the blocks minted and missed by validators in the
+// dynasty's slots after genesis and up to the block.
+func (block *Block) mintStats(chain *BlockChain, schedule *DynastySchedule) ([]*MintStat, error) {
+	stats := make(map[byteutils.HexHash]*MintStat)
+	validators := make([]byteutils.Hash, 0)
+	for _, slot := range schedule.Slots {
+		if slot.Proposer == nil {
+			continue
+		}
+		stat, ok := stats[slot.Proposer.Hex()]
+		if !ok {
+			stat = &MintStat{Validator: slot.Proposer}
+			stats[slot.Proposer.Hex()] = stat
+			validators = append(validators, slot.Proposer)
+		}
+		if slot.Timestamp > chain.GenesisBlock().Timestamp() && slot.Timestamp <= block.Timestamp() {
+			stat.Expected++
+		}
+	}
+
+	result := make([]*MintStat, 0, len(validators))
+	for _, validator := range validators {
+		stat := stats[validator.Hex()]
+		key := append(byteutils.FromInt64(schedule.Serial), validator...)
+		bytes, err := block.dposContext.mintCntTrie.Get(key)
+		if err != nil && err != storage.ErrKeyNotFound {
+			return nil, err
+		}
+		if err == nil {
+			stat.Minted = byteutils.Int64(bytes)
+		}
+		if stat.Expected > stat.Minted {
+			stat.Missed = stat.Expected - stat.Minted
+		}
+		result = append(result, stat)
+	}
+	return result, nil
+}
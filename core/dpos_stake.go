@@ -0,0 +1,234 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// key prefixes in the stake trie
+const (
+	bondedStakePrefix     = byte(0)  // + delegator, val: stake
+	unbondingStakePrefix  = byte(1)  // + release height + delegator, val: delegator + stake
+	commissionPrefix      = byte(2)  // + candidate, val: commission, candidates registered before ForkCandidateMetadata
+	accruedRewardPrefix   = byte(3)  // + dynastyId + miner, val: miner + reward
+	claimableRewardPrefix = byte(4)  // + address, val: reward
+	blockFeesPrefix       = byte(5)  // val: fees
+	livenessPrefix        = byte(6)  // + validator + dynastyId, val: dynastyId + expected + produced
+	jailPrefix            = byte(7)  // + validator, val: validator + release dynastyId
+	proposalPrefix        = byte(8)  // + proposal id, val: proposal
+	proposalEndPrefix     = byte(9)  // + end height + proposal id, val: proposal id
+	proposalVotePrefix    = byte(10) // + proposal id + voter, val: voter + approve
+	paramPrefix           = byte(11) // + param name, val: value
+	paramChangePrefix     = byte(12) // + activation height + proposal id, val: proposal id
+)
+
+func bondedStakeKey(delegator byteutils.Hash) []byte {
+	return append([]byte{bondedStakePrefix}, delegator...)
+}
+
+func unbondingStakeKey(release uint64, delegator byteutils.Hash) []byte {
+	key := append([]byte{unbondingStakePrefix}, byteutils.FromUint64(release)...)
+	return append(key, delegator...)
+}
+
+func getStake(stakeTrie *trie.BatchTrie, key []byte) (*util.Uint128, error) {
+	bytes, err := stakeTrie.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return util.NewUint128(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return util.NewUint128FromFixedSizeByteSlice(bytes)
+}
+
+func putStake(stakeTrie *trie.BatchTrie, key []byte, stake *util.Uint128) error {
+	if stake.Sign() == 0 {
+		if _, err := stakeTrie.Del(key); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		return nil
+	}
+	bytes, err := stake.ToFixedSizeByteSlice()
+	if err != nil {
+		return err
+	}
+	_, err = stakeTrie.Put(key, bytes)
+	return err
+}
+
+// indexed stakes keep the address in the value, since trie iterators don't return keys.
+func getIndexedStake(stakeTrie *trie.BatchTrie, key []byte) (*util.Uint128, error) {
+	bytes, err := stakeTrie.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return util.NewUint128(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, stake, err := parseIndexedStake(bytes)
+	return stake, err
+}
+
+func putIndexedStake(stakeTrie *trie.BatchTrie, key []byte, addr byteutils.Hash, stake *util.Uint128) error {
+	if stake.Sign() == 0 {
+		if _, err := stakeTrie.Del(key); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		return nil
+	}
+	bytes, err := stake.ToFixedSizeByteSlice()
+	if err != nil {
+		return err
+	}
+	value := append(append([]byte{}, addr...), bytes...)
+	_, err = stakeTrie.Put(key, value)
+	return err
+}
+
+func parseIndexedStake(value []byte) (byteutils.Hash, *util.Uint128, error) {
+	if len(value) != AddressLength+util.Uint128Bytes {
+		return nil, nil, util.ErrUint128InvalidBytesSize
+	}
+	stake, err := util.NewUint128FromFixedSizeByteSlice(value[AddressLength:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return value[:AddressLength], stake, nil
+}
+
+// bond locks value of the delegator's balance as stake.
+func (block *Block) bond(delegator byteutils.Hash, value *util.Uint128) error {
+	acc, err := block.accState.GetOrCreateUserAccount(delegator)
+	if err != nil {
+		return err
+	}
+	if err := acc.SubBalance(value); err != nil {
+		return err
+	}
+	stakeTrie := block.dposContext.stakeTrie
+	stake, err := getStake(stakeTrie, bondedStakeKey(delegator))
+	if err != nil {
+		return err
+	}
+	stake.Add(stake.Int, value.Int)
+	return putStake(stakeTrie, bondedStakeKey(delegator), stake)
+}
+
+// unbond moves all the bonded stake of the delegator into the unbonding period.
+func (block *Block) unbond(delegator byteutils.Hash) error {
+	stakeTrie := block.dposContext.stakeTrie
+	stake, err := getStake(stakeTrie, bondedStakeKey(delegator))
+	if err != nil {
+		return err
+	}
+	if stake.Sign() == 0 {
+		return ErrNoStakeToUnbond
+	}
+	if err := putStake(stakeTrie, bondedStakeKey(delegator), util.NewUint128()); err != nil {
+		return err
+	}
+
+	params, err := block.GovernedParams()
+	if err != nil {
+		return err
+	}
+	release := block.height + params.UnbondingPeriod
+	key := unbondingStakeKey(release, delegator)
+	unbonding, err := getIndexedStake(stakeTrie, key)
+	if err != nil {
+		return err
+	}
+	unbonding.Add(unbonding.Int, stake.Int)
+	return putIndexedStake(stakeTrie, key, delegator, unbonding)
+}
+
+// slashStake burns percent of the bonded stake of the delegator, returns the burned stake.
+func (block *Block) slashStake(delegator byteutils.Hash, percent int) (*util.Uint128, error) {
+	stakeTrie := block.dposContext.stakeTrie
+	stake, err := getStake(stakeTrie, bondedStakeKey(delegator))
+	if err != nil {
+		return nil, err
+	}
+	burned := util.NewUint128()
+	burned.Mul(stake.Int, big.NewInt(int64(percent)))
+	burned.Div(burned.Int, big.NewInt(100))
+	if burned.Sign() == 0 {
+		return burned, nil
+	}
+	stake.Sub(stake.Int, burned.Int)
+	return burned, putStake(stakeTrie, bondedStakeKey(delegator), stake)
+}
+
+// releaseUnbondedStakes returns the stakes and candidate deposits whose unbonding period ends at the block.
+func (block *Block) releaseUnbondedStakes() error {
+	if rules := block.ChainRules(); !rules.IsStaking && !rules.IsCandidateMetadata {
+		return nil
+	}
+	stakeTrie := block.dposContext.stakeTrie
+	prefix := append([]byte{unbondingStakePrefix}, byteutils.FromUint64(block.height)...)
+	iter, err := stakeTrie.Iterator(prefix)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var delegators []byteutils.Hash
+	exist, err := iter.Next()
+	for exist {
+		delegator, stake, perr := parseIndexedStake(iter.Value())
+		if perr != nil {
+			return perr
+		}
+		acc, aerr := block.accState.GetOrCreateUserAccount(delegator)
+		if aerr != nil {
+			return aerr
+		}
+		acc.AddBalance(stake)
+		delegators = append(delegators, delegator)
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return err
+	}
+	for _, delegator := range delegators {
+		if _, err := stakeTrie.Del(unbondingStakeKey(block.height, delegator)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BondedStake returns the stake the address bonded for delegation.
+func (block *Block) BondedStake(addr *Address) (*util.Uint128, error) {
+	return getStake(block.dposContext.stakeTrie, bondedStakeKey(addr.Bytes()))
+}
+
+// UnbondingStake returns the stake of the address released at the height.
+func (block *Block) UnbondingStake(addr *Address, release uint64) (*util.Uint128, error) {
+	return getIndexedStake(block.dposContext.stakeTrie, unbondingStakeKey(release, addr.Bytes()))
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelegatePayload_Staking(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkStaking, Height: 0}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	candidate := mockAddress()
+	block, err := NewBlock(bc.ChainID(), candidate, bc.tailBlock)
+	assert.Nil(t, err)
+	block.begin()
+	acc, _ := block.accState.GetOrCreateUserAccount(from.Bytes())
+	acc.AddBalance(util.NewUint128FromInt(1000))
+	_, err = block.dposContext.candidateTrie.Put(candidate.Bytes(), candidate.Bytes())
+	assert.Nil(t, err)
+
+	execute := func(action, value string) error {
+		payload := NewDelegatePayload(action, candidate.String())
+		payload.Value = value
+		bytes, _ := payload.ToBytes()
+		tx := NewTransaction(bc.ChainID(), from, candidate, util.NewUint128(), 1, TxPayloadDelegateType, bytes, TransactionGasPrice, TransactionMaxGas)
+		_, _, err := payload.Execute(block, tx)
+		return err
+	}
+
+	balance := func() string {
+		balance, _ := block.GetBalance(from.Bytes())
+		return balance.String()
+	}
+
+	assert.Equal(t, ErrInvalidStakeValue, execute(DelegateAction, ""))
+	assert.Equal(t, ErrInvalidStakeValue, execute(DelegateAction, "abc"))
+	assert.Equal(t, ErrInvalidStakeValue, execute(DelegateAction, "-1"))
+	assert.NotNil(t, execute(DelegateAction, "2000"))
+
+	assert.Nil(t, execute(DelegateAction, "400"))
+	assert.Equal(t, "600", balance())
+	stake, err := block.BondedStake(from)
+	assert.Nil(t, err)
+	assert.Equal(t, "400", stake.String())
+	// moving the vote keeps the bonded stake
+	assert.Nil(t, execute(DelegateAction, ""))
+
+	// only the bonded stake is tallied
+	dc := &DynastyContext{
+		DelegateTrie:  block.dposContext.delegateTrie,
+		CandidateTrie: block.dposContext.candidateTrie,
+		StakeTrie:     block.dposContext.stakeTrie,
+		Accounts:      block.accState,
+		Base:          block,
+	}
+	votes, err := dc.tallyVotes()
+	assert.Nil(t, err)
+	assert.Equal(t, "400", votes[candidate.String()].String())
+
+	assert.Nil(t, execute(UnDelegateAction, ""))
+	stake, _ = block.BondedStake(from)
+	assert.Equal(t, "0", stake.String())
+	release := block.height + block.DposParams().UnbondingPeriod
+	stake, _ = block.UnbondingStake(from, release)
+	assert.Equal(t, "400", stake.String())
+	assert.Equal(t, ErrNoStakeToUnbond, execute(UnDelegateAction, ""))
+
+	// the stake is spendable again once the unbonding period ends
+	block.height = release - 1
+	assert.Nil(t, block.releaseUnbondedStakes())
+	assert.Equal(t, "600", balance())
+	block.height = release
+	assert.Nil(t, block.releaseUnbondedStakes())
+	assert.Equal(t, "1000", balance())
+	stake, _ = block.UnbondingStake(from, release)
+	assert.Equal(t, "0", stake.String())
+}
+
+func TestDelegatePayload_UnDelegatePreStaking(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkStaking, Height: 0}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	from := mockAddress()
+	candidate := mockAddress()
+	block, err := NewBlock(bc.ChainID(), candidate, bc.tailBlock)
+	assert.Nil(t, err)
+	block.begin()
+	_, err = block.dposContext.candidateTrie.Put(candidate.Bytes(), candidate.Bytes())
+	assert.Nil(t, err)
+	// a vote cast before staking was activated, without stake
+	_, err = block.dposContext.delegateTrie.Put(append(candidate.Bytes(), from.Bytes()...), from.Bytes())
+	assert.Nil(t, err)
+	_, err = block.dposContext.voteTrie.Put(from.Bytes(), candidate.Bytes())
+	assert.Nil(t, err)
+
+	payload := NewDelegatePayload(UnDelegateAction, candidate.String())
+	bytes, _ := payload.ToBytes()
+	tx := NewTransaction(bc.ChainID(), from, candidate, util.NewUint128(), 1, TxPayloadDelegateType, bytes, TransactionGasPrice, TransactionMaxGas)
+	_, _, err = payload.Execute(block, tx)
+	assert.Nil(t, err)
+	_, err = block.dposContext.voteTrie.Get(from.Bytes())
+	assert.NotNil(t, err)
+	_, err = block.dposContext.delegateTrie.Get(append(candidate.Bytes(), from.Bytes()...))
+	assert.NotNil(t, err)
+
+	// nothing left to withdraw
+	_, _, err = payload.Execute(block, tx)
+	assert.Equal(t, ErrNoStakeToUnbond, err)
+}
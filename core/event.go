@@ -61,6 +61,9 @@ const (
 
 	// TopicTransactionExecutionResult the topic of transaction execution result
 	TopicTransactionExecutionResult = "chain.transactionResult"
+
+	// TopicDoubleSign the topic of double sign evidence found in block pool.
+	TopicDoubleSign = "chain.doubleSign"
 )
 
 // Event event structure.
@@ -0,0 +1,177 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Fork choice rules
+const (
+	// LongestChainRule prefers the highest tail, ties are broken by the block hash.
+	LongestChainRule = "longest"
+
+	// MostMinersRule prefers the tail whose blocks above LIB are minted by the most
+	// distinct miners, a fork built by a few miners can't outrun the others.
+	MostMinersRule = "miners"
+)
+
+// ForkChoiceRule picks the new tail among the current tail and the detached tails.
+type ForkChoiceRule interface {
+	Name() string
+	Choose(bc *BlockChain, tail *Block, candidates []*Block) *Block
+}
+
+// NewForkChoiceRule returns the fork choice rule by name, the longest chain rule if name is empty.
+func NewForkChoiceRule(name string) (ForkChoiceRule, error) {
+	switch name {
+	case "", LongestChainRule:
+		return &longestChain{}, nil
+	case MostMinersRule:
+		return &mostMiners{}, nil
+	}
+	return nil, ErrUnknownForkChoiceRule
+}
+
+// longer returns whether a is preferred over b by the longest chain rule.
+func longer(a *Block, b *Block) bool {
+	if a.Height() != b.Height() {
+		return a.Height() > b.Height()
+	}
+	return byteutils.Less(b.Hash(), a.Hash())
+}
+
+type longestChain struct{}
+
+func (r *longestChain) Name() string {
+	return LongestChainRule
+}
+
+func (r *longestChain) Choose(bc *BlockChain, tail *Block, candidates []*Block) *Block {
+	best := tail
+	for _, v := range candidates {
+		if longer(v, best) {
+			best = v
+		}
+	}
+	return best
+}
+
+type mostMiners struct{}
+
+func (r *mostMiners) Name() string {
+	return MostMinersRule
+}
+
+// minersAboveLIB counts the distinct miners from the block down to LIB.
+func (r *mostMiners) minersAboveLIB(bc *BlockChain, block *Block) int {
+	lib := bc.LatestIrreversibleBlock()
+	miners := make(map[byteutils.HexHash]bool)
+	for cur := block; cur != nil && cur.height > lib.height; cur = bc.GetBlock(cur.ParentHash()) {
+		if cur.miner != nil {
+			miners[byteutils.Hash(cur.miner.Bytes()).Hex()] = true
+		}
+	}
+	return len(miners)
+}
+
+func (r *mostMiners) Choose(bc *BlockChain, tail *Block, candidates []*Block) *Block {
+	best, bestMiners := tail, r.minersAboveLIB(bc, tail)
+	for _, v := range candidates {
+		miners := r.minersAboveLIB(bc, v)
+		if miners > bestMiners || (miners == bestMiners && longer(v, best)) {
+			best, bestMiners = v, miners
+		}
+	}
+	return best
+}
+
+// ForkBlock is a block in the block tree above LIB.
+type ForkBlock struct {
+	Block     *Block
+	Canonical bool
+}
+
+// ForkTail is a tail in the block tree and where it forks from the canonical chain.
+type ForkTail struct {
+	Block    *Block
+	Ancestor *Block
+}
+
+// ForkTree is the block tree above LIB, built from the detached tails.
+type ForkTree struct {
+	LIB    *Block
+	Tail   *Block
+	Rule   string
+	Blocks []*ForkBlock
+	Tails  []*ForkTail
+}
+
+// ForkTree returns the block tree above LIB, tails forked below LIB are ignored.
+func (bc *BlockChain) ForkTree() (*ForkTree, error) {
+	lib := bc.LatestIrreversibleBlock()
+	tail := bc.TailBlock()
+	tree := &ForkTree{
+		LIB:  lib,
+		Tail: tail,
+		Rule: bc.ForkChoiceRule().Name(),
+	}
+
+	tails := []*Block{tail}
+	for _, v := range bc.DetachedTailBlocks() {
+		if !v.Hash().Equals(tail.Hash()) {
+			tails = append(tails, v)
+		}
+	}
+
+	visited := make(map[byteutils.HexHash]bool)
+	for _, v := range tails {
+		ancestor, err := bc.FindCommonAncestorWithTail(v)
+		if err != nil {
+			return nil, err
+		}
+		if ancestor.height < lib.height {
+			continue
+		}
+		tree.Tails = append(tree.Tails, &ForkTail{Block: v, Ancestor: ancestor})
+
+		for cur := v; cur != nil && cur.height > lib.height; cur = bc.GetBlock(cur.ParentHash()) {
+			if visited[cur.Hash().Hex()] {
+				break
+			}
+			visited[cur.Hash().Hex()] = true
+			canonical := bc.GetBlockOnCanonicalChainByHeight(cur.height)
+			tree.Blocks = append(tree.Blocks, &ForkBlock{
+				Block:     cur,
+				Canonical: canonical != nil && canonical.Hash().Equals(cur.Hash()),
+			})
+		}
+	}
+
+	sort.Slice(tree.Blocks, func(i, j int) bool {
+		a, b := tree.Blocks[i].Block, tree.Blocks[j].Block
+		if a.height != b.height {
+			return a.height < b.height
+		}
+		return a.Hash().Hex() < b.Hash().Hex()
+	})
+	return tree, nil
+}
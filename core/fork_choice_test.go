@@ -0,0 +1,91 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForkChoiceRule(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	_, err := NewForkChoiceRule("heaviest")
+	assert.Equal(t, ErrUnknownForkChoiceRule, err)
+	assert.Equal(t, LongestChainRule, bc.ForkChoiceRule().Name())
+
+	minerA := &Address{[]byte("012345678901234567890011")}
+	minerB := &Address{[]byte("012345678901234567890012")}
+	minerC := &Address{[]byte("012345678901234567890013")}
+	mint := func(parent *Block, miner *Address, slot int64) *Block {
+		block, err := bc.NewBlockFromParent(miner, parent)
+		assert.Nil(t, err)
+		block.header.timestamp = BlockInterval * slot
+		block.SetMiner(miner)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		return bc.GetBlock(block.Hash())
+	}
+	/*
+		genesis -- 0 -- 11 -- 111 -- 1111    minted by A
+				     \_ 12 -- 121           minted by B, C
+	*/
+	block0 := mint(bc.genesisBlock, minerA, 1)
+	block11 := mint(block0, minerA, 2)
+	block111 := mint(block11, minerA, 3)
+	block1111 := mint(block111, minerA, 4)
+	block12 := mint(block0, minerB, 5)
+	block121 := mint(block12, minerC, 6)
+	assert.Nil(t, bc.SetTailBlock(block12))
+
+	tails := bc.DetachedTailBlocks()
+	longest, _ := NewForkChoiceRule(LongestChainRule)
+	assert.Equal(t, block1111.Hash(), longest.Choose(bc, bc.TailBlock(), tails).Hash())
+	miners, _ := NewForkChoiceRule(MostMinersRule)
+	assert.Equal(t, block121.Hash(), miners.Choose(bc, bc.TailBlock(), tails).Hash())
+
+	bc.SetForkChoiceRule(miners)
+	assert.Nil(t, bc.SetTailBlock(block1111))
+	tree, err := bc.ForkTree()
+	assert.Nil(t, err)
+	assert.Equal(t, MostMinersRule, tree.Rule)
+	assert.Equal(t, bc.genesisBlock.Hash(), tree.LIB.Hash())
+	assert.Equal(t, block1111.Hash(), tree.Tail.Hash())
+	assert.Equal(t, 6, len(tree.Blocks))
+	assert.Equal(t, block0.Hash(), tree.Blocks[0].Block.Hash())
+	canonical := 0
+	for _, v := range tree.Blocks {
+		if v.Canonical {
+			canonical++
+		}
+	}
+	assert.Equal(t, 4, canonical)
+	assert.Equal(t, 2, len(tree.Tails))
+	for _, v := range tree.Tails {
+		if v.Block.Hash().Equals(block121.Hash()) {
+			assert.Equal(t, block0.Hash(), v.Ancestor.Hash())
+		} else {
+			assert.Equal(t, block1111.Hash(), v.Block.Hash())
+			assert.Equal(t, block1111.Hash(), v.Ancestor.Hash())
+		}
+	}
+}
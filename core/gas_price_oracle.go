@@ -0,0 +1,243 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Gas price oracle parameters
+const (
+	// GasPriceOracleBlocks is the number of recent blocks sampled by the oracle.
+	GasPriceOracleBlocks = 20
+
+	// GasPriceOracleBacklogBlocks is the pool backlog, in blocks, at which the suggestions are raised the most.
+	GasPriceOracleBacklogBlocks = 4
+
+	// percentiles of the sampled gas prices for each suggestion.
+	GasPriceSlowPercentile     = 20
+	GasPriceStandardPercentile = 50
+	GasPriceFastPercentile     = 80
+)
+
+// GasPriceSuggestion is the suggested gas prices for different inclusion speeds.
+type GasPriceSuggestion struct {
+	Slow     *util.Uint128
+	Standard *util.Uint128
+	Fast     *util.Uint128
+}
+
+type gasPriceSample struct {
+	gasPrice *util.Uint128
+	weight   float64
+}
+
+// GasPriceOracle suggests gas prices based on recent blocks and the pending txs.
+type GasPriceOracle struct {
+	bc     *BlockChain
+	blocks int
+
+	mu          sync.Mutex
+	sampledTail byteutils.Hash
+	samples     []*gasPriceSample
+	totalWeight float64
+	avgTxs      float64
+}
+
+// NewGasPriceOracle create a new GasPriceOracle sampling the last blocks.
+func NewGasPriceOracle(bc *BlockChain, blocks int) *GasPriceOracle {
+	if blocks <= 0 {
+		blocks = GasPriceOracleBlocks
+	}
+	return &GasPriceOracle{
+		bc:     bc,
+		blocks: blocks,
+	}
+}
+
+// blockGasUsed returns the gas used by the txs in the block,
+// summed from their execution results before ForkBlockLimits records it in the header.
+func blockGasUsed(block *Block) (*util.Uint128, error) {
+	if block.ChainRules().IsBlockLimits {
+		return block.GasUsed(), nil
+	}
+	gasUsed := util.NewUint128()
+	for _, tx := range block.transactions {
+		events, err := block.FetchEvents(tx.hash)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if event.Topic != TopicTransactionExecutionResult {
+				continue
+			}
+			txEvent := TransactionEvent{}
+			if err := json.Unmarshal([]byte(event.Data), &txEvent); err != nil {
+				return nil, err
+			}
+			used, ok := new(big.Int).SetString(txEvent.GasUsed, 10)
+			if !ok {
+				return nil, ErrInvalidBlockGasUsed
+			}
+			gasUsed.Add(gasUsed.Int, used)
+		}
+	}
+	return gasUsed, nil
+}
+
+// fullness returns the gas used by the txs in the block over the block gas limit, in [0, 1].
+func (oracle *GasPriceOracle) fullness(block *Block) float64 {
+	gasUsed, err := blockGasUsed(block)
+	if err != nil {
+		return 0
+	}
+	limit := oracle.bc.DposParams().BlockGasLimit
+	if params, err := block.GovernedParams(); err == nil {
+		limit = params.BlockGasLimit
+	}
+	fullness, _ := new(big.Rat).SetFrac(gasUsed.Int, limit.Int).Float64()
+	if fullness > 1 {
+		return 1
+	}
+	return fullness
+}
+
+// sample collects the gas prices of txs in the last blocks.
+// each tx is weighted by the fullness of its block, full blocks reflect the real competition.
+func (oracle *GasPriceOracle) sample(tail *Block) {
+	if oracle.sampledTail != nil && oracle.sampledTail.Equals(tail.Hash()) {
+		return
+	}
+
+	var blocks []*Block
+	totalTxs := 0
+	block := tail
+	for i := 0; i < oracle.blocks && block != nil; i++ {
+		if CheckGenesisBlock(block) {
+			break
+		}
+		blocks = append(blocks, block)
+		totalTxs += len(block.transactions)
+		block = oracle.bc.GetBlock(block.ParentHash())
+	}
+
+	samples := []*gasPriceSample{}
+	totalWeight := float64(0)
+	for _, block := range blocks {
+		if len(block.transactions) == 0 {
+			continue
+		}
+		fullness := oracle.fullness(block)
+		for _, tx := range block.transactions {
+			samples = append(samples, &gasPriceSample{gasPrice: tx.gasPrice, weight: fullness})
+			totalWeight += fullness
+		}
+	}
+	// no gas is known to be used, e.g. results recorded without the gas, weight txs equally.
+	if totalWeight == 0 {
+		for _, s := range samples {
+			s.weight = 1
+		}
+		totalWeight = float64(len(samples))
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].gasPrice.Cmp(samples[j].gasPrice.Int) < 0
+	})
+
+	oracle.sampledTail = tail.Hash()
+	oracle.samples = samples
+	oracle.totalWeight = totalWeight
+	oracle.avgTxs = 0
+	if len(blocks) > 0 {
+		oracle.avgTxs = float64(totalTxs) / float64(len(blocks))
+	}
+}
+
+// percentile returns the weighted percentile of the sampled gas prices.
+func (oracle *GasPriceOracle) percentile(pct float64) *util.Uint128 {
+	threshold := oracle.totalWeight * pct / 100
+	cumulative := float64(0)
+	for _, s := range oracle.samples {
+		cumulative += s.weight
+		if cumulative >= threshold {
+			return s.gasPrice
+		}
+	}
+	return oracle.samples[len(oracle.samples)-1].gasPrice
+}
+
+// pressure returns how much the pending txs in pool push the suggestions up, in [0, 1].
+func (oracle *GasPriceOracle) pressure(pending int) float64 {
+	avgTxs := oracle.avgTxs
+	if avgTxs < 1 {
+		avgTxs = 1
+	}
+	backlog := float64(pending) / avgTxs
+	if backlog >= GasPriceOracleBacklogBlocks {
+		return 1
+	}
+	return backlog / GasPriceOracleBacklogBlocks
+}
+
+// Suggest returns the slow, standard and fast gas price suggestions.
+func (oracle *GasPriceOracle) Suggest() *GasPriceSuggestion {
+	oracle.mu.Lock()
+	defer oracle.mu.Unlock()
+
+	oracle.sample(oracle.bc.TailBlock())
+
+	lowest := TransactionGasPrice
+	pending := 0
+	if pool := oracle.bc.TransactionPool(); pool != nil {
+		pending = pool.Len()
+		if gasPrice := pool.GasPrice(); gasPrice.Cmp(lowest.Int) > 0 {
+			lowest = gasPrice
+		}
+	}
+
+	// if no transactions have been submited, use the lowest gasPrice
+	if len(oracle.samples) == 0 {
+		return &GasPriceSuggestion{Slow: lowest, Standard: lowest, Fast: lowest}
+	}
+
+	pressure := oracle.pressure(pending)
+	suggest := func(pct float64) *util.Uint128 {
+		// the backlog moves the percentile half way to the highest price at most
+		pct += (100 - pct) * pressure / 2
+		gasPrice := oracle.percentile(pct)
+		if gasPrice.Cmp(lowest.Int) < 0 {
+			return lowest
+		}
+		if gasPrice.Cmp(TransactionMaxGasPrice.Int) > 0 {
+			return TransactionMaxGasPrice
+		}
+		return gasPrice
+	}
+	return &GasPriceSuggestion{
+		Slow:     suggest(GasPriceSlowPercentile),
+		Standard: suggest(GasPriceStandardPercentile),
+		Fast:     suggest(GasPriceFastPercentile),
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasPriceOracle(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkBlockLimits, Height: 0}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	oracle := bc.GasPriceOracle()
+	suggestion := oracle.Suggest()
+	assert.Equal(t, suggestion.Slow, TransactionGasPrice)
+	assert.Equal(t, suggestion.Standard, TransactionGasPrice)
+	assert.Equal(t, suggestion.Fast, TransactionGasPrice)
+
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, err := ks.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	gasPrice := func(times int64) *util.Uint128 {
+		return util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(times).Int))
+	}
+	nonce := uint64(0)
+	newTx := func(price *util.Uint128) *Transaction {
+		nonce++
+		tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("nas"), price, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		return tx
+	}
+
+	// a full block and a block with a single expensive tx using a quarter of the gas limit
+	quarter := util.NewUint128FromBigInt(new(big.Int).Div(BlockGasLimit.Int, big.NewInt(4)))
+	for i, prices := range [][]int64{{1, 2, 3, 4}, {10}} {
+		block, err := bc.NewBlock(from)
+		assert.Nil(t, err)
+		for _, price := range prices {
+			block.transactions = append(block.transactions, newTx(gasPrice(price)))
+		}
+		block.miner = from
+		block.Seal()
+		block.header.gasUsed = BlockGasLimit
+		if i > 0 {
+			block.header.gasUsed = quarter
+		}
+		block.Sign(signature)
+		bc.SetTailBlock(block)
+		bc.storeBlockToStorage(block)
+	}
+
+	suggestion = oracle.Suggest()
+	assert.Equal(t, suggestion.Slow, gasPrice(1))
+	assert.Equal(t, suggestion.Standard, gasPrice(3))
+	assert.Equal(t, suggestion.Fast, gasPrice(4))
+
+	// pending txs raise the suggestions
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, bc.txPool.Push(newTx(TransactionGasPrice)))
+	}
+	suggestion = oracle.Suggest()
+	assert.Equal(t, suggestion.Slow, gasPrice(3))
+	assert.Equal(t, suggestion.Standard, gasPrice(4))
+	assert.Equal(t, suggestion.Fast, gasPrice(4))
+
+	// the suggestions never go below the pool's lowest gasPrice
+	bc.txPool.SetGasConfig(gasPrice(5), nil)
+	suggestion = oracle.Suggest()
+	assert.Equal(t, suggestion.Slow, gasPrice(5))
+}
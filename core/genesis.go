@@ -53,6 +53,63 @@ func LoadGenesisConf(filePath string) (*corepb.Genesis, error) {
 	return genesis, nil
 }
 
+// CheckGenesisConf validates the genesis before it is used to initialize a chain:
+// the fork schedule and dpos params, an initial dynasty of at least the safe size,
+// unique addresses and a token distribution whose total fits in uint128.
+func CheckGenesisConf(conf *corepb.Genesis) error {
+	if conf.Meta == nil || conf.Meta.ChainId == 0 {
+		return ErrInvalidGenesisChainID
+	}
+	if conf.Consensus == nil || conf.Consensus.Dpos == nil {
+		return ErrInitialDynastyNotEnough
+	}
+	if _, err := NewForkSchedule(conf.Forks); err != nil {
+		return err
+	}
+	params, err := NewDposParams(conf.Consensus.Dpos)
+	if err != nil {
+		return err
+	}
+
+	if len(conf.Consensus.Dpos.Dynasty) < params.SafeSize {
+		return ErrInitialDynastyNotEnough
+	}
+	members := make(map[string]bool)
+	for _, v := range conf.Consensus.Dpos.Dynasty {
+		addr, err := AddressParse(v)
+		if err != nil {
+			return err
+		}
+		if members[addr.String()] {
+			return ErrDuplicatedGenesisAddress
+		}
+		members[addr.String()] = true
+	}
+
+	total := util.NewUint128()
+	accounts := make(map[string]bool)
+	for _, v := range conf.TokenDistribution {
+		addr, err := AddressParse(v.Address)
+		if err != nil {
+			return err
+		}
+		if accounts[addr.String()] {
+			return ErrDuplicatedGenesisAddress
+		}
+		accounts[addr.String()] = true
+
+		value, ok := util.NewUint128().FromString(v.Value)
+		if !ok || value.Sign() <= 0 || value.Validate() != nil {
+			return ErrInvalidGenesisTokenDistribution
+		}
+		total.Add(total.Int, value.Int)
+		if total.Validate() != nil {
+			return ErrInvalidGenesisTokenDistribution
+		}
+	}
+	return nil
+}
+
 // NewGenesisBlock create genesis @Block from file.
 func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 	accState, err := state.NewAccountState(nil, chain.storage)
@@ -67,6 +124,10 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 	if err != nil {
 		return nil, err
 	}
+	receiptsTrie, err := trie.NewBatchTrie(nil, chain.storage)
+	if err != nil {
+		return nil, err
+	}
 	dposContext, err := NewDposContext(chain.storage)
 	if err != nil {
 		return nil, err
@@ -80,14 +141,15 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 			timestamp:   GenesisTimestamp,
 			nonce:       0,
 		},
-		accState:    accState,
-		txsTrie:     txsTrie,
-		eventsTrie:  eventsTrie,
-		dposContext: dposContext,
-		txPool:      chain.txPool,
-		storage:     chain.storage,
-		height:      1,
-		sealed:      false,
+		accState:     accState,
+		txsTrie:      txsTrie,
+		eventsTrie:   eventsTrie,
+		receiptsTrie: receiptsTrie,
+		dposContext:  dposContext,
+		txPool:       chain.txPool,
+		storage:      chain.storage,
+		height:       1,
+		sealed:       false,
 	}
 
 	context, err := GenesisDynastyContext(chain, conf)
@@ -167,11 +229,55 @@ func DumpGenesis(stor storage.Storage) (*corepb.Genesis, error) {
 			Value:   balance.String(),
 		})
 	}
-	return &corepb.Genesis{
-		Meta: &corepb.GenesisMeta{ChainId: genesis.ChainID()},
-		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{Dynasty: bootstrap},
-		},
+	consensus, err := loadConsensusFromStorage(stor)
+	if err != nil {
+		return nil, err
+	}
+	consensus.Dpos.Dynasty = bootstrap
+	genesisConf := &corepb.Genesis{
+		Meta:              &corepb.GenesisMeta{ChainId: genesis.ChainID()},
+		Consensus:         consensus,
 		TokenDistribution: distribution,
-	}, nil
+	}
+	schedule, err := loadForksFromStorage(stor)
+	if err != nil {
+		return nil, err
+	}
+	if schedule != nil {
+		genesisConf.Forks = schedule.Forks
+	}
+	return genesisConf, nil
+}
+
+// loadForksFromStorage return the fork schedule of genesis, nil if it is not stored yet.
+func loadForksFromStorage(stor storage.Storage) (*corepb.GenesisForkSchedule, error) {
+	bytes, err := stor.Get([]byte(GenesisForks))
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	schedule := new(corepb.GenesisForkSchedule)
+	if err := proto.Unmarshal(bytes, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// loadConsensusFromStorage return the consensus config of genesis without dynasty,
+// the default dpos params are used by chains created before the config is stored.
+func loadConsensusFromStorage(stor storage.Storage) (*corepb.GenesisConsensus, error) {
+	bytes, err := stor.Get([]byte(GenesisConsensus))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == storage.ErrKeyNotFound {
+		return &corepb.GenesisConsensus{Dpos: DefaultDposParams.ToProto()}, nil
+	}
+	consensus := new(corepb.GenesisConsensus)
+	if err := proto.Unmarshal(bytes, consensus); err != nil {
+		return nil, err
+	}
+	return consensus, nil
 }
@@ -89,7 +89,10 @@ func TestNewGenesisBlock(t *testing.T) {
 	conf := MockGenesisConf()
 	storage, err := storage.NewMemoryStorage()
 	assert.Nil(t, err)
-	chain := &BlockChain{storage: storage}
+	chain := &BlockChain{storage: storage, forks: DefaultForkSchedule, dposParams: DefaultDposParams}
+	chain.txPool, err = NewTransactionPool(128)
+	assert.Nil(t, err)
+	chain.txPool.setBlockChain(chain)
 	genesis, err := NewGenesisBlock(conf, chain)
 	assert.Nil(t, chain.storeBlockToStorage(genesis))
 	assert.Nil(t, err)
@@ -143,3 +146,35 @@ func TestNewGenesisBlock(t *testing.T) {
 	assert.Equal(t, dumpConf.Consensus.Dpos.Dynasty, conf.Consensus.Dpos.Dynasty)
 	assert.Equal(t, dumpConf.TokenDistribution, conf.TokenDistribution)
 }
+
+func TestCheckGenesisConf(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(conf *corepb.Genesis)
+		err    error
+	}{
+		{"valid", func(conf *corepb.Genesis) {}, nil},
+		{"no chain id", func(conf *corepb.Genesis) { conf.Meta.ChainId = 0 }, ErrInvalidGenesisChainID},
+		{"no consensus", func(conf *corepb.Genesis) { conf.Consensus = nil }, ErrInitialDynastyNotEnough},
+		{"dynasty below safe size", func(conf *corepb.Genesis) { conf.Consensus.Dpos.Dynasty = MockDynasty[:2] }, ErrInitialDynastyNotEnough},
+		{"duplicated validator", func(conf *corepb.Genesis) {
+			conf.Consensus.Dpos.Dynasty = append([]string{MockDynasty[1]}, MockDynasty[1:]...)
+		}, ErrDuplicatedGenesisAddress},
+		{"duplicated account", func(conf *corepb.Genesis) {
+			conf.TokenDistribution[1].Address = conf.TokenDistribution[0].Address
+		}, ErrDuplicatedGenesisAddress},
+		{"invalid address", func(conf *corepb.Genesis) { conf.TokenDistribution[0].Address = "1a26" }, ErrInvalidAddress},
+		{"invalid value", func(conf *corepb.Genesis) { conf.TokenDistribution[0].Value = "-1" }, ErrInvalidGenesisTokenDistribution},
+		{"total overflow", func(conf *corepb.Genesis) {
+			conf.TokenDistribution[0].Value = "340282366920938463463374607431768211455"
+		}, ErrInvalidGenesisTokenDistribution},
+		{"invalid dpos params", func(conf *corepb.Genesis) { conf.Consensus.Dpos.DoubleSignBurnPercent = 101 }, ErrInvalidGenesisBurnPercent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := MockGenesisConf()
+			tt.modify(conf)
+			assert.Equal(t, tt.err, CheckGenesisConf(conf))
+		})
+	}
+}
@@ -0,0 +1,567 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Governed param names
+const (
+	ParamBlockReward           = "block_reward"
+	ParamMinGasPrice           = "min_gas_price"
+	ParamUnbondingPeriod       = "unbonding_period"
+	ParamCandidateDeposit      = "candidate_deposit"
+	ParamJailDynasties         = "jail_dynasties"
+	ParamDoubleSignBurnPercent = "double_sign_burn_percent"
+	ParamVotingPeriod          = "voting_period"
+	ParamBlockGasLimit         = "block_gas_limit"
+	ParamBlockSizeLimit        = "block_size_limit"
+	ParamProposalDeposit       = "proposal_deposit"
+	ParamGovernanceQuorum      = "governance_quorum"
+	ParamGovernanceThreshold   = "governance_threshold"
+	ParamMaxProposalsPerHeight = "max_proposals_per_height"
+)
+
+// Proposal status
+const (
+	ProposalVoting = iota
+	ProposalPassed
+	ProposalRejected
+	ProposalActivated
+)
+
+// A proposal passes if the voted stake reaches GovernanceQuorum percent of the bonded stake
+// and the approving stake is more than GovernanceThreshold percent of the voted stake,
+// the defaults until changed by governance.
+const (
+	GovernanceQuorum    = 40
+	GovernanceThreshold = 50
+)
+
+// MaxProposalsPerHeight is the default max proposals whose voting ends at a height,
+// each one is tallied in the block. LimitProposalsPerHeight bounds the governed value.
+const (
+	MaxProposalsPerHeight   = 8
+	LimitProposalsPerHeight = 64
+)
+
+// ProposalDeposit is the default deposit locked by a proposal, returned if the proposal reaches the quorum.
+var ProposalDeposit = util.NewUint128FromBigInt(util.NewUint128().Mul(util.NewUint128FromInt(10).Int,
+	util.NewUint128().Exp(util.NewUint128FromInt(10).Int, util.NewUint128FromInt(18).Int, nil)))
+
+// GovernedParams is the chain params changeable by governance proposals,
+// the genesis and default values are used until changed.
+// The block interval, dynasty interval and dynasty size stay fixed in genesis,
+// the slot and dynasty of a block are derived from its timestamp with them.
+type GovernedParams struct {
+	BlockReward           *util.Uint128
+	MinGasPrice           *util.Uint128 // zero for no limit in consensus
+	UnbondingPeriod       uint64
+	CandidateDeposit      *util.Uint128
+	JailDynasties         int64
+	DoubleSignBurnPercent int
+	VotingPeriod          uint64
+	BlockGasLimit         *util.Uint128
+	BlockSizeLimit        uint64
+	ProposalDeposit       *util.Uint128
+	GovernanceQuorum      int
+	GovernanceThreshold   int
+	MaxProposalsPerHeight int
+}
+
+// set parses the value of the param, the params are unchanged on error.
+func (p *GovernedParams) set(name, value string) error {
+	parseUint128 := func() (*util.Uint128, error) {
+		v, ok := util.NewUint128().FromString(value)
+		if !ok || v.Sign() < 0 || v.Validate() != nil {
+			return nil, ErrInvalidGovernedParamValue
+		}
+		return v, nil
+	}
+	parseUint := func(max uint64) (uint64, error) {
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || v > max {
+			return 0, ErrInvalidGovernedParamValue
+		}
+		return v, nil
+	}
+
+	switch name {
+	case ParamBlockReward:
+		v, err := parseUint128()
+		if err != nil {
+			return err
+		}
+		p.BlockReward = v
+	case ParamMinGasPrice:
+		v, err := parseUint128()
+		if err != nil {
+			return err
+		}
+		p.MinGasPrice = v
+	case ParamUnbondingPeriod:
+		v, err := parseUint(^uint64(0))
+		if err != nil || v == 0 {
+			return ErrInvalidGovernedParamValue
+		}
+		p.UnbondingPeriod = v
+	case ParamCandidateDeposit:
+		v, err := parseUint128()
+		if err != nil {
+			return err
+		}
+		p.CandidateDeposit = v
+	case ParamJailDynasties:
+		v, err := parseUint(1 << 31)
+		if err != nil || v == 0 {
+			return ErrInvalidGovernedParamValue
+		}
+		p.JailDynasties = int64(v)
+	case ParamDoubleSignBurnPercent:
+		v, err := parseUint(100)
+		if err != nil {
+			return err
+		}
+		p.DoubleSignBurnPercent = int(v)
+	case ParamVotingPeriod:
+		v, err := parseUint(^uint64(0))
+		if err != nil || v == 0 {
+			return ErrInvalidGovernedParamValue
+		}
+		p.VotingPeriod = v
+	case ParamBlockGasLimit:
+		v, err := parseUint128()
+		if err != nil {
+			return err
+		}
+		// a tx with the max gas limit always fits in a block
+		if v.Cmp(TransactionMaxGas.Int) < 0 {
+			return ErrInvalidGovernedParamValue
+		}
+		p.BlockGasLimit = v
+	case ParamBlockSizeLimit:
+		v, err := parseUint(^uint64(0))
+		if err != nil || v < MinBlockSizeLimit {
+			return ErrInvalidGovernedParamValue
+		}
+		p.BlockSizeLimit = v
+	case ParamProposalDeposit:
+		v, err := parseUint128()
+		if err != nil {
+			return err
+		}
+		p.ProposalDeposit = v
+	case ParamGovernanceQuorum:
+		// a zero quorum would pass a proposal without votes
+		v, err := parseUint(100)
+		if err != nil || v == 0 {
+			return ErrInvalidGovernedParamValue
+		}
+		p.GovernanceQuorum = int(v)
+	case ParamGovernanceThreshold:
+		// the approving stake must be more than the threshold, 100 would lock the params
+		v, err := parseUint(99)
+		if err != nil {
+			return err
+		}
+		p.GovernanceThreshold = int(v)
+	case ParamMaxProposalsPerHeight:
+		v, err := parseUint(LimitProposalsPerHeight)
+		if err != nil || v == 0 {
+			return ErrInvalidGovernedParamValue
+		}
+		p.MaxProposalsPerHeight = int(v)
+	default:
+		return ErrUnknownGovernedParam
+	}
+	return nil
+}
+
+// Strings returns the values of the params by names.
+func (p *GovernedParams) Strings() map[string]string {
+	return map[string]string{
+		ParamBlockReward:           p.BlockReward.String(),
+		ParamMinGasPrice:           p.MinGasPrice.String(),
+		ParamUnbondingPeriod:       strconv.FormatUint(p.UnbondingPeriod, 10),
+		ParamCandidateDeposit:      p.CandidateDeposit.String(),
+		ParamJailDynasties:         strconv.FormatInt(p.JailDynasties, 10),
+		ParamDoubleSignBurnPercent: strconv.Itoa(p.DoubleSignBurnPercent),
+		ParamVotingPeriod:          strconv.FormatUint(p.VotingPeriod, 10),
+		ParamBlockGasLimit:         p.BlockGasLimit.String(),
+		ParamBlockSizeLimit:        strconv.FormatUint(p.BlockSizeLimit, 10),
+		ParamProposalDeposit:       p.ProposalDeposit.String(),
+		ParamGovernanceQuorum:      strconv.Itoa(p.GovernanceQuorum),
+		ParamGovernanceThreshold:   strconv.Itoa(p.GovernanceThreshold),
+		ParamMaxProposalsPerHeight: strconv.Itoa(p.MaxProposalsPerHeight),
+	}
+}
+
+func paramKey(name string) []byte {
+	return append([]byte{paramPrefix}, []byte(name)...)
+}
+
+// loadGovernedParams returns the dpos params and defaults overridden by the values changed by proposals.
+func loadGovernedParams(stakeTrie *trie.BatchTrie, dpos *DposParams) (*GovernedParams, error) {
+	params := &GovernedParams{
+		BlockReward:           BlockReward,
+		MinGasPrice:           util.NewUint128(),
+		UnbondingPeriod:       dpos.UnbondingPeriod,
+		CandidateDeposit:      dpos.CandidateDeposit,
+		JailDynasties:         dpos.JailDynasties,
+		DoubleSignBurnPercent: dpos.DoubleSignBurnPercent,
+		VotingPeriod:          dpos.VotingPeriod,
+		BlockGasLimit:         dpos.BlockGasLimit,
+		BlockSizeLimit:        dpos.BlockSizeLimit,
+		ProposalDeposit:       ProposalDeposit,
+		GovernanceQuorum:      GovernanceQuorum,
+		GovernanceThreshold:   GovernanceThreshold,
+		MaxProposalsPerHeight: MaxProposalsPerHeight,
+	}
+	for name := range params.Strings() {
+		value, err := stakeTrie.Get(paramKey(name))
+		if err == storage.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := params.set(name, string(value)); err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+// GovernedParams returns the governed params at the block.
+func (block *Block) GovernedParams() (*GovernedParams, error) {
+	return loadGovernedParams(block.dposContext.stakeTrie, block.DposParams())
+}
+
+// txsGovernedParams returns the governed params checked by the txs of the block, loaded once
+// per block since the params only change in executeGovernance after the txs. The params are shared, read only.
+func (block *Block) txsGovernedParams() (*GovernedParams, error) {
+	if block.txsParams == nil {
+		params, err := block.GovernedParams()
+		if err != nil {
+			return nil, err
+		}
+		block.txsParams = params
+	}
+	return block.txsParams, nil
+}
+
+// Proposal is a governance proposal changing a param at the activation height.
+type Proposal struct {
+	ID               byteutils.Hash
+	Proposer         byteutils.Hash
+	Param            string
+	Value            string
+	EndHeight        uint64
+	ActivationHeight uint64
+	Status           uint32
+	Yes              *util.Uint128
+	No               *util.Uint128
+	Deposit          *util.Uint128
+}
+
+// ToProto converts the proposal to proto.
+func (p *Proposal) ToProto() (proto.Message, error) {
+	yes, err := p.Yes.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	no, err := p.No.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := p.Deposit.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Proposal{
+		Id:               p.ID,
+		Proposer:         p.Proposer,
+		Param:            p.Param,
+		Value:            p.Value,
+		EndHeight:        p.EndHeight,
+		ActivationHeight: p.ActivationHeight,
+		Status:           p.Status,
+		Yes:              yes,
+		No:               no,
+		Deposit:          deposit,
+	}, nil
+}
+
+// FromProto converts proto to the proposal.
+func (p *Proposal) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.Proposal); ok {
+		yes, err := util.NewUint128FromFixedSizeByteSlice(msg.Yes)
+		if err != nil {
+			return err
+		}
+		no, err := util.NewUint128FromFixedSizeByteSlice(msg.No)
+		if err != nil {
+			return err
+		}
+		deposit := util.NewUint128()
+		if len(msg.Deposit) > 0 {
+			if deposit, err = util.NewUint128FromFixedSizeByteSlice(msg.Deposit); err != nil {
+				return err
+			}
+		}
+		p.ID = msg.Id
+		p.Proposer = msg.Proposer
+		p.Param = msg.Param
+		p.Value = msg.Value
+		p.EndHeight = msg.EndHeight
+		p.ActivationHeight = msg.ActivationHeight
+		p.Status = msg.Status
+		p.Yes = yes
+		p.No = no
+		p.Deposit = deposit
+		return nil
+	}
+	return errors.New("Protobuf message cannot be converted into Proposal")
+}
+
+func proposalKey(id byteutils.Hash) []byte {
+	return append([]byte{proposalPrefix}, id...)
+}
+
+func proposalEndKey(end uint64, id byteutils.Hash) []byte {
+	key := append([]byte{proposalEndPrefix}, byteutils.FromUint64(end)...)
+	return append(key, id...)
+}
+
+func proposalVoteKey(id byteutils.Hash, voter byteutils.Hash) []byte {
+	key := append([]byte{proposalVotePrefix}, id...)
+	return append(key, voter...)
+}
+
+func paramChangeKey(activation uint64, id byteutils.Hash) []byte {
+	key := append([]byte{paramChangePrefix}, byteutils.FromUint64(activation)...)
+	return append(key, id...)
+}
+
+func getProposal(stakeTrie *trie.BatchTrie, id byteutils.Hash) (*Proposal, error) {
+	bytes, err := stakeTrie.Get(proposalKey(id))
+	if err == storage.ErrKeyNotFound {
+		return nil, ErrProposalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg := new(corepb.Proposal)
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+	proposal := new(Proposal)
+	if err := proposal.FromProto(msg); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func putProposal(stakeTrie *trie.BatchTrie, proposal *Proposal) error {
+	msg, err := proposal.ToProto()
+	if err != nil {
+		return err
+	}
+	bytes, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = stakeTrie.Put(proposalKey(proposal.ID), bytes)
+	return err
+}
+
+// iterateValues returns the values of the keys with the prefix.
+func iterateValues(stakeTrie *trie.BatchTrie, prefix []byte) ([][]byte, error) {
+	values := [][]byte{}
+	iter, err := stakeTrie.Iterator(prefix)
+	if err == storage.ErrKeyNotFound {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		values = append(values, iter.Value())
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// executeGovernance tallies the proposals whose voting period ends at the block
+// and applies the param changes activated at the block, the changes take effect from the next block.
+func (block *Block) executeGovernance() error {
+	if !block.ChainRules().IsGovernance {
+		return nil
+	}
+	stakeTrie := block.dposContext.stakeTrie
+
+	ids, err := iterateValues(stakeTrie, proposalEndKey(block.height, nil))
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		total, err := totalBondedStake(stakeTrie)
+		if err != nil {
+			return err
+		}
+		params, err := block.GovernedParams()
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if _, err := stakeTrie.Del(proposalEndKey(block.height, id)); err != nil {
+				return err
+			}
+			if err := block.tallyProposal(id, total, params); err != nil {
+				return err
+			}
+		}
+	}
+
+	ids, err = iterateValues(stakeTrie, paramChangeKey(block.height, nil))
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := stakeTrie.Del(paramChangeKey(block.height, id)); err != nil {
+			return err
+		}
+		proposal, err := getProposal(stakeTrie, id)
+		if err != nil {
+			return err
+		}
+		if _, err := stakeTrie.Put(paramKey(proposal.Param), []byte(proposal.Value)); err != nil {
+			return err
+		}
+		proposal.Status = ProposalActivated
+		if err := putProposal(stakeTrie, proposal); err != nil {
+			return err
+		}
+		block.txsParams = nil
+		logging.VLog().WithFields(logrus.Fields{
+			"proposal": proposal.ID.Hex(),
+			"param":    proposal.Param,
+			"value":    proposal.Value,
+			"height":   block.height,
+		}).Info("Activated governed param.")
+	}
+	return nil
+}
+
+// totalBondedStake sums the bonded stake of all delegators.
+func totalBondedStake(stakeTrie *trie.BatchTrie) (*big.Int, error) {
+	total := new(big.Int)
+	stakes, err := iterateValues(stakeTrie, []byte{bondedStakePrefix})
+	if err != nil {
+		return nil, err
+	}
+	for _, bytes := range stakes {
+		stake, err := util.NewUint128FromFixedSizeByteSlice(bytes)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, stake.Int)
+	}
+	return total, nil
+}
+
+// tallyProposal counts the votes weighted by the voters' bonded stake at the end of voting,
+// total is the bonded stake of all delegators, params the governed quorum and threshold.
+// The deposit is returned to the proposer if the votes reach the quorum, burned otherwise.
+func (block *Block) tallyProposal(id byteutils.Hash, total *big.Int, params *GovernedParams) error {
+	stakeTrie := block.dposContext.stakeTrie
+	proposal, err := getProposal(stakeTrie, id)
+	if err != nil {
+		return err
+	}
+
+	votes, err := iterateValues(stakeTrie, proposalVoteKey(id, nil))
+	if err != nil {
+		return err
+	}
+	for _, vote := range votes {
+		voter := byteutils.Hash(vote[:AddressLength])
+		stake, err := getStake(stakeTrie, bondedStakeKey(voter))
+		if err != nil {
+			return err
+		}
+		if vote[AddressLength] == 1 {
+			proposal.Yes.Add(proposal.Yes.Int, stake.Int)
+		} else {
+			proposal.No.Add(proposal.No.Int, stake.Int)
+		}
+		if _, err := stakeTrie.Del(proposalVoteKey(id, voter)); err != nil {
+			return err
+		}
+	}
+
+	voted := new(big.Int).Add(proposal.Yes.Int, proposal.No.Int)
+	quorum := new(big.Int).Mul(voted, big.NewInt(100)).Cmp(new(big.Int).Mul(total, big.NewInt(int64(params.GovernanceQuorum)))) >= 0
+	threshold := new(big.Int).Mul(proposal.Yes.Int, big.NewInt(100)).Cmp(new(big.Int).Mul(voted, big.NewInt(int64(params.GovernanceThreshold)))) > 0
+	if total.Sign() > 0 && quorum && threshold {
+		proposal.Status = ProposalPassed
+		if _, err := stakeTrie.Put(paramChangeKey(proposal.ActivationHeight, id), id); err != nil {
+			return err
+		}
+	} else {
+		proposal.Status = ProposalRejected
+	}
+	if total.Sign() > 0 && quorum && proposal.Deposit.Sign() > 0 {
+		acc, err := block.accState.GetOrCreateUserAccount(proposal.Proposer)
+		if err != nil {
+			return err
+		}
+		acc.AddBalance(proposal.Deposit)
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"proposal": proposal.ID.Hex(),
+		"yes":      proposal.Yes.String(),
+		"no":       proposal.No.String(),
+		"total":    total.String(),
+		"status":   proposal.Status,
+	}).Info("Tallied governance proposal.")
+	return putProposal(stakeTrie, proposal)
+}
+
+// Proposal returns the governance proposal by id.
+func (block *Block) Proposal(id byteutils.Hash) (*Proposal, error) {
+	return getProposal(block.dposContext.stakeTrie, id)
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposalPayload_Governance(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Forks = []*corepb.GenesisFork{{Name: ForkStaking, Height: 0}, {Name: ForkGovernance, Height: 0}}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	block, err := NewBlock(bc.ChainID(), mockAddress(), bc.tailBlock)
+	assert.Nil(t, err)
+	block.begin()
+	stakeTrie := block.dposContext.stakeTrie
+
+	voters := make([]*Address, 3)
+	for i, stake := range []int64{300, 200, 0} {
+		pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+		voters[i], _ = NewAddressFromPublicKey(pubdata)
+		assert.Nil(t, putStake(stakeTrie, bondedStakeKey(voters[i].Bytes()), util.NewUint128FromInt(stake)))
+	}
+	deposits := func(n int64) *util.Uint128 {
+		v := util.NewUint128()
+		v.Mul(ProposalDeposit.Int, util.NewUint128FromInt(n).Int)
+		return v
+	}
+	acc, _ := block.accState.GetOrCreateUserAccount(voters[0].Bytes())
+	acc.AddBalance(deposits(10))
+	// bonded stake of delegators not voting
+	assert.Nil(t, putStake(stakeTrie, bondedStakeKey(mockAddress().Bytes()), util.NewUint128FromInt(500)))
+
+	execute := func(from *Address, payload *ProposalPayload) (*Transaction, error) {
+		bytes, _ := payload.ToBytes()
+		tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadProposalType, bytes, TransactionGasPrice, TransactionMaxGas)
+		tx.hash, _ = HashTransaction(tx)
+		_, _, err := payload.Execute(block, tx)
+		return tx, err
+	}
+
+	end := block.height + block.DposParams().VotingPeriod
+	_, err = execute(voters[2], NewProposePayload(ParamBlockReward, "100", end+10))
+	assert.Equal(t, ErrNoStakeToGovern, err)
+	_, err = execute(voters[0], NewProposePayload("gas_limit", "100", end+10))
+	assert.Equal(t, ErrUnknownGovernedParam, err)
+	_, err = execute(voters[0], NewProposePayload(ParamDoubleSignBurnPercent, "101", end+10))
+	assert.Equal(t, ErrInvalidGovernedParamValue, err)
+	_, err = execute(voters[0], NewProposePayload(ParamBlockGasLimit, "1", end+10))
+	assert.Equal(t, ErrInvalidGovernedParamValue, err)
+	_, err = execute(voters[0], NewProposePayload(ParamGovernanceQuorum, "0", end+10))
+	assert.Equal(t, ErrInvalidGovernedParamValue, err)
+	_, err = execute(voters[0], NewProposePayload(ParamGovernanceThreshold, "100", end+10))
+	assert.Equal(t, ErrInvalidGovernedParamValue, err)
+	_, err = execute(voters[0], NewProposePayload(ParamMaxProposalsPerHeight, "65", end+10))
+	assert.Equal(t, ErrInvalidGovernedParamValue, err)
+	_, err = execute(voters[0], NewProposePayload(ParamBlockReward, "100", end))
+	assert.Equal(t, ErrInvalidActivationHeight, err)
+	_, err = execute(voters[0], &ProposalPayload{Action: "withdraw"})
+	assert.Equal(t, ErrInvalidProposalPayloadAction, err)
+
+	passing, err := execute(voters[0], NewProposePayload(ParamBlockReward, "100", end+10))
+	assert.Nil(t, err)
+	failing, err := execute(voters[0], NewProposePayload(ParamMinGasPrice, "100", end+10))
+	assert.Nil(t, err)
+	// proposals ending at a height are limited
+	for i := 2; i < MaxProposalsPerHeight; i++ {
+		_, err = execute(voters[0], NewProposePayload(ParamVotingPeriod, strconv.Itoa(100+i), end+10))
+		assert.Nil(t, err)
+	}
+	_, err = execute(voters[0], NewProposePayload(ParamVotingPeriod, "100", end+10))
+	assert.Equal(t, ErrTooManyProposals, err)
+	balance, _ := block.GetBalance(voters[0].Bytes())
+	assert.Equal(t, deposits(10-MaxProposalsPerHeight).String(), balance.String())
+
+	_, err = execute(voters[1], NewVotePayload("00", true))
+	assert.Equal(t, ErrProposalNotFound, err)
+	for _, vote := range []struct {
+		voter    *Address
+		proposal *Transaction
+		approve  bool
+	}{
+		{voters[0], passing, false},
+		{voters[0], passing, true}, // voting again overrides
+		{voters[1], passing, false},
+		{voters[1], failing, true},
+	} {
+		_, err = execute(vote.voter, NewVotePayload(vote.proposal.hash.String(), vote.approve))
+		assert.Nil(t, err)
+	}
+
+	// votes are tallied at the end of voting and the params change at the activation height
+	block.height = end
+	assert.Nil(t, block.executeGovernance())
+	_, err = execute(voters[1], NewVotePayload(passing.hash.String(), true))
+	assert.Equal(t, ErrProposalVotingClosed, err)
+
+	proposal, err := block.Proposal(passing.hash)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(ProposalPassed), proposal.Status)
+	assert.Equal(t, "300", proposal.Yes.String())
+	assert.Equal(t, "200", proposal.No.String())
+	// 200 of 1000 bonded stake does not reach the governed quorum
+	proposal, err = block.Proposal(failing.hash)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(ProposalRejected), proposal.Status)
+	// only the deposit of the passing proposal reaching the quorum is returned
+	balance, _ = block.GetBalance(voters[0].Bytes())
+	assert.Equal(t, deposits(10-MaxProposalsPerHeight+1).String(), balance.String())
+
+	block.height = end + 10
+	assert.Nil(t, block.executeGovernance())
+	proposal, _ = block.Proposal(passing.hash)
+	assert.Equal(t, uint32(ProposalActivated), proposal.Status)
+	params, err := block.GovernedParams()
+	assert.Nil(t, err)
+	assert.Equal(t, "100", params.BlockReward.String())
+	assert.Equal(t, "0", params.MinGasPrice.String())
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// GetLogs limits.
+const (
+	// MaxLogsRange is the max number of blocks queried by GetLogs at once.
+	MaxLogsRange = uint64(10000)
+
+	// MaxLogsRangeWithoutBloom is the max number of blocks before ForkLogsBloom queried at once,
+	// they have no bloom and every tx of them is scanned.
+	MaxLogsRangeWithoutBloom = uint64(1000)
+
+	// MaxLogsLimit is the max number of logs returned by GetLogs at once.
+	MaxLogsLimit = 10000
+)
+
+// Log is an event recorded by a transaction on the canonical chain.
+type Log struct {
+	BlockHeight uint64
+	BlockHash   byteutils.Hash
+	TxHash      byteutils.Hash
+
+	// Address is the contract the tx deployed or called, nil for other txs.
+	Address *Address
+
+	Topic string
+	Data  string
+}
+
+// LogFilter selects the logs of the blocks from FromHeight to ToHeight included,
+// FromHeight 0 for the genesis.
+type LogFilter struct {
+	FromHeight uint64
+	ToHeight   uint64
+
+	// Address matches the emitting contract, nil for any including the logs of non contract txs.
+	Address *Address
+
+	// Topics matches any of the topics, empty for any but the execution results recorded
+	// for every tx, which are only matched if listed.
+	Topics []string
+
+	// Limit is the number of logs returned before the query stops at a block boundary,
+	// 0 or above MaxLogsLimit for MaxLogsLimit.
+	Limit int
+}
+
+// isExecutionResult returns if the topic is the execution result recorded for every tx.
+func isExecutionResult(topic string) bool {
+	switch topic {
+	case TopicTransactionExecutionResult, TopicExecuteTxSuccess, TopicExecuteTxFailed:
+		return true
+	}
+	return false
+}
+
+// matchEvent returns if the event emitted by address matches the filter.
+func (f *LogFilter) matchEvent(address *Address, event *Event) bool {
+	if !f.matchAddress(address) {
+		return false
+	}
+	if len(f.Topics) == 0 {
+		return !isExecutionResult(event.Topic)
+	}
+	for _, topic := range f.Topics {
+		if topic == event.Topic {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAddress returns if the contract matches the filter, address is nil for non contract txs.
+func (f *LogFilter) matchAddress(address *Address) bool {
+	return f.Address == nil || (address != nil && f.Address.Equals(address))
+}
+
+// matchBloom returns if the block may contain logs matching the filter.
+func (f *LogFilter) matchBloom(bloom Bloom) bool {
+	if f.Address != nil && !bloom.Test(f.Address.Bytes()) {
+		return false
+	}
+	if len(f.Topics) == 0 {
+		return true
+	}
+	for _, topic := range f.Topics {
+		if bloom.Test([]byte(topic)) {
+			return true
+		}
+	}
+	return false
+}
+
+// logAddress returns the contract emitting the events of the tx, nil if the tx deploys or calls no contract.
+func logAddress(tx *Transaction) (*Address, error) {
+	switch tx.Type() {
+	case TxPayloadDeployType:
+		return tx.GenerateContractAddress()
+	case TxPayloadCallType:
+		return tx.To(), nil
+	}
+	return nil, nil
+}
+
+// logsBloom returns the bloom over the event topics and emitting contracts of the txs in the block.
+func (block *Block) logsBloom() (Bloom, error) {
+	var bloom Bloom
+	for _, tx := range block.transactions {
+		events, err := block.FetchEvents(tx.hash)
+		if err != nil {
+			return bloom, err
+		}
+		if len(events) == 0 {
+			continue
+		}
+		address, err := logAddress(tx)
+		if err != nil {
+			return bloom, err
+		}
+		if address != nil {
+			bloom.Add(address.Bytes())
+		}
+		for _, event := range events {
+			bloom.Add([]byte(event.Topic))
+		}
+	}
+	return bloom, nil
+}
+
+// Logs returns the logs of the txs in the block matching the filter, the height range is ignored.
+func (block *Block) Logs(filter *LogFilter) ([]*Log, error) {
+	// blocks before ForkLogsBloom have no bloom and are always scanned.
+	if len(block.LogsBloom()) > 0 && !filter.matchBloom(BytesToBloom(block.LogsBloom())) {
+		return nil, nil
+	}
+
+	var logs []*Log
+	for _, tx := range block.transactions {
+		address, err := logAddress(tx)
+		if err != nil {
+			return nil, err
+		}
+		if !filter.matchAddress(address) {
+			continue
+		}
+		events, err := block.FetchEvents(tx.hash)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if !filter.matchEvent(address, event) {
+				continue
+			}
+			logs = append(logs, &Log{
+				BlockHeight: block.height,
+				BlockHash:   block.Hash(),
+				TxHash:      tx.hash,
+				Address:     address,
+				Topic:       event.Topic,
+				Data:        event.Data,
+			})
+		}
+	}
+	return logs, nil
+}
+
+// GetLogs returns the logs on the canonical chain matching the filter, in block and tx order.
+// The query stops after the block reaching the limit, the logs of a block are never split,
+// and it returns the height to query next, 0 if all the blocks in the range are queried.
+func (bc *BlockChain) GetLogs(filter *LogFilter) ([]*Log, uint64, error) {
+	from, to := filter.FromHeight, filter.ToHeight
+	if from == 0 {
+		from = bc.genesisBlock.Height()
+	}
+	if tail := bc.TailBlock().Height(); to > tail {
+		to = tail
+	}
+	if from > to {
+		return nil, 0, ErrInvalidLogsRange
+	}
+	if to-from >= MaxLogsRange {
+		return nil, 0, ErrExceedMaxLogsRange
+	}
+	// blocks up to the fork height have no bloom.
+	if fork := bc.forks.Height(ForkLogsBloom); from <= fork {
+		last := to
+		if last > fork {
+			last = fork
+		}
+		if last-from >= MaxLogsRangeWithoutBloom {
+			return nil, 0, ErrExceedMaxLogsRangeWithoutBloom
+		}
+	}
+	limit := filter.Limit
+	if limit <= 0 || limit > MaxLogsLimit {
+		limit = MaxLogsLimit
+	}
+
+	var logs []*Log
+	for height := from; height <= to; height++ {
+		block := bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, 0, ErrInvalidLogsRange
+		}
+		blockLogs, err := block.Logs(filter)
+		if err != nil {
+			return nil, 0, err
+		}
+		logs = append(logs, blockLogs...)
+		if len(logs) >= limit && height < to {
+			return logs, height + 1, nil
+		}
+	}
+	return logs, 0, nil
+}
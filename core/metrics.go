@@ -38,6 +38,7 @@ var (
 	metricsCachedDownloadBlock = metrics.NewGauge("neb.block.download.cached")
 	metricsDuplicatedBlock     = metrics.NewCounter("neb.block.duplicated")
 	metricsInvalidBlock        = metrics.NewCounter("neb.block.invalid")
+	metricsDoubleSignEvidence  = metrics.NewCounter("neb.block.double_sign")
 	metricsTxsInBlock          = metrics.NewGauge("neb.block.txs")
 	metricsBlockVerifiedTime   = metrics.NewGauge("neb.block.executed")
 	metricsTxVerifiedTime      = metrics.NewGauge("neb.tx.executed")
@@ -51,6 +52,7 @@ var (
 	metricsDuplicateTx         = metrics.NewCounter("neb.txpool.duplicate")
 	metricsTxPoolBelowGasPrice = metrics.NewCounter("neb.txpool.below_gas_price")
 	metricsTxPoolOutOfGasLimit = metrics.NewCounter("neb.txpool.out_of_gas_limit")
+	metricsTxPoolFiltered      = metrics.NewCounter("neb.txpool.filtered")
 
 	// transaction metrics
 	metricsTxSubmit     = metrics.NewMeter("neb.transaction.submit")
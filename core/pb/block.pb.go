@@ -12,11 +12,15 @@ It has these top-level messages:
 	Data
 	Transaction
 	DposContext
+	Candidate
+	Proposal
 	BlockHeader
 	Block
 	NetBlocks
 	NetBlock
 	DownloadBlock
+	Event
+	Receipt
 */
 package corepb
 
@@ -120,6 +124,9 @@ type Transaction struct {
 	GasLimit  []byte `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	Alg       uint32 `protobuf:"varint,11,opt,name=alg,proto3" json:"alg,omitempty"`
 	Sign      []byte `protobuf:"bytes,12,opt,name=sign,proto3" json:"sign,omitempty"`
+	// optional validity window, the tx can't be packed after it, 0 means unset.
+	ValidUntilHeight    uint64 `protobuf:"varint,13,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	ValidUntilTimestamp int64  `protobuf:"varint,14,opt,name=valid_until_timestamp,json=validUntilTimestamp,proto3" json:"valid_until_timestamp,omitempty"`
 }
 
 func (m *Transaction) Reset()                    { *m = Transaction{} }
@@ -211,6 +218,20 @@ func (m *Transaction) GetSign() []byte {
 	return nil
 }
 
+func (m *Transaction) GetValidUntilHeight() uint64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
+func (m *Transaction) GetValidUntilTimestamp() int64 {
+	if m != nil {
+		return m.ValidUntilTimestamp
+	}
+	return 0
+}
+
 type DposContext struct {
 	DynastyRoot     []byte `protobuf:"bytes,1,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
 	NextDynastyRoot []byte `protobuf:"bytes,2,opt,name=next_dynasty_root,json=nextDynastyRoot,proto3" json:"next_dynasty_root,omitempty"`
@@ -218,6 +239,7 @@ type DposContext struct {
 	CandidateRoot   []byte `protobuf:"bytes,4,opt,name=candidate_root,json=candidateRoot,proto3" json:"candidate_root,omitempty"`
 	VoteRoot        []byte `protobuf:"bytes,5,opt,name=vote_root,json=voteRoot,proto3" json:"vote_root,omitempty"`
 	MintCntRoot     []byte `protobuf:"bytes,6,opt,name=mint_cnt_root,json=mintCntRoot,proto3" json:"mint_cnt_root,omitempty"`
+	StakeRoot       []byte `protobuf:"bytes,7,opt,name=stake_root,json=stakeRoot,proto3" json:"stake_root,omitempty"`
 }
 
 func (m *DposContext) Reset()                    { *m = DposContext{} }
@@ -267,25 +289,179 @@ func (m *DposContext) GetMintCntRoot() []byte {
 	return nil
 }
 
+func (m *DposContext) GetStakeRoot() []byte {
+	if m != nil {
+		return m.StakeRoot
+	}
+	return nil
+}
+
+type Candidate struct {
+	Address    []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url        string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Endpoint   string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Commission uint32 `protobuf:"varint,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	Deposit    []byte `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *Candidate) Reset()                    { *m = Candidate{} }
+func (m *Candidate) String() string            { return proto.CompactTextString(m) }
+func (*Candidate) ProtoMessage()               {}
+func (*Candidate) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{4} }
+
+func (m *Candidate) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Candidate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Candidate) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Candidate) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Candidate) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *Candidate) GetDeposit() []byte {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+type Proposal struct {
+	Id               []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer         []byte `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Param            string `protobuf:"bytes,3,opt,name=param,proto3" json:"param,omitempty"`
+	Value            string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	EndHeight        uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	ActivationHeight uint64 `protobuf:"varint,6,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Status           uint32 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Yes              []byte `protobuf:"bytes,8,opt,name=yes,proto3" json:"yes,omitempty"`
+	No               []byte `protobuf:"bytes,9,opt,name=no,proto3" json:"no,omitempty"`
+	Deposit          []byte `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *Proposal) Reset()                    { *m = Proposal{} }
+func (m *Proposal) String() string            { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()               {}
+func (*Proposal) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{5} }
+
+func (m *Proposal) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Proposal) GetProposer() []byte {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *Proposal) GetParam() string {
+	if m != nil {
+		return m.Param
+	}
+	return ""
+}
+
+func (m *Proposal) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Proposal) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Proposal) GetYes() []byte {
+	if m != nil {
+		return m.Yes
+	}
+	return nil
+}
+
+func (m *Proposal) GetNo() []byte {
+	if m != nil {
+		return m.No
+	}
+	return nil
+}
+
+func (m *Proposal) GetDeposit() []byte {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
 type BlockHeader struct {
-	Hash        []byte       `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ParentHash  []byte       `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
-	Nonce       uint64       `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Coinbase    []byte       `protobuf:"bytes,4,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
-	Timestamp   int64        `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	ChainId     uint32       `protobuf:"varint,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Alg         uint32       `protobuf:"varint,7,opt,name=alg,proto3" json:"alg,omitempty"`
-	Sign        []byte       `protobuf:"bytes,8,opt,name=sign,proto3" json:"sign,omitempty"`
-	StateRoot   []byte       `protobuf:"bytes,9,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	TxsRoot     []byte       `protobuf:"bytes,10,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
-	EventsRoot  []byte       `protobuf:"bytes,11,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
-	DposContext *DposContext `protobuf:"bytes,12,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
+	Hash         []byte       `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash   []byte       `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Nonce        uint64       `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Coinbase     []byte       `protobuf:"bytes,4,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	Timestamp    int64        `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ChainId      uint32       `protobuf:"varint,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Alg          uint32       `protobuf:"varint,7,opt,name=alg,proto3" json:"alg,omitempty"`
+	Sign         []byte       `protobuf:"bytes,8,opt,name=sign,proto3" json:"sign,omitempty"`
+	StateRoot    []byte       `protobuf:"bytes,9,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	TxsRoot      []byte       `protobuf:"bytes,10,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
+	EventsRoot   []byte       `protobuf:"bytes,11,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
+	DposContext  *DposContext `protobuf:"bytes,12,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
+	GasUsed      []byte       `protobuf:"bytes,13,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	ReceiptsRoot []byte       `protobuf:"bytes,14,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	LogsBloom    []byte       `protobuf:"bytes,15,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
 }
 
 func (m *BlockHeader) Reset()                    { *m = BlockHeader{} }
 func (m *BlockHeader) String() string            { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()               {}
-func (*BlockHeader) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{4} }
+func (*BlockHeader) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
 
 func (m *BlockHeader) GetHash() []byte {
 	if m != nil {
@@ -371,6 +547,27 @@ func (m *BlockHeader) GetDposContext() *DposContext {
 	return nil
 }
 
+func (m *BlockHeader) GetGasUsed() []byte {
+	if m != nil {
+		return m.GasUsed
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetReceiptsRoot() []byte {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetLogsBloom() []byte {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return nil
+}
+
 type Block struct {
 	Header       *BlockHeader   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions" json:"transactions,omitempty"`
@@ -381,7 +578,7 @@ type Block struct {
 func (m *Block) Reset()                    { *m = Block{} }
 func (m *Block) String() string            { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()               {}
-func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{5} }
+func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
 
 func (m *Block) GetHeader() *BlockHeader {
 	if m != nil {
@@ -420,7 +617,7 @@ type NetBlocks struct {
 func (m *NetBlocks) Reset()                    { *m = NetBlocks{} }
 func (m *NetBlocks) String() string            { return proto.CompactTextString(m) }
 func (*NetBlocks) ProtoMessage()               {}
-func (*NetBlocks) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
+func (*NetBlocks) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
 
 func (m *NetBlocks) GetFrom() string {
 	if m != nil {
@@ -452,7 +649,7 @@ type NetBlock struct {
 func (m *NetBlock) Reset()                    { *m = NetBlock{} }
 func (m *NetBlock) String() string            { return proto.CompactTextString(m) }
 func (*NetBlock) ProtoMessage()               {}
-func (*NetBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
+func (*NetBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
 
 func (m *NetBlock) GetFrom() string {
 	if m != nil {
@@ -483,7 +680,7 @@ type DownloadBlock struct {
 func (m *DownloadBlock) Reset()                    { *m = DownloadBlock{} }
 func (m *DownloadBlock) String() string            { return proto.CompactTextString(m) }
 func (*DownloadBlock) ProtoMessage()               {}
-func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{10} }
 
 func (m *DownloadBlock) GetHash() []byte {
 	if m != nil {
@@ -499,66 +696,182 @@ func (m *DownloadBlock) GetSign() []byte {
 	return nil
 }
 
+type Event struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{11} }
+
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Event) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type Receipt struct {
+	TxHash            []byte   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Status            int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	GasUsed           []byte   `protobuf:"bytes,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	CumulativeGasUsed []byte   `protobuf:"bytes,4,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	ContractAddress   []byte   `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Events            []*Event `protobuf:"bytes,6,rep,name=events" json:"events,omitempty"`
+	Error             string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *Receipt) Reset()                    { *m = Receipt{} }
+func (m *Receipt) String() string            { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()               {}
+func (*Receipt) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{12} }
+
+func (m *Receipt) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *Receipt) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Receipt) GetGasUsed() []byte {
+	if m != nil {
+		return m.GasUsed
+	}
+	return nil
+}
+
+func (m *Receipt) GetCumulativeGasUsed() []byte {
+	if m != nil {
+		return m.CumulativeGasUsed
+	}
+	return nil
+}
+
+func (m *Receipt) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return nil
+}
+
+func (m *Receipt) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Receipt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*Data)(nil), "corepb.Data")
 	proto.RegisterType((*Transaction)(nil), "corepb.Transaction")
 	proto.RegisterType((*DposContext)(nil), "corepb.DposContext")
+	proto.RegisterType((*Candidate)(nil), "corepb.Candidate")
+	proto.RegisterType((*Proposal)(nil), "corepb.Proposal")
 	proto.RegisterType((*BlockHeader)(nil), "corepb.BlockHeader")
 	proto.RegisterType((*Block)(nil), "corepb.Block")
 	proto.RegisterType((*NetBlocks)(nil), "corepb.NetBlocks")
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
+	proto.RegisterType((*Event)(nil), "corepb.Event")
+	proto.RegisterType((*Receipt)(nil), "corepb.Receipt")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x96, 0x93, 0x38, 0x3f, 0xc7, 0x4e, 0xef, 0xbd, 0x73, 0x2b, 0xe4, 0xf2, 0xa3, 0x06, 0x57,
-	0x95, 0x22, 0x90, 0xba, 0x28, 0x88, 0xae, 0xa1, 0x59, 0x14, 0x09, 0xa1, 0xca, 0x62, 0x83, 0x84,
-	0x64, 0x4d, 0xec, 0x21, 0xb1, 0x70, 0x66, 0x2c, 0xcf, 0x69, 0x49, 0x1e, 0x83, 0x35, 0xaf, 0xc0,
-	0x73, 0x21, 0xf1, 0x16, 0x68, 0xce, 0x8c, 0x9d, 0x84, 0x76, 0xc3, 0x6e, 0xbe, 0xef, 0x3b, 0x33,
-	0x3e, 0x3f, 0x9f, 0x0f, 0x04, 0xf3, 0x52, 0x65, 0x5f, 0xce, 0xaa, 0x5a, 0xa1, 0x62, 0xfd, 0x4c,
-	0xd5, 0xa2, 0x9a, 0xc7, 0xdf, 0x3c, 0x18, 0xbc, 0xce, 0x32, 0x75, 0x23, 0x91, 0x45, 0x30, 0xe0,
-	0x79, 0x5e, 0x0b, 0xad, 0x23, 0x6f, 0xe2, 0x4d, 0xc3, 0xa4, 0x81, 0x46, 0x99, 0xf3, 0x92, 0xcb,
-	0x4c, 0x44, 0x1d, 0xab, 0x38, 0xc8, 0x0e, 0xc1, 0x97, 0xca, 0xf0, 0xdd, 0x89, 0x37, 0xed, 0x25,
-	0x16, 0xb0, 0x47, 0x30, 0xba, 0xe5, 0xb5, 0x4e, 0x97, 0x5c, 0x2f, 0xa3, 0x1e, 0xdd, 0x18, 0x1a,
-	0xe2, 0x8a, 0xeb, 0x25, 0x3b, 0x86, 0x60, 0x5e, 0xd4, 0xb8, 0x4c, 0xab, 0x92, 0x67, 0x22, 0xf2,
-	0x49, 0x06, 0xa2, 0xae, 0x0d, 0x13, 0xbf, 0x84, 0xde, 0x8c, 0x23, 0x67, 0x0c, 0x7a, 0xb8, 0xa9,
-	0x04, 0x25, 0x33, 0x4a, 0xe8, 0x6c, 0x32, 0xa9, 0xf8, 0xa6, 0x54, 0x3c, 0x6f, 0x32, 0x71, 0x30,
-	0xfe, 0xd1, 0x81, 0xe0, 0x43, 0xcd, 0xa5, 0xe6, 0x19, 0x16, 0x4a, 0x9a, 0xdb, 0xf4, 0x79, 0x5b,
-	0x0a, 0x9d, 0x0d, 0xf7, 0xb9, 0x56, 0x2b, 0x77, 0x95, 0xce, 0xec, 0x00, 0x3a, 0xa8, 0x28, 0xfd,
-	0x30, 0xe9, 0xa0, 0x32, 0x15, 0xdd, 0xf2, 0xf2, 0x46, 0xb8, 0xbc, 0x2d, 0xd8, 0xd6, 0xe9, 0xef,
-	0xd6, 0xf9, 0x18, 0x46, 0x58, 0xac, 0x84, 0x46, 0xbe, 0xaa, 0xa2, 0xfe, 0xc4, 0x9b, 0x76, 0x93,
-	0x2d, 0xc1, 0x26, 0xd0, 0xcb, 0x39, 0xf2, 0x68, 0x30, 0xf1, 0xa6, 0xc1, 0x79, 0x78, 0x66, 0x5b,
-	0x7e, 0x66, 0x6a, 0x4b, 0x48, 0x61, 0x47, 0x30, 0xcc, 0x96, 0xbc, 0x90, 0x69, 0x91, 0x47, 0xc3,
-	0x89, 0x37, 0x1d, 0x27, 0x03, 0xc2, 0x6f, 0x73, 0xd3, 0xc2, 0x05, 0xd7, 0x69, 0x55, 0x17, 0x99,
-	0x88, 0x46, 0xb6, 0x85, 0x0b, 0xae, 0xaf, 0x0d, 0x6e, 0xc4, 0xb2, 0x58, 0x15, 0x18, 0x41, 0x2b,
-	0xbe, 0x33, 0x98, 0xfd, 0x0b, 0x5d, 0x5e, 0x2e, 0xa2, 0x80, 0xde, 0x33, 0x47, 0x53, 0xb6, 0x2e,
-	0x16, 0x32, 0x0a, 0x6d, 0xd9, 0xe6, 0x1c, 0xff, 0xf2, 0x20, 0x98, 0x55, 0x4a, 0x5f, 0x2a, 0x89,
-	0x62, 0x8d, 0xec, 0x29, 0x84, 0xf9, 0x46, 0x72, 0x8d, 0x9b, 0xb4, 0x56, 0x0a, 0x5d, 0xdb, 0x02,
-	0xc7, 0x25, 0x4a, 0x21, 0x7b, 0x06, 0xff, 0x49, 0xb1, 0xc6, 0x74, 0x2f, 0xce, 0xb6, 0xf2, 0x1f,
-	0x23, 0xcc, 0x76, 0x62, 0x4f, 0x60, 0x9c, 0x8b, 0x52, 0x2c, 0x38, 0x0a, 0x1b, 0x67, 0x1b, 0x1c,
-	0x36, 0x24, 0x05, 0x9d, 0xc2, 0x41, 0xc6, 0x65, 0x5e, 0xe4, 0x6d, 0x94, 0xed, 0xf9, 0xb8, 0x65,
-	0x29, 0xcc, 0xb8, 0x49, 0x35, 0x11, 0xbe, 0x73, 0x93, 0x72, 0x62, 0x0c, 0xe3, 0x55, 0x21, 0x31,
-	0xcd, 0x24, 0xda, 0x80, 0xbe, 0x4d, 0xdc, 0x90, 0x97, 0x12, 0x4d, 0x4c, 0xfc, 0xb3, 0x03, 0xc1,
-	0x1b, 0x63, 0xfe, 0x2b, 0xc1, 0x73, 0x51, 0xdf, 0x6b, 0x8d, 0x63, 0x08, 0x2a, 0x5e, 0x0b, 0x89,
-	0xd6, 0xb4, 0xb6, 0x2c, 0xb0, 0x14, 0xd9, 0xf6, 0x7e, 0xa7, 0x3f, 0x84, 0x61, 0xa6, 0x0a, 0x39,
-	0xe7, 0xba, 0x31, 0x4c, 0x8b, 0xf7, 0xdd, 0xe1, 0xff, 0xe9, 0x8e, 0xdd, 0xd9, 0xf7, 0xf7, 0x67,
-	0xef, 0x26, 0x38, 0xb8, 0x3b, 0xc1, 0xe1, 0x76, 0x82, 0xec, 0x09, 0x80, 0xc6, 0xb6, 0x73, 0xd6,
-	0x22, 0x23, 0x62, 0xa8, 0x31, 0x47, 0x30, 0xc4, 0xb5, 0xb6, 0xa2, 0xb5, 0xc8, 0x00, 0xd7, 0x9a,
-	0xa4, 0x63, 0x08, 0xc4, 0xad, 0x90, 0xe8, 0xd4, 0xc0, 0xd6, 0x6a, 0x29, 0x0a, 0x78, 0x05, 0x61,
-	0x5e, 0x29, 0x9d, 0x66, 0xd6, 0x1c, 0x64, 0x9c, 0xe0, 0xfc, 0xff, 0xd6, 0xc1, 0x5b, 0xdf, 0x24,
-	0x41, 0xbe, 0x05, 0xf1, 0x77, 0x0f, 0x7c, 0x6a, 0x34, 0x7b, 0x0e, 0xfd, 0x25, 0x35, 0x9b, 0x9a,
-	0xbc, 0x73, 0x77, 0x67, 0x0e, 0x89, 0x0b, 0x61, 0x17, 0x10, 0xe2, 0xf6, 0xcf, 0xd5, 0x51, 0x67,
-	0xd2, 0xdd, 0xbd, 0xb2, 0xf3, 0x57, 0x27, 0x7b, 0x81, 0xec, 0x81, 0xf9, 0x4a, 0xb1, 0x58, 0xa2,
-	0x1b, 0x8a, 0x43, 0x66, 0x56, 0xab, 0x42, 0x8a, 0xba, 0xf9, 0x87, 0x09, 0xc4, 0x9f, 0x60, 0xf4,
-	0x5e, 0x20, 0x25, 0xa0, 0xdb, 0x55, 0xe0, 0x96, 0x0b, 0xad, 0x82, 0x43, 0xf0, 0xe7, 0x1c, 0x33,
-	0x3b, 0xfd, 0x5e, 0x62, 0x01, 0x3b, 0x85, 0x3e, 0x6d, 0x4e, 0x1d, 0x75, 0x29, 0xaf, 0xf1, 0x5e,
-	0x29, 0x89, 0x13, 0xe3, 0x8f, 0x30, 0x6c, 0x5e, 0xff, 0x8b, 0xc7, 0x4f, 0xc0, 0xa7, 0xfb, 0x54,
-	0xc0, 0x9d, 0xb7, 0xad, 0x16, 0x5f, 0xc0, 0x78, 0xa6, 0xbe, 0x4a, 0xb3, 0xe6, 0xda, 0xf7, 0xef,
-	0xdb, 0x6d, 0x64, 0x91, 0xce, 0xd6, 0x22, 0xf3, 0x3e, 0x2d, 0xfb, 0x17, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x21, 0x22, 0xf3, 0xfb, 0xfb, 0x05, 0x00, 0x00,
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x8e, 0xe4, 0x34,
+	0x10, 0x56, 0xff, 0x77, 0x2a, 0xdd, 0xb3, 0x33, 0x19, 0x7e, 0xb2, 0x0b, 0xcb, 0x34, 0x59, 0xad,
+	0x34, 0xb0, 0x68, 0x24, 0x06, 0xc4, 0x9e, 0x77, 0x67, 0x10, 0x83, 0x84, 0xd0, 0xc8, 0xda, 0x3d,
+	0x20, 0x21, 0x45, 0xee, 0xc4, 0x74, 0x5b, 0x9b, 0xd8, 0x51, 0xec, 0x6e, 0x7a, 0xae, 0x9c, 0x38,
+	0x21, 0x71, 0xe6, 0xc4, 0xc3, 0xf0, 0x38, 0xbc, 0x03, 0xaa, 0xb2, 0x93, 0x4e, 0xb3, 0x23, 0x24,
+	0x6e, 0xae, 0xfa, 0xca, 0x71, 0x7d, 0xae, 0xfa, 0xca, 0x81, 0x70, 0x59, 0xe8, 0xec, 0xcd, 0x45,
+	0x55, 0x6b, 0xab, 0xa3, 0x71, 0xa6, 0x6b, 0x51, 0x2d, 0x93, 0xdf, 0x7b, 0x30, 0x79, 0x91, 0x65,
+	0x7a, 0xa3, 0x6c, 0x14, 0xc3, 0x84, 0xe7, 0x79, 0x2d, 0x8c, 0x89, 0x7b, 0x8b, 0xde, 0xf9, 0x8c,
+	0x35, 0x26, 0x22, 0x4b, 0x5e, 0x70, 0x95, 0x89, 0xb8, 0xef, 0x10, 0x6f, 0x46, 0xef, 0xc0, 0x48,
+	0x69, 0xf4, 0x0f, 0x16, 0xbd, 0xf3, 0x21, 0x73, 0x46, 0xf4, 0x01, 0x04, 0x5b, 0x5e, 0x9b, 0x74,
+	0xcd, 0xcd, 0x3a, 0x1e, 0xd2, 0x8e, 0x29, 0x3a, 0x6e, 0xb8, 0x59, 0x47, 0x67, 0x10, 0x2e, 0x65,
+	0x6d, 0xd7, 0x69, 0x55, 0xf0, 0x4c, 0xc4, 0x23, 0x82, 0x81, 0x5c, 0xb7, 0xe8, 0x49, 0xbe, 0x84,
+	0xe1, 0x35, 0xb7, 0x3c, 0x8a, 0x60, 0x68, 0xef, 0x2a, 0x41, 0xc9, 0x04, 0x8c, 0xd6, 0x98, 0x49,
+	0xc5, 0xef, 0x0a, 0xcd, 0xf3, 0x26, 0x13, 0x6f, 0x26, 0xbf, 0x0d, 0x20, 0x7c, 0x55, 0x73, 0x65,
+	0x78, 0x66, 0xa5, 0x56, 0xb8, 0x9b, 0x8e, 0x77, 0x54, 0x68, 0x8d, 0xbe, 0x9f, 0x6a, 0x5d, 0xfa,
+	0xad, 0xb4, 0x8e, 0x8e, 0xa0, 0x6f, 0x35, 0xa5, 0x3f, 0x63, 0x7d, 0xab, 0x91, 0xd1, 0x96, 0x17,
+	0x1b, 0xe1, 0xf3, 0x76, 0xc6, 0x9e, 0xe7, 0xa8, 0xcb, 0xf3, 0x43, 0x08, 0xac, 0x2c, 0x85, 0xb1,
+	0xbc, 0xac, 0xe2, 0xf1, 0xa2, 0x77, 0x3e, 0x60, 0x7b, 0x47, 0xb4, 0x80, 0x61, 0xce, 0x2d, 0x8f,
+	0x27, 0x8b, 0xde, 0x79, 0x78, 0x39, 0xbb, 0x70, 0x57, 0x7e, 0x81, 0xdc, 0x18, 0x21, 0xd1, 0x43,
+	0x98, 0x66, 0x6b, 0x2e, 0x55, 0x2a, 0xf3, 0x78, 0xba, 0xe8, 0x9d, 0xcf, 0xd9, 0x84, 0xec, 0x6f,
+	0x73, 0xbc, 0xc2, 0x15, 0x37, 0x69, 0x55, 0xcb, 0x4c, 0xc4, 0x81, 0xbb, 0xc2, 0x15, 0x37, 0xb7,
+	0x68, 0x37, 0x60, 0x21, 0x4b, 0x69, 0x63, 0x68, 0xc1, 0xef, 0xd0, 0x8e, 0x8e, 0x61, 0xc0, 0x8b,
+	0x55, 0x1c, 0xd2, 0xf7, 0x70, 0x89, 0xb4, 0x8d, 0x5c, 0xa9, 0x78, 0xe6, 0x68, 0xe3, 0x3a, 0xfa,
+	0x0c, 0xa2, 0x2d, 0x2f, 0x64, 0x9e, 0x6e, 0x94, 0x95, 0x45, 0xba, 0x16, 0x72, 0xb5, 0xb6, 0xf1,
+	0x9c, 0xd8, 0x1d, 0x13, 0xf2, 0x1a, 0x81, 0x1b, 0xf2, 0x47, 0x97, 0xf0, 0x6e, 0x37, 0x7a, 0x4f,
+	0xfa, 0x88, 0x48, 0x9f, 0xee, 0x37, 0xbc, 0x6a, 0xa0, 0xe4, 0xd7, 0x3e, 0x84, 0xd7, 0x95, 0x36,
+	0x57, 0x5a, 0x59, 0xb1, 0xb3, 0xd1, 0xc7, 0x30, 0xcb, 0xef, 0x14, 0x37, 0xf6, 0x2e, 0xad, 0xb5,
+	0xb6, 0xbe, 0x30, 0xa1, 0xf7, 0x31, 0xad, 0x6d, 0xf4, 0x29, 0x9c, 0x28, 0xb1, 0xb3, 0xe9, 0x41,
+	0x9c, 0x2b, 0xd6, 0x03, 0x04, 0xae, 0x3b, 0xb1, 0x4f, 0x60, 0x9e, 0x8b, 0x42, 0xac, 0xb8, 0x15,
+	0x2e, 0xce, 0x95, 0x70, 0xd6, 0x38, 0x29, 0xe8, 0x29, 0x1c, 0x65, 0x5c, 0xe5, 0x32, 0x6f, 0xa3,
+	0x5c, 0x55, 0xe7, 0xad, 0x97, 0xc2, 0xb0, 0x5f, 0x75, 0x13, 0x31, 0xf2, 0xfd, 0xaa, 0x3d, 0x98,
+	0xc0, 0xbc, 0x94, 0xca, 0xa6, 0x99, 0xb2, 0x2e, 0x60, 0xec, 0x12, 0x47, 0xe7, 0x95, 0xb2, 0x14,
+	0xf3, 0x18, 0xc0, 0x58, 0xfe, 0xc6, 0x7f, 0x61, 0x42, 0x01, 0x01, 0x79, 0x10, 0x4e, 0xfe, 0xec,
+	0x41, 0x70, 0xd5, 0x9c, 0xf8, 0x1f, 0x3a, 0x8b, 0x60, 0xa8, 0x78, 0xe9, 0x44, 0x16, 0x30, 0x5a,
+	0x63, 0x39, 0x37, 0x75, 0x41, 0xec, 0x02, 0x86, 0xcb, 0xe8, 0x11, 0x4c, 0x85, 0xca, 0x2b, 0x2d,
+	0x95, 0xa3, 0x13, 0xb0, 0xd6, 0x8e, 0x3e, 0x02, 0xc8, 0x74, 0x59, 0x4a, 0x63, 0xa4, 0x56, 0x44,
+	0x65, 0xce, 0x3a, 0x1e, 0x3c, 0x3b, 0x17, 0x95, 0x36, 0xb2, 0xa1, 0xd1, 0x98, 0xc9, 0x2f, 0x7d,
+	0x98, 0xde, 0xd6, 0xba, 0xd2, 0x86, 0x17, 0x28, 0x0a, 0x99, 0xfb, 0xec, 0xfa, 0x32, 0xc7, 0x23,
+	0x2b, 0xc2, 0x44, 0xed, 0xeb, 0xd1, 0xda, 0x28, 0x8d, 0x8a, 0xd7, 0xbc, 0xf4, 0x29, 0x3a, 0xe3,
+	0x50, 0x46, 0x41, 0x23, 0xa3, 0xc7, 0x00, 0x42, 0xe5, 0x4d, 0xb7, 0x39, 0x2d, 0x05, 0x42, 0xe5,
+	0xbe, 0xcd, 0x9e, 0xc1, 0x09, 0xaa, 0x77, 0xcb, 0x51, 0xc1, 0x4d, 0xd4, 0xd8, 0xf5, 0xe4, 0x1e,
+	0xf0, 0xc1, 0xef, 0xc1, 0xd8, 0x58, 0x6e, 0x37, 0x86, 0xee, 0x7b, 0xce, 0xbc, 0x85, 0x17, 0x76,
+	0x27, 0x0c, 0xe9, 0x69, 0xc6, 0x70, 0x89, 0x6c, 0x94, 0xf6, 0x22, 0xea, 0x2b, 0xdd, 0xbd, 0x04,
+	0x38, 0xbc, 0x84, 0xbf, 0x06, 0x10, 0xbe, 0xc4, 0x31, 0x79, 0x23, 0x78, 0x2e, 0xea, 0x7b, 0x87,
+	0xc8, 0x19, 0x84, 0x15, 0xaf, 0x85, 0xb2, 0x6e, 0xbc, 0xb9, 0xeb, 0x00, 0xe7, 0xa2, 0x01, 0x77,
+	0xff, 0x4c, 0x7c, 0x04, 0xd3, 0x4c, 0x4b, 0xb5, 0xe4, 0xa6, 0x19, 0x2d, 0xad, 0x7d, 0x38, 0x47,
+	0x46, 0xff, 0x9e, 0x23, 0xdd, 0x29, 0x31, 0x3e, 0x9c, 0x12, 0x5e, 0xeb, 0x93, 0xb7, 0xb5, 0x3e,
+	0xed, 0x68, 0xdd, 0x75, 0x67, 0xd3, 0xdf, 0x41, 0xdb, 0x9d, 0xbe, 0xc1, 0x1f, 0xc2, 0xd4, 0xee,
+	0x8c, 0x03, 0xfd, 0x7d, 0xd8, 0x9d, 0x21, 0xe8, 0x0c, 0x42, 0xb1, 0x15, 0xca, 0x7a, 0x34, 0x74,
+	0x5c, 0x9d, 0x8b, 0x02, 0xbe, 0x82, 0x59, 0x5e, 0x69, 0x93, 0x66, 0x4e, 0xe4, 0x34, 0x62, 0xc2,
+	0xcb, 0xd3, 0x76, 0xd6, 0xed, 0xf5, 0xcf, 0xc2, 0x7c, 0x6f, 0xe0, 0x99, 0x38, 0xc1, 0x36, 0x46,
+	0xe4, 0x34, 0x74, 0x66, 0x6c, 0xb2, 0xe2, 0xe6, 0xb5, 0x11, 0x39, 0x0a, 0xbb, 0x16, 0x99, 0x90,
+	0x55, 0x73, 0xea, 0x91, 0x13, 0x76, 0xe3, 0x6c, 0x04, 0x57, 0xe8, 0x95, 0x49, 0x97, 0x85, 0xd6,
+	0x65, 0xfc, 0xc0, 0x51, 0x42, 0xcf, 0x4b, 0x74, 0x24, 0x7f, 0xf4, 0x60, 0x44, 0x75, 0x8c, 0x9e,
+	0xc1, 0x78, 0x4d, 0xb5, 0x8c, 0x7b, 0x87, 0xa9, 0x75, 0xca, 0xcc, 0x7c, 0x48, 0xf4, 0x1c, 0x66,
+	0x76, 0xff, 0x84, 0x98, 0xb8, 0xbf, 0x18, 0x74, 0xb7, 0x74, 0x9e, 0x17, 0x76, 0x10, 0x88, 0xbd,
+	0xe8, 0xbb, 0xd5, 0xd5, 0xdc, 0x5b, 0xd8, 0x0a, 0xa5, 0x54, 0xa2, 0x6e, 0x1e, 0x13, 0x32, 0x92,
+	0x1f, 0x21, 0xf8, 0x5e, 0x58, 0x4a, 0xc0, 0xb4, 0x6f, 0x92, 0x7f, 0xe5, 0x70, 0x8d, 0xdb, 0x96,
+	0xdc, 0x66, 0xae, 0xb9, 0x86, 0xcc, 0x19, 0xd1, 0x53, 0x18, 0xd3, 0x13, 0x6e, 0xe2, 0x01, 0xe5,
+	0x35, 0x3f, 0xa0, 0xc2, 0x3c, 0x98, 0xfc, 0x00, 0xd3, 0xe6, 0xeb, 0xff, 0xe3, 0xe3, 0x4f, 0x60,
+	0x44, 0xfb, 0x89, 0xc0, 0x5b, 0xdf, 0x76, 0x58, 0xf2, 0x1c, 0xe6, 0xd7, 0xfa, 0x67, 0x85, 0xef,
+	0x6d, 0xfb, 0xfd, 0xfb, 0x1e, 0x59, 0xea, 0xc0, 0xfe, 0xbe, 0x03, 0x93, 0xcf, 0x61, 0xf4, 0x35,
+	0x36, 0x0d, 0x1e, 0x6e, 0x75, 0x25, 0x33, 0x9f, 0x91, 0x33, 0x70, 0x0b, 0xbd, 0x94, 0x7e, 0xee,
+	0xe1, 0x3a, 0xf9, 0xbb, 0x07, 0x13, 0xe6, 0x4a, 0x1e, 0xbd, 0x0f, 0x13, 0xbb, 0x4b, 0x3b, 0x27,
+	0x8d, 0xed, 0x8e, 0xa4, 0xb6, 0x9f, 0x01, 0xb8, 0x75, 0xd4, 0xce, 0x80, 0x6e, 0x7b, 0x0d, 0x0e,
+	0xdb, 0xeb, 0x02, 0x4e, 0xb3, 0x4d, 0xb9, 0x29, 0xb8, 0x95, 0x5b, 0x91, 0xb6, 0x51, 0xae, 0x40,
+	0x27, 0x7b, 0xe8, 0x1b, 0x1f, 0xff, 0x09, 0x1c, 0x63, 0x73, 0xd7, 0x3c, 0xb3, 0x69, 0x33, 0xb6,
+	0xdd, 0x13, 0xf1, 0xa0, 0xf1, 0xbf, 0x70, 0x6e, 0x2c, 0x90, 0x93, 0x46, 0x3c, 0x3e, 0x2c, 0x10,
+	0x71, 0x67, 0x1e, 0xc4, 0x3b, 0x10, 0x75, 0xad, 0x6b, 0x92, 0x6d, 0xc0, 0x9c, 0xb1, 0x1c, 0xd3,
+	0x8f, 0xd9, 0x17, 0xff, 0x0c, 0x00, 0xd6, 0x0d, 0x8e, 0x8c, 0xa7, 0x09, 0x00, 0x00,
 }
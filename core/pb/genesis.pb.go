@@ -12,7 +12,11 @@ It has these top-level messages:
 	GenesisMeta
 	GenesisConsensus
 	GenesisConsensusDpos
+	GenesisConsensusPod
+	GenesisConsensusDip
 	GenesisTokenDistribution
+	GenesisFork
+	GenesisForkSchedule
 */
 package corepb
 
@@ -39,6 +43,8 @@ type Genesis struct {
 	// genesis token distribution address
 	// map<string, string> token_distribution = 3;
 	TokenDistribution []*GenesisTokenDistribution `protobuf:"bytes,3,rep,name=token_distribution,json=tokenDistribution" json:"token_distribution,omitempty"`
+	// genesis fork schedule, forks not listed activate at their default height
+	Forks []*GenesisFork `protobuf:"bytes,4,rep,name=forks" json:"forks,omitempty"`
 }
 
 func (m *Genesis) Reset()                    { *m = Genesis{} }
@@ -67,6 +73,13 @@ func (m *Genesis) GetTokenDistribution() []*GenesisTokenDistribution {
 	return nil
 }
 
+func (m *Genesis) GetForks() []*GenesisFork {
+	if m != nil {
+		return m.Forks
+	}
+	return nil
+}
+
 type GenesisMeta struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -87,6 +100,10 @@ func (m *GenesisMeta) GetChainId() uint32 {
 type GenesisConsensus struct {
 	// ChainID.
 	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos" json:"dpos,omitempty"`
+	// PoD is used if set, validators are elected by nebulas rank instead of votes.
+	Pod *GenesisConsensusPod `protobuf:"bytes,2,opt,name=pod" json:"pod,omitempty"`
+	// DIP is enabled if set, developers are rewarded for the usage of their contracts.
+	Dip *GenesisConsensusDip `protobuf:"bytes,3,opt,name=dip" json:"dip,omitempty"`
 }
 
 func (m *GenesisConsensus) Reset()                    { *m = GenesisConsensus{} }
@@ -101,9 +118,47 @@ func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 	return nil
 }
 
+func (m *GenesisConsensus) GetPod() *GenesisConsensusPod {
+	if m != nil {
+		return m.Pod
+	}
+	return nil
+}
+
+func (m *GenesisConsensus) GetDip() *GenesisConsensusDip {
+	if m != nil {
+		return m.Dip
+	}
+	return nil
+}
+
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
+	// seconds between two blocks, default 5.
+	BlockInterval int64 `protobuf:"varint,2,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// seconds of a dynasty, default 60.
+	DynastyInterval int64 `protobuf:"varint,3,opt,name=dynasty_interval,json=dynastyInterval,proto3" json:"dynasty_interval,omitempty"`
+	// validators in a dynasty, default 6.
+	DynastySize uint32 `protobuf:"varint,4,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	// min candidates to elect a dynasty, default dynasty_size/3+1.
+	SafeSize uint32 `protobuf:"varint,5,opt,name=safe_size,json=safeSize,proto3" json:"safe_size,omitempty"`
+	// min miners to make a block irreversible, default dynasty_size*2/3+1.
+	ConsensusSize uint32 `protobuf:"varint,6,opt,name=consensus_size,json=consensusSize,proto3" json:"consensus_size,omitempty"`
+	// percent of the candidate deposit and bonded stake burned when a miner is proved double signing, default 0.
+	DoubleSignBurnPercent uint32 `protobuf:"varint,7,opt,name=double_sign_burn_percent,json=doubleSignBurnPercent,proto3" json:"double_sign_burn_percent,omitempty"`
+	// blocks before undelegated stake becomes spendable, default 2160.
+	UnbondingPeriod uint64 `protobuf:"varint,8,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// deposit locked by a candidate registration, returned after the unbonding period on logout, default 0.
+	CandidateDeposit string `protobuf:"bytes,9,opt,name=candidate_deposit,json=candidateDeposit,proto3" json:"candidate_deposit,omitempty"`
+	// dynasties an offline validator is excluded from elections before it can unjail, default 6.
+	JailDynasties uint32 `protobuf:"varint,10,opt,name=jail_dynasties,json=jailDynasties,proto3" json:"jail_dynasties,omitempty"`
+	// blocks a governance proposal is open for voting, default 2160.
+	VotingPeriod uint64 `protobuf:"varint,11,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
+	// max gas used by the txs in a block, default 500 * 10^9.
+	BlockGasLimit string `protobuf:"bytes,12,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
+	// max bytes of the txs in a block, default 4MB.
+	BlockSizeLimit uint64 `protobuf:"varint,13,opt,name=block_size_limit,json=blockSizeLimit,proto3" json:"block_size_limit,omitempty"`
 }
 
 func (m *GenesisConsensusDpos) Reset()                    { *m = GenesisConsensusDpos{} }
@@ -118,6 +173,133 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+func (m *GenesisConsensusDpos) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDynastyInterval() int64 {
+	if m != nil {
+		return m.DynastyInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDynastySize() uint32 {
+	if m != nil {
+		return m.DynastySize
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetSafeSize() uint32 {
+	if m != nil {
+		return m.SafeSize
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetConsensusSize() uint32 {
+	if m != nil {
+		return m.ConsensusSize
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDoubleSignBurnPercent() uint32 {
+	if m != nil {
+		return m.DoubleSignBurnPercent
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetCandidateDeposit() string {
+	if m != nil {
+		return m.CandidateDeposit
+	}
+	return ""
+}
+
+func (m *GenesisConsensusDpos) GetJailDynasties() uint32 {
+	if m != nil {
+		return m.JailDynasties
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetVotingPeriod() uint64 {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetBlockGasLimit() string {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return ""
+}
+
+func (m *GenesisConsensusDpos) GetBlockSizeLimit() uint64 {
+	if m != nil {
+		return m.BlockSizeLimit
+	}
+	return 0
+}
+
+type GenesisConsensusPod struct {
+	// blocks before the dynasty election to compute nebulas rank, default 1000.
+	NrWindow uint64 `protobuf:"varint,1,opt,name=nr_window,json=nrWindow,proto3" json:"nr_window,omitempty"`
+}
+
+func (m *GenesisConsensusPod) Reset()                    { *m = GenesisConsensusPod{} }
+func (m *GenesisConsensusPod) String() string            { return proto.CompactTextString(m) }
+func (*GenesisConsensusPod) ProtoMessage()               {}
+func (*GenesisConsensusPod) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+
+func (m *GenesisConsensusPod) GetNrWindow() uint64 {
+	if m != nil {
+		return m.NrWindow
+	}
+	return 0
+}
+
+type GenesisConsensusDip struct {
+	// blocks in a reward period, default 1000.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// total rewards issued to developers each period.
+	Reward string `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (m *GenesisConsensusDip) Reset()                    { *m = GenesisConsensusDip{} }
+func (m *GenesisConsensusDip) String() string            { return proto.CompactTextString(m) }
+func (*GenesisConsensusDip) ProtoMessage()               {}
+func (*GenesisConsensusDip) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{5} }
+
+func (m *GenesisConsensusDip) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDip) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -126,7 +308,7 @@ type GenesisTokenDistribution struct {
 func (m *GenesisTokenDistribution) Reset()                    { *m = GenesisTokenDistribution{} }
 func (m *GenesisTokenDistribution) String() string            { return proto.CompactTextString(m) }
 func (*GenesisTokenDistribution) ProtoMessage()               {}
-func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{6} }
 
 func (m *GenesisTokenDistribution) GetAddress() string {
 	if m != nil {
@@ -142,33 +324,104 @@ func (m *GenesisTokenDistribution) GetValue() string {
 	return ""
 }
 
+type GenesisFork struct {
+	// fork feature name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the fork is activated after this height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GenesisFork) Reset()                    { *m = GenesisFork{} }
+func (m *GenesisFork) String() string            { return proto.CompactTextString(m) }
+func (*GenesisFork) ProtoMessage()               {}
+func (*GenesisFork) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{7} }
+
+func (m *GenesisFork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GenesisFork) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GenesisForkSchedule is the activation heights of all forks of a chain, stored with genesis.
+type GenesisForkSchedule struct {
+	Forks []*GenesisFork `protobuf:"bytes,1,rep,name=forks" json:"forks,omitempty"`
+}
+
+func (m *GenesisForkSchedule) Reset()                    { *m = GenesisForkSchedule{} }
+func (m *GenesisForkSchedule) String() string            { return proto.CompactTextString(m) }
+func (*GenesisForkSchedule) ProtoMessage()               {}
+func (*GenesisForkSchedule) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{8} }
+
+func (m *GenesisForkSchedule) GetForks() []*GenesisFork {
+	if m != nil {
+		return m.Forks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "corepb.Genesis")
 	proto.RegisterType((*GenesisMeta)(nil), "corepb.GenesisMeta")
 	proto.RegisterType((*GenesisConsensus)(nil), "corepb.GenesisConsensus")
 	proto.RegisterType((*GenesisConsensusDpos)(nil), "corepb.GenesisConsensusDpos")
+	proto.RegisterType((*GenesisConsensusPod)(nil), "corepb.GenesisConsensusPod")
+	proto.RegisterType((*GenesisConsensusDip)(nil), "corepb.GenesisConsensusDip")
 	proto.RegisterType((*GenesisTokenDistribution)(nil), "corepb.GenesisTokenDistribution")
+	proto.RegisterType((*GenesisFork)(nil), "corepb.GenesisFork")
+	proto.RegisterType((*GenesisForkSchedule)(nil), "corepb.GenesisForkSchedule")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0x65, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0x28, 0x79, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x05, 0x11, 0x82, 0xef, 0x23, 0x6d, 0x2e, 0x1a, 0xd4, 0xa4,
-	0xf4, 0xa6, 0xc2, 0x7e, 0x9b, 0x7f, 0x4e, 0x96, 0xae, 0x38, 0x8a, 0x7b, 0x3c, 0x39, 0x5f, 0x2e,
-	0xe7, 0xdc, 0x0b, 0x8b, 0x37, 0xb4, 0x48, 0x86, 0xca, 0xb6, 0x73, 0xde, 0xb1, 0x79, 0xe3, 0x3a,
-	0x6c, 0x6b, 0xf1, 0x13, 0x41, 0xf2, 0x30, 0x38, 0xec, 0x06, 0x66, 0x5f, 0xe8, 0x15, 0x8f, 0xf2,
-	0xa8, 0xc8, 0xd6, 0x97, 0xe5, 0x80, 0x94, 0x7b, 0xfb, 0x19, 0xbd, 0x92, 0x01, 0x60, 0x77, 0x90,
-	0x36, 0xce, 0x12, 0x5a, 0xea, 0x89, 0x9f, 0x04, 0x9a, 0x4f, 0xe8, 0xfb, 0xd1, 0x97, 0x7f, 0x28,
-	0x7b, 0x01, 0xe6, 0xdd, 0x07, 0xda, 0x8d, 0x36, 0xe4, 0x3b, 0x53, 0xf7, 0xde, 0x38, 0xcb, 0xe3,
-	0x3c, 0x2e, 0xb2, 0x75, 0x3e, 0x19, 0xf0, 0xba, 0x03, 0xab, 0x03, 0x4e, 0x5e, 0xf8, 0xe9, 0x93,
-	0x28, 0x20, 0x3b, 0x48, 0xc7, 0xae, 0xe0, 0xac, 0x79, 0x57, 0xc6, 0x6e, 0x8c, 0x0e, 0x25, 0x16,
-	0x32, 0x09, 0xfa, 0x51, 0x8b, 0x0a, 0xce, 0xa7, 0xc9, 0xd8, 0x0a, 0x66, 0xba, 0x75, 0xb4, 0xef,
-	0x7b, 0x7d, 0xac, 0x41, 0xd5, 0x3a, 0x92, 0x81, 0x14, 0x2b, 0x58, 0xfe, 0xe7, 0x32, 0x0e, 0x89,
-	0xde, 0x5a, 0x45, 0x7e, 0xcb, 0xa3, 0x3c, 0x2e, 0x52, 0x39, 0x4a, 0xf1, 0x04, 0xfc, 0x58, 0xa1,
-	0xdd, 0x2f, 0xa5, 0x75, 0x87, 0x34, 0x44, 0x48, 0xe5, 0x28, 0xd9, 0x12, 0x4e, 0xbf, 0xd5, 0x67,
-	0x8f, 0x61, 0xb9, 0xa9, 0x1c, 0x44, 0x3d, 0x0f, 0xa7, 0xbb, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0xf4, 0x0e, 0x3f, 0xcb, 0x01, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdd, 0x6e, 0x13, 0x3b,
+	0x10, 0xc7, 0xb5, 0x67, 0xb7, 0xf9, 0x98, 0x34, 0x6d, 0xea, 0xf6, 0x1c, 0xf9, 0xa8, 0x5c, 0x84,
+	0x45, 0x85, 0x54, 0x88, 0x0a, 0x15, 0x09, 0xc4, 0x1d, 0x82, 0x40, 0x55, 0x04, 0xa2, 0x72, 0x91,
+	0xb8, 0x5c, 0x39, 0xb1, 0x9b, 0x98, 0x6c, 0xed, 0x95, 0xed, 0x6d, 0xd5, 0x3e, 0x09, 0xd7, 0x3c,
+	0x1c, 0xcf, 0x81, 0xfc, 0x91, 0x34, 0x84, 0x16, 0xee, 0x76, 0xfe, 0xf3, 0x1b, 0xcf, 0x87, 0x67,
+	0x0d, 0xdd, 0x09, 0x97, 0xdc, 0x08, 0x73, 0x50, 0x69, 0x65, 0x15, 0x6a, 0x8c, 0x95, 0xe6, 0xd5,
+	0x28, 0xff, 0x91, 0x40, 0xf3, 0x28, 0x78, 0xd0, 0x23, 0xc8, 0xce, 0xb9, 0xa5, 0x38, 0xe9, 0x27,
+	0x83, 0xce, 0xe1, 0xf6, 0x41, 0x40, 0x0e, 0xa2, 0xfb, 0x23, 0xb7, 0x94, 0x78, 0x00, 0x3d, 0x87,
+	0xf6, 0x58, 0x49, 0xc3, 0xa5, 0xa9, 0x0d, 0xfe, 0xc7, 0xd3, 0x78, 0x85, 0x7e, 0x33, 0xf7, 0x93,
+	0x1b, 0x14, 0x7d, 0x02, 0x64, 0xd5, 0x8c, 0xcb, 0x82, 0x09, 0x63, 0xb5, 0x18, 0xd5, 0x56, 0x28,
+	0x89, 0xd3, 0x7e, 0x3a, 0xe8, 0x1c, 0xf6, 0x57, 0x0e, 0xf8, 0xec, 0xc0, 0xe1, 0x12, 0x47, 0xb6,
+	0xec, 0xaa, 0x84, 0xf6, 0x61, 0xed, 0x4c, 0xe9, 0x99, 0xc1, 0x59, 0x3f, 0xbd, 0xa5, 0xe4, 0x77,
+	0x4a, 0xcf, 0x48, 0x20, 0xf2, 0x01, 0x74, 0x96, 0x1a, 0x41, 0xff, 0x43, 0x6b, 0x3c, 0xa5, 0x42,
+	0x16, 0x82, 0xf9, 0x7e, 0xbb, 0xa4, 0xe9, 0xed, 0x63, 0x96, 0x7f, 0x4f, 0xa0, 0xb7, 0xda, 0x05,
+	0x7a, 0x0a, 0x19, 0xab, 0x94, 0x89, 0xb3, 0xb9, 0x77, 0x57, 0xb7, 0xc3, 0x4a, 0x19, 0xe2, 0x49,
+	0xf4, 0x04, 0xd2, 0x4a, 0xb1, 0x38, 0x9e, 0xdd, 0xbb, 0x02, 0x4e, 0x14, 0x23, 0x8e, 0x73, 0x38,
+	0x13, 0x15, 0x4e, 0xff, 0x8c, 0x0f, 0x45, 0x45, 0x1c, 0x97, 0x7f, 0xcb, 0x60, 0xe7, 0xb6, 0xe4,
+	0x08, 0x43, 0x93, 0x5d, 0x49, 0x6a, 0xec, 0x15, 0x4e, 0xfa, 0xe9, 0xa0, 0x4d, 0xe6, 0x26, 0xda,
+	0x83, 0x8d, 0x51, 0xa9, 0xc6, 0xb3, 0x42, 0x48, 0xcb, 0xf5, 0x05, 0x2d, 0x7d, 0x6d, 0x29, 0xe9,
+	0x7a, 0xf5, 0x38, 0x8a, 0x68, 0x1f, 0x7a, 0x31, 0xe2, 0x06, 0x4c, 0x3d, 0xb8, 0x19, 0xf5, 0x05,
+	0x7a, 0x1f, 0xd6, 0xe7, 0xa8, 0x11, 0xd7, 0x1c, 0x67, 0x7e, 0x90, 0x9d, 0xa8, 0x9d, 0x8a, 0x6b,
+	0x8e, 0x76, 0xa1, 0x6d, 0xe8, 0x19, 0x0f, 0xfe, 0x35, 0xef, 0x6f, 0x39, 0xc1, 0x3b, 0xf7, 0x60,
+	0x63, 0xb1, 0x1c, 0x81, 0x68, 0x78, 0xa2, 0xbb, 0x50, 0x3d, 0xf6, 0x02, 0x30, 0x53, 0xf5, 0xa8,
+	0x74, 0xa7, 0x4c, 0x64, 0x31, 0xaa, 0xb5, 0x2c, 0x2a, 0xae, 0xc7, 0x5c, 0x5a, 0xdc, 0xf4, 0x01,
+	0xff, 0x06, 0xff, 0xa9, 0x98, 0xc8, 0xd7, 0xb5, 0x96, 0x27, 0xc1, 0xe9, 0x5a, 0xa9, 0xe5, 0x48,
+	0x49, 0x26, 0xe4, 0xc4, 0x45, 0x08, 0xc5, 0x70, 0xab, 0x9f, 0x0c, 0x32, 0xb2, 0xb9, 0xd0, 0x4f,
+	0xbc, 0x8c, 0x1e, 0xc3, 0xd6, 0x98, 0x4a, 0x26, 0x18, 0xb5, 0xbc, 0x60, 0xbc, 0x52, 0x46, 0x58,
+	0xdc, 0xee, 0x27, 0x83, 0x36, 0xe9, 0x2d, 0x1c, 0xc3, 0xa0, 0xbb, 0xba, 0xbf, 0x52, 0x51, 0x16,
+	0xa1, 0x51, 0xc1, 0x0d, 0x86, 0x50, 0xb7, 0x53, 0x87, 0x73, 0x11, 0x3d, 0x80, 0xee, 0x85, 0xb2,
+	0x4b, 0xb9, 0x3b, 0x3e, 0xf7, 0x7a, 0x10, 0x63, 0xe2, 0x87, 0xb0, 0x19, 0x6e, 0x65, 0x42, 0x4d,
+	0x51, 0x8a, 0x73, 0x61, 0xf1, 0xba, 0x4f, 0x1b, 0xae, 0xe5, 0x88, 0x9a, 0x0f, 0x4e, 0x44, 0x03,
+	0xe8, 0x05, 0xce, 0xcd, 0x29, 0x82, 0x5d, 0x7f, 0x5e, 0xb8, 0x55, 0x37, 0x29, 0x4f, 0xe6, 0x87,
+	0xb0, 0x7d, 0xcb, 0x96, 0xb9, 0x9b, 0x90, 0xba, 0xb8, 0x14, 0x92, 0xa9, 0x4b, 0xbf, 0xc6, 0x19,
+	0x69, 0x49, 0xfd, 0xc5, 0xdb, 0xf9, 0xdb, 0xdf, 0x63, 0x86, 0xa2, 0x42, 0xff, 0x41, 0xe3, 0x97,
+	0x80, 0x68, 0x39, 0x5d, 0xf3, 0x4b, 0xaa, 0xc3, 0x7a, 0xb7, 0x49, 0xb4, 0xf2, 0xf7, 0x80, 0xef,
+	0xfa, 0x7d, 0xdd, 0x62, 0x52, 0xc6, 0x34, 0x37, 0xe1, 0x27, 0x6a, 0x93, 0xb9, 0x89, 0x76, 0x60,
+	0xed, 0x82, 0x96, 0x35, 0x8f, 0x87, 0x05, 0x23, 0x7f, 0x09, 0x9d, 0xa5, 0xdf, 0x18, 0x21, 0xc8,
+	0x24, 0x3d, 0xe7, 0x31, 0xd6, 0x7f, 0xbb, 0x32, 0xa6, 0x5c, 0x4c, 0xa6, 0xd6, 0x47, 0x66, 0x24,
+	0x5a, 0xf9, 0x2b, 0xd8, 0x5e, 0x0a, 0x3d, 0x1d, 0x4f, 0x39, 0xab, 0x4b, 0x7e, 0xf3, 0x5a, 0x24,
+	0x7f, 0x7b, 0x2d, 0x46, 0x0d, 0xff, 0x4a, 0x3e, 0xfb, 0x39, 0x00, 0x27, 0xfb, 0x9c, 0x4a, 0x36,
+	0x05, 0x00, 0x00,
 }
@@ -0,0 +1,195 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Receipt is the result of a transaction executed in a block, recorded since ForkReceipts.
+type Receipt struct {
+	TxHash byteutils.Hash
+	Status int8
+
+	// gas used by the tx, and by the txs in the block up to the tx.
+	GasUsed           *util.Uint128
+	CumulativeGasUsed *util.Uint128
+
+	// contract deployed by the tx, nil if the tx does not deploy a contract.
+	ContractAddress *Address
+
+	Events []*Event
+	Error  string
+}
+
+// ToProto converts domain Receipt to proto Receipt
+func (r *Receipt) ToProto() (proto.Message, error) {
+	gasUsed, err := r.GasUsed.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	cumulativeGasUsed, err := r.CumulativeGasUsed.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	receipt := &corepb.Receipt{
+		TxHash:            r.TxHash,
+		Status:            int32(r.Status),
+		GasUsed:           gasUsed,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Error:             r.Error,
+	}
+	if r.ContractAddress != nil {
+		receipt.ContractAddress = r.ContractAddress.Bytes()
+	}
+	for _, event := range r.Events {
+		receipt.Events = append(receipt.Events, &corepb.Event{Topic: event.Topic, Data: event.Data})
+	}
+	return receipt, nil
+}
+
+// FromProto converts proto Receipt to domain Receipt
+func (r *Receipt) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.Receipt); ok {
+		gasUsed, err := util.NewUint128().FromFixedSizeByteSlice(msg.GasUsed)
+		if err != nil {
+			return err
+		}
+		cumulativeGasUsed, err := util.NewUint128().FromFixedSizeByteSlice(msg.CumulativeGasUsed)
+		if err != nil {
+			return err
+		}
+		r.TxHash = msg.TxHash
+		r.Status = int8(msg.Status)
+		r.GasUsed = gasUsed
+		r.CumulativeGasUsed = cumulativeGasUsed
+		r.ContractAddress = nil
+		if len(msg.ContractAddress) > 0 {
+			if r.ContractAddress, err = AddressParseFromBytes(msg.ContractAddress); err != nil {
+				return err
+			}
+		}
+		r.Events = nil
+		for _, event := range msg.Events {
+			r.Events = append(r.Events, &Event{Topic: event.Topic, Data: event.Data})
+		}
+		r.Error = msg.Error
+		return nil
+	}
+	return errors.New("Protobuf message cannot be converted into Receipt")
+}
+
+// recordReceipt records the receipt of the executed tx in the receipts trie.
+func (block *Block) recordReceipt(tx *Transaction, gasUsed, cumulativeGasUsed *util.Uint128) error {
+	events, err := block.FetchEvents(tx.hash)
+	if err != nil {
+		return err
+	}
+	receipt := &Receipt{
+		TxHash:            tx.hash,
+		Status:            TxExecutionFailed,
+		GasUsed:           gasUsed,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Events:            events,
+	}
+	for _, event := range events {
+		switch event.Topic {
+		case TopicTransactionExecutionResult:
+			txEvent := TransactionEvent{}
+			if err := json.Unmarshal([]byte(event.Data), &txEvent); err != nil {
+				return err
+			}
+			receipt.Status, receipt.Error = txEvent.Status, txEvent.Error
+		case TopicExecuteTxSuccess:
+			receipt.Status = TxExecutionSuccess
+		}
+	}
+	if receipt.Status == TxExecutionSuccess && tx.Type() == TxPayloadDeployType {
+		if receipt.ContractAddress, err = tx.GenerateContractAddress(); err != nil {
+			return err
+		}
+	}
+
+	pbReceipt, err := receipt.ToProto()
+	if err != nil {
+		return err
+	}
+	bytes, err := proto.Marshal(pbReceipt)
+	if err != nil {
+		return err
+	}
+	_, err = block.receiptsTrie.Put(tx.hash, bytes)
+	return err
+}
+
+// GetReceipt returns the receipt of the tx executed in the block or its ancestors.
+func (block *Block) GetReceipt(txHash byteutils.Hash) (*Receipt, error) {
+	bytes, err := block.receiptsTrie.Get(txHash)
+	if err == storage.ErrKeyNotFound {
+		return nil, ErrReceiptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return loadReceipt(bytes)
+}
+
+// ProveReceipt returns the receipt bytes and the merkle proof from the receipts root of the block.
+func (block *Block) ProveReceipt(txHash byteutils.Hash) ([]byte, trie.MerkleProof, error) {
+	bytes, err := block.receiptsTrie.Get(txHash)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil, ErrReceiptNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := block.receiptsTrie.Prove(txHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bytes, proof, nil
+}
+
+// VerifyReceiptProof checks the receipt is under the receipts root and returns it.
+func VerifyReceiptProof(receiptsRoot byteutils.Hash, txHash byteutils.Hash, bytes []byte, proof trie.MerkleProof) (*Receipt, error) {
+	if err := trie.VerifyProof(receiptsRoot, txHash, bytes, proof); err != nil {
+		return nil, err
+	}
+	return loadReceipt(bytes)
+}
+
+func loadReceipt(bytes []byte) (*Receipt, error) {
+	pbReceipt := new(corepb.Receipt)
+	if err := proto.Unmarshal(bytes, pbReceipt); err != nil {
+		return nil, err
+	}
+	receipt := new(Receipt)
+	if err := receipt.FromProto(pbReceipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
@@ -63,14 +63,36 @@ var (
 
 	// ZeroGasCount is zero gas count
 	ZeroGasCount = util.NewUint128()
+
+	// BatchItemBaseGasCount is base gas count of each item in batch transaction
+	BatchItemBaseGasCount = util.NewUint128FromInt(20000)
+
+	// EvidenceBaseGasCount is base gas count of evidence transaction
+	EvidenceBaseGasCount = util.NewUint128FromInt(20000)
+
+	// EvidenceGasLimit is the gas limit of evidence transactions submitted by miners
+	EvidenceGasLimit = util.NewUint128FromInt(200000)
+
+	// ClaimBaseGasCount is base gas count of claim transaction
+	ClaimBaseGasCount = util.NewUint128FromInt(20000)
+
+	// UnjailBaseGasCount is base gas count of unjail transaction
+	UnjailBaseGasCount = util.NewUint128FromInt(20000)
+
+	// ProposalBaseGasCount is base gas count of proposal transaction
+	ProposalBaseGasCount = util.NewUint128FromInt(20000)
 )
 
+// BatchMaxItems is the max count of items in a batch transaction
+const BatchMaxItems = 1000
+
 // TransactionEvent transaction event
 type TransactionEvent struct {
-	Hash    string `json:"hash"`
-	Status  int8   `json:"status"`
-	GasUsed string `json:"gas_used"`
-	Error   string `json:"error"`
+	Hash    string             `json:"hash"`
+	Status  int8               `json:"status"`
+	GasUsed string             `json:"gas_used"`
+	Error   string             `json:"error"`
+	Items   []*BatchItemResult `json:"items,omitempty"`
 }
 
 // Transaction type is used to handle all transaction data.
@@ -86,6 +108,10 @@ type Transaction struct {
 	gasPrice  *util.Uint128
 	gasLimit  *util.Uint128
 
+	// validity window, 0 means unset.
+	validUntilHeight    uint64
+	validUntilTimestamp int64
+
 	// Signature
 	alg  uint8          // algorithm
 	sign byteutils.Hash // Signature values
@@ -131,6 +157,44 @@ func (tx *Transaction) Data() []byte {
 	return tx.data.Payload
 }
 
+// ValidUntilHeight return the last block height the tx can be packed in
+func (tx *Transaction) ValidUntilHeight() uint64 {
+	return tx.validUntilHeight
+}
+
+// ValidUntilTimestamp return the last block timestamp the tx can be packed in
+func (tx *Transaction) ValidUntilTimestamp() int64 {
+	return tx.validUntilTimestamp
+}
+
+// SetValidUntil set the validity window of tx, 0 means unset. It must be called before signing.
+func (tx *Transaction) SetValidUntil(height uint64, timestamp int64) {
+	tx.validUntilHeight = height
+	tx.validUntilTimestamp = timestamp
+}
+
+// HasValidityWindow return if the tx has a validity window
+func (tx *Transaction) HasValidityWindow() bool {
+	return tx.validUntilHeight != 0 || tx.validUntilTimestamp != 0
+}
+
+// CheckValidityWindow return error if the tx can't be packed in block at given height and timestamp
+func (tx *Transaction) CheckValidityWindow(rules *ChainRules, height uint64, timestamp int64) error {
+	if !tx.HasValidityWindow() {
+		return nil
+	}
+	if !rules.IsValidityWindow {
+		return ErrValidityWindowNotActivated
+	}
+	if tx.validUntilHeight != 0 && height > tx.validUntilHeight {
+		return ErrTransactionExpired
+	}
+	if tx.validUntilTimestamp != 0 && timestamp > tx.validUntilTimestamp {
+		return ErrTransactionExpired
+	}
+	return nil
+}
+
 // ToProto converts domain Tx to proto Tx
 func (tx *Transaction) ToProto() (proto.Message, error) {
 	value, err := tx.value.ToFixedSizeByteSlice()
@@ -158,6 +222,9 @@ func (tx *Transaction) ToProto() (proto.Message, error) {
 		GasLimit:  gasLimit,
 		Alg:       uint32(tx.alg),
 		Sign:      tx.sign,
+
+		ValidUntilHeight:    tx.validUntilHeight,
+		ValidUntilTimestamp: tx.validUntilTimestamp,
 	}, nil
 }
 
@@ -188,6 +255,8 @@ func (tx *Transaction) FromProto(msg proto.Message) error {
 		tx.gasLimit = gasLimit
 		tx.alg = uint8(msg.Alg)
 		tx.sign = msg.Sign
+		tx.validUntilHeight = msg.ValidUntilHeight
+		tx.validUntilTimestamp = msg.ValidUntilTimestamp
 		return nil
 	}
 	return errors.New("Protobug Message cannot be converted into Transaction")
@@ -290,7 +359,7 @@ func (tx *Transaction) LoadPayload(block *Block) (TxPayload, error) {
 	)
 	switch tx.data.Type {
 	case TxPayloadBinaryType:
-		if block.height > OptimizeHeight {
+		if block.ChainRules().IsOptimize {
 			payload, err = LoadBinaryPayload(tx.data.Payload)
 		} else {
 			if block.Height() >= 280921 && block.Height() <= 297680 || block.Height() >= 300087 && block.Height() <= 302302 {
@@ -307,6 +376,36 @@ func (tx *Transaction) LoadPayload(block *Block) (TxPayload, error) {
 		payload, err = LoadCandidatePayload(tx.data.Payload)
 	case TxPayloadDelegateType:
 		payload, err = LoadDelegatePayload(tx.data.Payload)
+	case TxPayloadBatchType:
+		if block.ChainRules().IsBatchPayload {
+			payload, err = LoadBatchPayload(tx.data.Payload)
+		} else {
+			err = ErrInvalidTxPayloadType
+		}
+	case TxPayloadEvidenceType:
+		if block.ChainRules().IsDoubleSignEvidence {
+			payload, err = LoadEvidencePayload(tx.data.Payload)
+		} else {
+			err = ErrInvalidTxPayloadType
+		}
+	case TxPayloadClaimType:
+		if block.ChainRules().IsRewardSharing {
+			payload, err = LoadClaimPayload(tx.data.Payload)
+		} else {
+			err = ErrInvalidTxPayloadType
+		}
+	case TxPayloadUnjailType:
+		if block.ChainRules().IsJailing {
+			payload, err = LoadUnjailPayload(tx.data.Payload)
+		} else {
+			err = ErrInvalidTxPayloadType
+		}
+	case TxPayloadProposalType:
+		if block.ChainRules().IsGovernance {
+			payload, err = LoadProposalPayload(tx.data.Payload)
+		} else {
+			err = ErrInvalidTxPayloadType
+		}
 	default:
 		err = ErrInvalidTxPayloadType
 	}
@@ -349,6 +448,11 @@ func (tx *Transaction) LocalExecution(block *Block) (*util.Uint128, string, erro
 
 // VerifyExecution transaction and return result.
 func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
+	// check validity window.
+	if err := tx.CheckValidityWindow(block.ChainRules(), block.height, block.header.timestamp); err != nil {
+		return util.NewUint128(), err
+	}
+
 	// check balance.
 	fromAcc, err := block.accState.GetOrCreateUserAccount(tx.from.address)
 	if err != nil {
@@ -389,8 +493,10 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		}).Debug("Failed to load payload.")
 		metricsTxExeFailed.Mark(1)
 
-		tx.gasConsumption(fromAcc, coinbaseAcc, gasUsed)
-		tx.triggerEvent(TopicExecuteTxFailed, block, gasUsed, err)
+		if err := tx.gasConsumption(block, fromAcc, coinbaseAcc, gasUsed); err != nil {
+			return nil, err
+		}
+		tx.triggerEvent(TopicExecuteTxFailed, block, gasUsed, err, nil)
 		return gasUsed, nil
 	}
 
@@ -403,8 +509,10 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		}).Debug("Failed to check base gas used.")
 		metricsTxExeFailed.Mark(1)
 
-		tx.gasConsumption(fromAcc, coinbaseAcc, tx.gasLimit)
-		tx.triggerEvent(TopicExecuteTxFailed, block, tx.gasLimit, ErrOutOfGasLimit)
+		if err := tx.gasConsumption(block, fromAcc, coinbaseAcc, tx.gasLimit); err != nil {
+			return nil, err
+		}
+		tx.triggerEvent(TopicExecuteTxFailed, block, tx.gasLimit, ErrOutOfGasLimit, nil)
 		return tx.gasLimit, nil
 	}
 
@@ -417,6 +525,12 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 	// execute smart contract and sub the calcute gas.
 	gasExecution, _, exeErr := payload.Execute(txBlock, tx)
 
+	// record the result of each item in batch transaction.
+	var items []*BatchItemResult
+	if batch, ok := payload.(*BatchPayload); ok {
+		items = batch.Results
+	}
+
 	// only execute success, merge the state to use
 	if exeErr == nil {
 		block.Merge(txBlock)
@@ -437,7 +551,9 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 	// gas = tx.GasCountOfTxBase() +  gasExecution
 	gas := util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
-	tx.gasConsumption(fromAcc, coinbaseAcc, gas)
+	if err := tx.gasConsumption(block, fromAcc, coinbaseAcc, gas); err != nil {
+		return nil, err
+	}
 
 	if exeErr != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -449,7 +565,7 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		}).Debug("Failed to execute payload.")
 
 		metricsTxExeFailed.Mark(1)
-		tx.triggerEvent(TopicExecuteTxFailed, block, gas, err)
+		tx.triggerEvent(TopicExecuteTxFailed, block, gas, err, items)
 	} else {
 		if fromAcc.Balance().Cmp(tx.value.Int) < 0 {
 			logging.VLog().WithFields(logrus.Fields{
@@ -459,7 +575,7 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 			}).Debug("Failed to check balance sufficient.")
 
 			metricsTxExeFailed.Mark(1)
-			tx.triggerEvent(TopicExecuteTxFailed, block, gas, ErrInsufficientBalance)
+			tx.triggerEvent(TopicExecuteTxFailed, block, gas, ErrInsufficientBalance, items)
 		} else {
 			// accept the transaction
 			fromAcc.SubBalance(tx.value)
@@ -467,25 +583,30 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 			metricsTxExeSuccess.Mark(1)
 			// record tx execution success event
-			tx.triggerEvent(TopicExecuteTxSuccess, block, gas, nil)
+			tx.triggerEvent(TopicExecuteTxSuccess, block, gas, nil, items)
 		}
 	}
 
 	return gas, nil
 }
 
-func (tx *Transaction) gasConsumption(from, coinbase state.Account, gas *util.Uint128) {
-	gasCost := util.NewUint128().Mul(tx.GasPrice().Int, gas.Int)
-	from.SubBalance(util.NewUint128FromBigInt(gasCost))
-	coinbase.AddBalance(util.NewUint128FromBigInt(gasCost))
+func (tx *Transaction) gasConsumption(block *Block, from, coinbase state.Account, gas *util.Uint128) error {
+	gasCost := util.NewUint128FromBigInt(util.NewUint128().Mul(tx.GasPrice().Int, gas.Int))
+	from.SubBalance(gasCost)
+	// the fees are shared with the delegators once reward sharing is enabled.
+	if block.ChainRules().IsRewardSharing {
+		return block.collectFees(gasCost)
+	}
+	coinbase.AddBalance(gasCost)
+	return nil
 }
 
-func (tx *Transaction) triggerEvent(topic string, block *Block, gasUsed *util.Uint128, err error) {
+func (tx *Transaction) triggerEvent(topic string, block *Block, gasUsed *util.Uint128, err error, items []*BatchItemResult) {
 
 	// Notice: We updated the definition of the transaction result event,
 	// and the event is recorded on the chain, so it needs to be compatible.
-	if block.Height() > OptimizeHeight {
-		tx.recordResultEvent(block, gasUsed, err)
+	if block.ChainRules().IsOptimize {
+		tx.recordResultEvent(block, gasUsed, err, items)
 		return
 	}
 
@@ -511,11 +632,12 @@ func (tx *Transaction) triggerEvent(topic string, block *Block, gasUsed *util.Ui
 	block.recordEvent(tx.hash, event)
 }
 
-func (tx *Transaction) recordResultEvent(block *Block, gasUsed *util.Uint128, err error) {
+func (tx *Transaction) recordResultEvent(block *Block, gasUsed *util.Uint128, err error, items []*BatchItemResult) {
 
 	txEvent := &TransactionEvent{
 		Hash:    tx.hash.String(),
 		GasUsed: gasUsed.String(),
+		Items:   items,
 	}
 	if err != nil {
 		txEvent.Status = TxExecutionFailed
@@ -624,7 +746,7 @@ func HashTransaction(tx *Transaction) (byteutils.Hash, error) {
 	if err != nil {
 		return nil, err
 	}
-	return hash.Sha3256(
+	args := [][]byte{
 		tx.from.address,
 		tx.to.address,
 		value,
@@ -634,5 +756,13 @@ func HashTransaction(tx *Transaction) (byteutils.Hash, error) {
 		byteutils.FromUint32(tx.chainID),
 		gasPrice,
 		gasLimit,
-	), nil
+	}
+	// only hash the validity window if set, keep the hash of txs without it unchanged.
+	if tx.HasValidityWindow() {
+		args = append(args,
+			byteutils.FromUint64(tx.validUntilHeight),
+			byteutils.FromInt64(tx.validUntilTimestamp),
+		)
+	}
+	return hash.Sha3256(args...), nil
 }
@@ -0,0 +1,194 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// BatchItem is a transfer, or a contract call if Function is set.
+type BatchItem struct {
+	To       string
+	Value    string
+	Function string
+	Args     string
+}
+
+// BatchItemResult is the execution result of a batch item.
+type BatchItemResult struct {
+	Index   int    `json:"index"`
+	Status  int8   `json:"status"`
+	GasUsed string `json:"gas_used"`
+	Result  string `json:"result,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// BatchPayload carry multiple transfers and contract calls executed atomically,
+// if any item fails, all items are reverted.
+type BatchPayload struct {
+	Items []*BatchItem
+
+	// Results is filled during execution, one for each executed item.
+	Results []*BatchItemResult `json:"-"`
+}
+
+// LoadBatchPayload from bytes
+func LoadBatchPayload(bytes []byte) (*BatchPayload, error) {
+	payload := &BatchPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	if len(payload.Items) == 0 || len(payload.Items) > BatchMaxItems {
+		return nil, ErrInvalidBatchPayloadItems
+	}
+	return payload, nil
+}
+
+// NewBatchPayload with items
+func NewBatchPayload(items []*BatchItem) *BatchPayload {
+	return &BatchPayload{
+		Items: items,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *BatchPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count, each item costs as a normal transaction.
+func (payload *BatchPayload) BaseGasCount() *util.Uint128 {
+	return util.NewUint128FromBigInt(util.NewUint128().Mul(BatchItemBaseGasCount.Int, util.NewUint128FromInt(int64(len(payload.Items))).Int))
+}
+
+// Execute the batch payload in tx, execute the items in order.
+func (payload *BatchPayload) Execute(block *Block, tx *Transaction) (*util.Uint128, string, error) {
+	payload.Results = nil
+
+	if !tx.From().Equals(tx.To()) {
+		return util.NewUint128(), "", ErrBatchTransactionAddressNotEqual
+	}
+
+	gasLimit := tx.PayloadGasLimit(payload)
+	gasExecution := util.NewUint128()
+	for index, item := range payload.Items {
+		remaining := util.NewUint128FromBigInt(util.NewUint128().Sub(gasLimit.Int, gasExecution.Int))
+		gas, result, err := payload.executeItem(block, tx, item, remaining)
+		gasExecution.Add(gasExecution.Int, gas.Int)
+
+		itemGas := util.NewUint128FromBigInt(util.NewUint128().Add(BatchItemBaseGasCount.Int, gas.Int))
+		itemResult := &BatchItemResult{
+			Index:   index,
+			Status:  TxExecutionSuccess,
+			GasUsed: itemGas.String(),
+			Result:  result,
+		}
+		payload.Results = append(payload.Results, itemResult)
+
+		if err != nil {
+			itemResult.Status = TxExecutionFailed
+			itemResult.Error = err.Error()
+			return gasExecution, "", err
+		}
+	}
+
+	results, err := json.Marshal(payload.Results)
+	if err != nil {
+		return gasExecution, "", err
+	}
+	return gasExecution, string(results), nil
+}
+
+func (payload *BatchPayload) executeItem(block *Block, tx *Transaction, item *BatchItem, gasLimit *util.Uint128) (*util.Uint128, string, error) {
+	to, err := AddressParse(item.To)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	value := util.NewUint128()
+	if len(item.Value) > 0 {
+		if _, ok := value.FromString(item.Value); !ok || value.Validate() != nil {
+			return util.NewUint128(), "", ErrInvalidBatchItemValue
+		}
+	}
+
+	gas := util.NewUint128()
+	result := ""
+	if len(item.Function) > 0 {
+		if gasLimit.Sign() <= 0 {
+			return gas, result, ErrOutOfGasLimit
+		}
+		if gas, result, err = payload.callContract(block, tx, item, to, value, gasLimit); err != nil {
+			return gas, result, err
+		}
+	}
+
+	// the balance for the gas of the whole batch can not be transferred
+	fromAcc, err := block.accState.GetOrCreateUserAccount(tx.from.address)
+	if err != nil {
+		return gas, result, err
+	}
+	required := util.NewUint128().Add(tx.MinBalanceRequired().Int, value.Int)
+	if fromAcc.Balance().Cmp(required) < 0 {
+		return gas, result, ErrInsufficientBalance
+	}
+	toAcc, err := block.accState.GetOrCreateUserAccount(to.address)
+	if err != nil {
+		return gas, result, err
+	}
+	fromAcc.SubBalance(value)
+	toAcc.AddBalance(value)
+	return gas, result, nil
+}
+
+func (payload *BatchPayload) callContract(block *Block, tx *Transaction, item *BatchItem, to *Address, value, gasLimit *util.Uint128) (*util.Uint128, string, error) {
+	call, err := NewCallPayload(item.Function, item.Args).ToBytes()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	// the contract sees the item as the transaction it's called by.
+	itemTx := &Transaction{
+		hash:      tx.hash,
+		from:      tx.from,
+		to:        to,
+		value:     value,
+		nonce:     tx.nonce,
+		timestamp: tx.timestamp,
+		data:      &corepb.Data{Type: TxPayloadCallType, Payload: call},
+		chainID:   tx.chainID,
+		gasPrice:  tx.gasPrice,
+		gasLimit:  tx.gasLimit,
+	}
+	ctx, deployPayload, err := generateCallContext(block, itemTx)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	engine := nvm.NewV8Engine(ctx)
+	defer engine.Dispose()
+
+	engine.SetExecutionLimits(gasLimit.Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
+
+	result, err := engine.Call(deployPayload.Source, deployPayload.SourceType, item.Function, item.Args)
+	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), result, err
+}
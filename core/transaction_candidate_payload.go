@@ -35,6 +35,13 @@ const (
 // CandidatePayload carry candidate application
 type CandidatePayload struct {
 	Action string
+
+	// candidate metadata registered by login since ForkCandidateMetadata.
+	Name     string `json:",omitempty"`
+	URL      string `json:",omitempty"`
+	Endpoint string `json:",omitempty"`
+	// Commission is the percent of rewards kept by the candidate before sharing with delegators.
+	Commission uint32 `json:",omitempty"`
 }
 
 // LoadCandidatePayload from bytes
@@ -68,8 +75,26 @@ func (payload *CandidatePayload) Execute(block *Block, tx *Transaction) (*util.U
 	candidate := tx.from.Bytes()
 	switch payload.Action {
 	case LoginAction:
-		if _, err := block.dposContext.candidateTrie.Put(candidate, candidate); err != nil {
-			return ZeroGasCount, "", err
+		if block.ChainRules().IsCandidateMetadata {
+			info := &CandidateInfo{
+				Address:    tx.from,
+				Name:       payload.Name,
+				URL:        payload.URL,
+				Endpoint:   payload.Endpoint,
+				Commission: payload.Commission,
+			}
+			if err := block.registerCandidate(info); err != nil {
+				return ZeroGasCount, "", err
+			}
+		} else {
+			if _, err := block.dposContext.candidateTrie.Put(candidate, candidate); err != nil {
+				return ZeroGasCount, "", err
+			}
+			if block.ChainRules().IsRewardSharing {
+				if err := block.dposContext.setCommission(candidate, payload.Commission); err != nil {
+					return ZeroGasCount, "", err
+				}
+			}
 		}
 		/* 		logging.VLog().WithFields(logrus.Fields{
 			"block":     ctx.block,
@@ -77,7 +102,7 @@ func (payload *CandidatePayload) Execute(block *Block, tx *Transaction) (*util.U
 			"candidate": ctx.tx.from.String(),
 		}).Debug("Candidate login.") */
 	case LogoutAction:
-		if err := block.dposContext.kickoutCandidate(candidate); err != nil {
+		if err := block.logoutCandidate(candidate); err != nil {
 			return ZeroGasCount, "", err
 		}
 		/* 		logging.VLog().WithFields(logrus.Fields{
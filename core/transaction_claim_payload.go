@@ -0,0 +1,78 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// ClaimPayload claims the shared rewards of the sender
+type ClaimPayload struct {
+}
+
+// LoadClaimPayload from bytes
+func LoadClaimPayload(bytes []byte) (*ClaimPayload, error) {
+	payload := &ClaimPayload{}
+	if len(bytes) == 0 {
+		return payload, nil
+	}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewClaimPayload create a claim payload
+func NewClaimPayload() *ClaimPayload {
+	return &ClaimPayload{}
+}
+
+// ToBytes serialize payload
+func (payload *ClaimPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *ClaimPayload) BaseGasCount() *util.Uint128 {
+	return ClaimBaseGasCount
+}
+
+// Execute moves the claimable rewards of the sender to its balance
+func (payload *ClaimPayload) Execute(block *Block, tx *Transaction) (*util.Uint128, string, error) {
+	claimer := tx.from.Bytes()
+	stakeTrie := block.dposContext.stakeTrie
+	reward, err := getStake(stakeTrie, claimableRewardKey(claimer))
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if reward.Sign() == 0 {
+		return ZeroGasCount, "", ErrNoRewardToClaim
+	}
+	if err := putStake(stakeTrie, claimableRewardKey(claimer), util.NewUint128()); err != nil {
+		return ZeroGasCount, "", err
+	}
+	acc, err := block.accState.GetOrCreateUserAccount(claimer)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	acc.AddBalance(reward)
+	return ZeroGasCount, "", nil
+}
@@ -20,10 +20,11 @@ package core
 
 import (
 	"encoding/json"
+	"math/big"
 
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
-	// "github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	// "github.com/nebulasio/go-nebulas/util/logging"
 	// "github.com/sirupsen/logrus"
 )
@@ -38,6 +39,8 @@ const (
 type DelegatePayload struct {
 	Action    string
 	Delegatee string
+	// Value is the amount bonded as stake by a delegation since the staking fork.
+	Value string `json:",omitempty"`
 }
 
 // LoadDelegatePayload from bytes
@@ -74,6 +77,13 @@ func (payload *DelegatePayload) Execute(block *Block, tx *Transaction) (*util.Ui
 	if err != nil {
 		return ZeroGasCount, "", err
 	}
+	staking := block.ChainRules().IsStaking
+	if staking && payload.Action == UnDelegateAction {
+		if err := payload.undelegateStake(block, delegator, delegatee); err != nil {
+			return ZeroGasCount, "", err
+		}
+		return ZeroGasCount, "", nil
+	}
 	// check delegatee valid
 	_, err = block.dposContext.candidateTrie.Get(delegatee.Bytes())
 	if err != nil && err != storage.ErrKeyNotFound {
@@ -101,6 +111,11 @@ func (payload *DelegatePayload) Execute(block *Block, tx *Transaction) (*util.Ui
 		if _, err = block.dposContext.voteTrie.Put(delegator, delegatee.Bytes()); err != nil {
 			return ZeroGasCount, "", err
 		}
+		if staking {
+			if err := payload.bondStake(block, delegator); err != nil {
+				return ZeroGasCount, "", err
+			}
+		}
 		/* 		logging.VLog().WithFields(logrus.Fields{
 			"block":     ctx.block,
 			"tx":        ctx.tx,
@@ -129,3 +144,60 @@ func (payload *DelegatePayload) Execute(block *Block, tx *Transaction) (*util.Ui
 	}
 	return ZeroGasCount, "", nil
 }
+
+// bondStake locks the payload value as stake, a delegator already holding stake may move its vote without adding more.
+func (payload *DelegatePayload) bondStake(block *Block, delegator byteutils.Hash) error {
+	value := util.NewUint128()
+	if len(payload.Value) > 0 {
+		v, ok := new(big.Int).SetString(payload.Value, 10)
+		if !ok || v.Sign() < 0 {
+			return ErrInvalidStakeValue
+		}
+		value = util.NewUint128FromBigInt(v)
+		if err := value.Validate(); err != nil {
+			return ErrInvalidStakeValue
+		}
+	}
+	if value.Sign() > 0 {
+		return block.bond(delegator, value)
+	}
+	stake, err := getStake(block.dposContext.stakeTrie, bondedStakeKey(delegator))
+	if err != nil {
+		return err
+	}
+	if stake.Sign() == 0 {
+		return ErrInvalidStakeValue
+	}
+	return nil
+}
+
+// undelegateStake withdraws the vote and starts unbonding the stake of the delegator.
+// The vote may already be gone if the delegatee was kicked out, and a delegator who voted
+// before staking has no stake to unbond.
+func (payload *DelegatePayload) undelegateStake(block *Block, delegator byteutils.Hash, delegatee *Address) error {
+	pre, err := block.dposContext.voteTrie.Get(delegator)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	voted := err == nil
+	if voted {
+		if !delegatee.address.Equals(pre) {
+			return ErrInvalidUnDelegateFromNonDelegatee
+		}
+		key := append(delegatee.Bytes(), delegator...)
+		if _, err := block.dposContext.delegateTrie.Del(key); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		if _, err := block.dposContext.voteTrie.Del(delegator); err != nil {
+			return err
+		}
+	}
+	stake, err := getStake(block.dposContext.stakeTrie, bondedStakeKey(delegator))
+	if err != nil {
+		return err
+	}
+	if voted && stake.Sign() == 0 {
+		return nil
+	}
+	return block.unbond(delegator)
+}
@@ -79,7 +79,7 @@ func (payload *DeployPayload) Execute(block *Block, tx *Transaction) (*util.Uint
 
 func generateDeployContext(block *Block, tx *Transaction) (*nvm.Context, error) {
 
-	if block.height > NewOptimizeHeight {
+	if block.ChainRules().IsDeployAddressCheck {
 		if !tx.From().Equals(tx.To()) {
 			return nil, ErrContractTransactionAddressNotEqual
 		}
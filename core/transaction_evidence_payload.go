@@ -0,0 +1,217 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// evidence record key prefix in the offender's account storage, followed by the slot timestamp.
+const evidenceKeyPrefix = "double_sign_evidence_"
+
+// BlockEvidence is a signed block header with the hashes of the block's txs,
+// the block hash can be verified without the whole block.
+type BlockEvidence struct {
+	Header   *corepb.BlockHeader
+	TxHashes []byteutils.Hash
+}
+
+// NewBlockEvidence create evidence of a signed block
+func NewBlockEvidence(block *Block) (*BlockEvidence, error) {
+	header, err := block.header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	evidence := &BlockEvidence{Header: header.(*corepb.BlockHeader)}
+	for _, tx := range block.transactions {
+		evidence.TxHashes = append(evidence.TxHashes, tx.hash)
+	}
+	return evidence, nil
+}
+
+// toBlock returns a block with the header and txs' hashes, only for hash and sign verification.
+func (e *BlockEvidence) toBlock() (*Block, error) {
+	if e == nil || e.Header == nil || e.Header.DposContext == nil {
+		return nil, ErrInvalidEvidence
+	}
+	block := &Block{header: new(BlockHeader)}
+	if err := block.header.FromProto(e.Header); err != nil {
+		return nil, err
+	}
+	for _, hash := range e.TxHashes {
+		block.transactions = append(block.transactions, &Transaction{hash: hash})
+	}
+	return block, nil
+}
+
+// EvidencePayload carry the evidence of a miner signing two different blocks in the same slot.
+type EvidencePayload struct {
+	First  *BlockEvidence
+	Second *BlockEvidence
+}
+
+// LoadEvidencePayload from bytes
+func LoadEvidencePayload(bytes []byte) (*EvidencePayload, error) {
+	payload := &EvidencePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewEvidencePayload with two conflicting blocks
+func NewEvidencePayload(first, second *Block) (*EvidencePayload, error) {
+	firstEvidence, err := NewBlockEvidence(first)
+	if err != nil {
+		return nil, err
+	}
+	secondEvidence, err := NewBlockEvidence(second)
+	if err != nil {
+		return nil, err
+	}
+	return &EvidencePayload{
+		First:  firstEvidence,
+		Second: secondEvidence,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *EvidencePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *EvidencePayload) BaseGasCount() *util.Uint128 {
+	return EvidenceBaseGasCount
+}
+
+// Verify the two blocks are different, in the same slot and signed by the same miner.
+// returns the miner who signed both blocks.
+func (payload *EvidencePayload) Verify(chainID uint32) (*Address, error) {
+	first, err := payload.First.toBlock()
+	if err != nil {
+		return nil, err
+	}
+	second, err := payload.Second.toBlock()
+	if err != nil {
+		return nil, err
+	}
+	if first.ChainID() != chainID || second.ChainID() != chainID {
+		return nil, ErrInvalidChainID
+	}
+	if first.Timestamp() != second.Timestamp() || first.Hash().Equals(second.Hash()) {
+		return nil, ErrInvalidEvidence
+	}
+	if !HashBlock(first).Equals(first.Hash()) || !HashBlock(second).Equals(second.Hash()) {
+		return nil, ErrInvalidBlockHash
+	}
+	firstMiner, err := RecoverMiner(first)
+	if err != nil {
+		return nil, err
+	}
+	secondMiner, err := RecoverMiner(second)
+	if err != nil {
+		return nil, err
+	}
+	if !firstMiner.Equals(secondMiner) {
+		return nil, ErrInvalidEvidenceSigner
+	}
+	return firstMiner, nil
+}
+
+// verifyProposer checks the offender is the proposer of the slot in the dynasty of both blocks.
+func (payload *EvidencePayload) verifyProposer(block *Block, offender *Address) error {
+	for _, evidence := range []*BlockEvidence{payload.First, payload.Second} {
+		dynasty, err := trie.NewBatchTrie(evidence.Header.DposContext.DynastyRoot, block.storage)
+		if err != nil {
+			return ErrInvalidEvidenceProposer
+		}
+		proposer, err := block.DposParams().FindProposer(evidence.Header.Timestamp, dynasty)
+		if err != nil {
+			return ErrInvalidEvidenceProposer
+		}
+		if !proposer.Equals(offender.Bytes()) {
+			return ErrInvalidEvidenceProposer
+		}
+	}
+	return nil
+}
+
+// Execute the evidence payload in tx, kickout the offender and burn part of its deposit and bonded stake.
+func (payload *EvidencePayload) Execute(block *Block, tx *Transaction) (*util.Uint128, string, error) {
+	offender, err := payload.Verify(block.header.chainID)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if err := payload.verifyProposer(block, offender); err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	// each slot can only be punished once
+	acc, err := block.accState.GetOrCreateUserAccount(offender.address)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	key := append([]byte(evidenceKeyPrefix), byteutils.FromInt64(payload.First.Header.Timestamp)...)
+	_, err = acc.Get(key)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return ZeroGasCount, "", err
+	}
+	if err == nil {
+		return ZeroGasCount, "", ErrDuplicatedEvidence
+	}
+	if err := acc.Put(key, tx.hash); err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	params, err := block.GovernedParams()
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	// the deposit is removed with the candidate, part of it is burned and the rest unbonded.
+	burnedDeposit, err := block.slashDeposit(offender.address, params.DoubleSignBurnPercent)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if err := block.dposContext.kickoutCandidate(offender.address); err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	burnedStake, err := block.slashStake(offender.address, params.DoubleSignBurnPercent)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":    block,
+		"tx":       tx,
+		"offender": offender,
+		"stake":    burnedStake,
+		"deposit":  burnedDeposit,
+	}).Info("Punished double sign miner.")
+	return ZeroGasCount, "", nil
+}
@@ -20,7 +20,9 @@ package core
 
 import (
 	"testing"
+	"time"
 
+	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/util"
@@ -446,3 +448,220 @@ func TestPayload_Execute(t *testing.T) {
 
 	block.rollback()
 }
+
+func TestLoadBatchPayload(t *testing.T) {
+	to := mockAddress().String()
+	tests := []struct {
+		name  string
+		bytes []byte
+		err   error
+		want  *BatchPayload
+	}{
+		{
+			name:  "parse faild",
+			bytes: []byte("data"),
+			err:   nil,
+			want:  nil,
+		},
+
+		{
+			name:  "no items",
+			bytes: []byte(`{"Items":[]}`),
+			err:   ErrInvalidBatchPayloadItems,
+			want:  nil,
+		},
+
+		{
+			name:  "normal",
+			bytes: []byte(`{"Items":[{"To":"` + to + `","Value":"1"},{"To":"` + to + `","Function":"func","Args":"[0]"}]}`),
+			err:   nil,
+			want: NewBatchPayload([]*BatchItem{
+				&BatchItem{To: to, Value: "1"},
+				&BatchItem{To: to, Function: "func", Args: "[0]"},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadBatchPayload(tt.bytes)
+			if tt.want != nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			} else {
+				assert.NotNil(t, err)
+				if tt.err != nil {
+					assert.Equal(t, tt.err, err)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchPayload_Execute(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	block := bc.tailBlock
+	block.begin()
+	defer block.rollback()
+
+	from, _ := AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	to1 := mockAddress()
+	to2 := mockAddress()
+	tx := NewTransaction(bc.chainID, from, from, util.NewUint128(), 1, TxPayloadBatchType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+
+	// transfers only
+	payload := NewBatchPayload([]*BatchItem{
+		&BatchItem{To: to1.String(), Value: "100"},
+		&BatchItem{To: to2.String(), Value: "200"},
+	})
+	assert.Equal(t, payload.BaseGasCount(), util.NewUint128FromInt(40000))
+	gas, _, err := payload.Execute(block, tx)
+	assert.Nil(t, err)
+	assert.Equal(t, gas, util.NewUint128())
+	assert.Equal(t, len(payload.Results), 2)
+	assert.Equal(t, payload.Results[1].Status, int8(TxExecutionSuccess))
+	assert.Equal(t, payload.Results[1].GasUsed, BatchItemBaseGasCount.String())
+	acc1, _ := block.accState.GetOrCreateUserAccount(to1.Bytes())
+	acc2, _ := block.accState.GetOrCreateUserAccount(to2.Bytes())
+	assert.Equal(t, acc1.Balance(), util.NewUint128FromInt(100))
+	assert.Equal(t, acc2.Balance(), util.NewUint128FromInt(200))
+
+	// the failed item stops the batch
+	payload = NewBatchPayload([]*BatchItem{
+		&BatchItem{To: to1.String(), Value: "100"},
+		&BatchItem{To: to2.String(), Value: "100000000000000000000000"},
+		&BatchItem{To: to2.String(), Value: "100"},
+	})
+	_, _, err = payload.Execute(block, tx)
+	assert.Equal(t, err, ErrInsufficientBalance)
+	assert.Equal(t, len(payload.Results), 2)
+	assert.Equal(t, payload.Results[0].Status, int8(TxExecutionSuccess))
+	assert.Equal(t, payload.Results[1].Status, int8(TxExecutionFailed))
+	assert.Equal(t, payload.Results[1].Error, ErrInsufficientBalance.Error())
+
+	// from & to must equal
+	tx.to = to1
+	_, _, err = payload.Execute(block, tx)
+	assert.Equal(t, err, ErrBatchTransactionAddressNotEqual)
+}
+
+func TestEvidencePayload_Execute(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Consensus.Dpos.DoubleSignBurnPercent = 10
+	bc, _ := NewBlockChain(neb)
+
+	ks := keystore.DefaultKS
+	miner := mockAddress()
+	key, _ := ks.GetUnlocked(miner.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// the miner follows the zero address in the dynasty, so the proposer of the second slot
+	scheduled, err := trie.NewBatchTrie(nil, bc.storage)
+	assert.Nil(t, err)
+	for _, member := range []*Address{GenesisCoinbase, miner} {
+		_, err = scheduled.Put(member.Bytes(), member.Bytes())
+		assert.Nil(t, err)
+	}
+	dynastyBlock := func(nonce uint64, signature keystore.Signature, dynasty *trie.BatchTrie) *Block {
+		block, err := NewBlock(bc.ChainID(), miner, bc.tailBlock)
+		assert.Nil(t, err)
+		if dynasty != nil {
+			block.dposContext.dynastyTrie = dynasty
+		}
+		block.header.timestamp = BlockInterval
+		block.header.nonce = nonce
+		block.SetMiner(miner)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, block.Sign(signature))
+		return block
+	}
+	signedBlock := func(nonce uint64, signature keystore.Signature) *Block {
+		return dynastyBlock(nonce, signature, scheduled)
+	}
+	first := signedBlock(1, signature)
+	second := signedBlock(2, signature)
+
+	// the pool reports blocks signed by the same miner in the same slot
+	sub := NewEventSubscriber(16, []string{TopicDoubleSign})
+	bc.eventEmitter.Register(sub)
+	bc.eventEmitter.Start()
+	defer bc.eventEmitter.Stop()
+	bc.bkPool.reportDoubleSign(first, second)
+	var event *Event
+	select {
+	case event = <-sub.EventChan():
+	case <-time.After(time.Second):
+	}
+	assert.NotNil(t, event)
+
+	payload, err := LoadEvidencePayload([]byte(event.Data))
+	assert.Nil(t, err)
+	offender, err := payload.Verify(bc.ChainID())
+	assert.Nil(t, err)
+	assert.Equal(t, miner, offender)
+
+	block := bc.tailBlock
+	block.begin()
+	defer block.rollback()
+	_, err = block.dposContext.candidateTrie.Put(miner.Bytes(), miner.Bytes())
+	assert.Nil(t, err)
+	acc, err := block.accState.GetOrCreateUserAccount(miner.Bytes())
+	assert.Nil(t, err)
+	acc.AddBalance(util.NewUint128FromInt(1000))
+	assert.Nil(t, putStake(block.dposContext.stakeTrie, bondedStakeKey(miner.Bytes()), util.NewUint128FromInt(1000)))
+
+	// the miner must be the proposer of the slot
+	submitter := mockAddress()
+	unscheduled, err := NewEvidencePayload(dynastyBlock(1, signature, nil), dynastyBlock(2, signature, nil))
+	assert.Nil(t, err)
+	tx := NewTransaction(bc.ChainID(), submitter, submitter, util.NewUint128(), 1, TxPayloadEvidenceType, []byte(event.Data), TransactionGasPrice, util.NewUint128FromInt(200000))
+	_, _, err = unscheduled.Execute(block, tx)
+	assert.Equal(t, ErrInvalidEvidenceProposer, err)
+
+	// the bonded stake is burned, not the balance
+	_, _, err = payload.Execute(block, tx)
+	assert.Nil(t, err)
+	_, err = block.dposContext.candidateTrie.Get(miner.Bytes())
+	assert.NotNil(t, err)
+	assert.Equal(t, util.NewUint128FromInt(1000), acc.Balance())
+	stake, err := block.BondedStake(miner)
+	assert.Nil(t, err)
+	assert.Equal(t, "900", stake.String())
+
+	// each slot is punished once
+	_, _, err = payload.Execute(block, tx)
+	assert.Equal(t, ErrDuplicatedEvidence, err)
+
+	// the same block twice is not evidence
+	payload, err = NewEvidencePayload(first, first)
+	assert.Nil(t, err)
+	_, err = payload.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidEvidence, err)
+
+	// tampered header
+	payload, err = NewEvidencePayload(first, second)
+	assert.Nil(t, err)
+	payload.Second.Header.Timestamp = BlockInterval * 2
+	_, err = payload.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidEvidence, err)
+	payload.Second.Header.Timestamp = BlockInterval
+	payload.Second.Header.Nonce = 3
+	_, err = payload.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidBlockHash, err)
+
+	// blocks signed by different miners
+	other := mockAddress()
+	otherKey, _ := ks.GetUnlocked(other.String())
+	otherSignature, _ := crypto.NewSignature(keystore.SECP256K1)
+	otherSignature.InitSign(otherKey.(keystore.PrivateKey))
+	payload, err = NewEvidencePayload(first, signedBlock(3, otherSignature))
+	assert.Nil(t, err)
+	_, err = payload.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidEvidenceSigner, err)
+
+	// the evidence payload is rejected before the fork
+	_, err = tx.LoadPayload(block)
+	assert.Equal(t, ErrInvalidTxPayloadType, err)
+}
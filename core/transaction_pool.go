@@ -48,6 +48,13 @@ type TransactionPool struct {
 	gasPrice *util.Uint128 // the lowest gasPrice.
 	gasLimit *util.Uint128 // the maximum gasLimit.
 
+	filters []TxFilter
+	locals  map[string]bool // local accounts, bypass the gasPrice floor and evicted last.
+
+	// the governed params of the tail block, checking the txs fit in a block.
+	tailParams *GovernedParams
+	tailHash   byteutils.HexHash
+
 	eventEmitter *EventEmitter
 }
 
@@ -75,6 +82,7 @@ func NewTransactionPool(size int) (*TransactionPool, error) {
 		all:               make(map[byteutils.HexHash]*Transaction),
 		gasPrice:          TransactionGasPrice,
 		gasLimit:          TransactionMaxGas,
+		locals:            make(map[string]bool),
 	}
 	return txPool, nil
 }
@@ -93,6 +101,34 @@ func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) {
 	}
 }
 
+// AddFilter append a filter to the admission filter chain.
+func (pool *TransactionPool) AddFilter(filter TxFilter) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.filters = append(pool.filters, filter)
+}
+
+// SetLocalAccounts reset the local accounts of the pool.
+func (pool *TransactionPool) SetLocalAccounts(addrs []*Address) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.locals = make(map[string]bool)
+	for _, addr := range addrs {
+		pool.locals[addr.String()] = true
+	}
+}
+
+// AddLocalAccount add a local account to the pool.
+func (pool *TransactionPool) AddLocalAccount(addr *Address) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.locals[addr.String()] = true
+}
+
+func (pool *TransactionPool) isLocal(tx *Transaction) bool {
+	return pool.locals[tx.from.String()]
+}
+
 // RegisterInNetwork register message subscriber in network.
 func (pool *TransactionPool) RegisterInNetwork(ns net.Service) {
 	ns.Register(net.NewSubscriber(pool, pool.receivedMessageCh, true, MessageTypeNewTx, net.MessageWeightNewTx))
@@ -230,8 +266,10 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 		return ErrDuplicatedTransaction
 	}
 
+	local := pool.isLocal(tx)
+
 	// if tx's gasPrice below the pool config lowest gasPrice, return ErrBelowGasPrice
-	if tx.gasPrice.Cmp(pool.gasPrice.Int) < 0 {
+	if !local && tx.gasPrice.Cmp(pool.gasPrice.Int) < 0 {
 		metricsTxPoolBelowGasPrice.Inc(1)
 		return ErrBelowGasPrice
 	}
@@ -246,13 +284,33 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 		return err
 	}
 
+	// verify the tx can be packed in next block
+	height := pool.bc.TailBlock().Height() + 1
+	if err := tx.CheckValidityWindow(pool.bc.ChainRules(height), height, time.Now().Unix()); err != nil {
+		metricsInvalidTx.Inc(1)
+		return err
+	}
+
+	// verify the tx fits in a block, otherwise it is given back by every block
+	if err := pool.checkBlockLimits(tx, height); err != nil {
+		metricsInvalidTx.Inc(1)
+		return err
+	}
+
+	// run the admission filter chain
+	for _, filter := range pool.filters {
+		if err := filter.Filter(tx, local); err != nil {
+			metricsTxPoolFiltered.Inc(1)
+			return err
+		}
+	}
+
 	// cache the verified tx
 	pool.cache.Insert(tx)
 	pool.all[tx.hash.Hex()] = tx
 	// delete tx with lowest priority if cache is full
 	if pool.cache.Len() > pool.size {
-		tx := pool.cache.PopMax().(*Transaction)
-		delete(pool.all, tx.hash.Hex())
+		pool.evict()
 	}
 
 	// trigger pending transaction
@@ -265,6 +323,56 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 	return nil
 }
 
+// checkBlockLimits verifies the gas limit and the size of the tx are within the limits of the block at height.
+func (pool *TransactionPool) checkBlockLimits(tx *Transaction, height uint64) error {
+	if !pool.bc.ChainRules(height).IsBlockLimits {
+		return nil
+	}
+	tail := pool.bc.TailBlock()
+	if pool.tailParams == nil || pool.tailHash != tail.Hash().Hex() {
+		params, err := tail.GovernedParams()
+		if err != nil {
+			return err
+		}
+		pool.tailParams, pool.tailHash = params, tail.Hash().Hex()
+	}
+	if tx.gasLimit.Cmp(pool.tailParams.BlockGasLimit.Int) > 0 {
+		return ErrTxExceedBlockGasLimit
+	}
+	size, err := txSize(tx)
+	if err != nil {
+		return err
+	}
+	if size > pool.tailParams.BlockSizeLimit {
+		return ErrTxExceedBlockSizeLimit
+	}
+	return nil
+}
+
+// evict delete the remote tx with lowest priority,
+// local txs are evicted only if there is no remote tx left.
+func (pool *TransactionPool) evict() {
+	var locals []*Transaction
+	var evicted *Transaction
+	for pool.cache.Len() > 0 {
+		tx := pool.cache.PopMax().(*Transaction)
+		if !pool.isLocal(tx) {
+			evicted = tx
+			break
+		}
+		locals = append(locals, tx)
+	}
+	if evicted == nil {
+		// all txs are local, evict the one with lowest priority
+		evicted = locals[0]
+		locals = locals[1:]
+	}
+	for _, tx := range locals {
+		pool.cache.Insert(tx)
+	}
+	delete(pool.all, evicted.hash.Hex())
+}
+
 // Pop a transaction from pool
 func (pool *TransactionPool) Pop() *Transaction {
 	pool.mu.Lock()
@@ -287,3 +395,17 @@ func (pool *TransactionPool) Empty() bool {
 	defer pool.mu.Unlock()
 	return pool.cache.Len() == 0
 }
+
+// Len return the number of pending txs in the pool
+func (pool *TransactionPool) Len() int {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	return pool.cache.Len()
+}
+
+// GasPrice return the lowest gasPrice accepted from remote txs
+func (pool *TransactionPool) GasPrice() *util.Uint128 {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	return pool.gasPrice
+}
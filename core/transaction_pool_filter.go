@@ -0,0 +1,155 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// TxFilter decides whether a tx can be admitted into the transaction pool.
+// local is true when the tx is sent from one of the node's local accounts.
+type TxFilter interface {
+	Filter(tx *Transaction, local bool) error
+}
+
+// BlacklistFilter rejects txs sent from or to blacklisted addresses.
+type BlacklistFilter struct {
+	addrs map[string]bool
+}
+
+// NewBlacklistFilter create a new BlacklistFilter
+func NewBlacklistFilter(addrs []*Address) *BlacklistFilter {
+	filter := &BlacklistFilter{addrs: make(map[string]bool)}
+	for _, addr := range addrs {
+		filter.addrs[addr.String()] = true
+	}
+	return filter
+}
+
+// Filter implements TxFilter
+func (f *BlacklistFilter) Filter(tx *Transaction, local bool) error {
+	if f.addrs[tx.from.String()] || f.addrs[tx.to.String()] {
+		return ErrTxBlacklisted
+	}
+	return nil
+}
+
+// ContractAllowlistFilter only accepts contract calls to allowed contracts.
+type ContractAllowlistFilter struct {
+	contracts map[string]bool
+}
+
+// NewContractAllowlistFilter create a new ContractAllowlistFilter
+func NewContractAllowlistFilter(contracts []*Address) *ContractAllowlistFilter {
+	filter := &ContractAllowlistFilter{contracts: make(map[string]bool)}
+	for _, addr := range contracts {
+		filter.contracts[addr.String()] = true
+	}
+	return filter
+}
+
+// Filter implements TxFilter
+func (f *ContractAllowlistFilter) Filter(tx *Transaction, local bool) error {
+	if tx.Type() == TxPayloadCallType && !f.contracts[tx.to.String()] {
+		return ErrContractNotAllowed
+	}
+	return nil
+}
+
+// txFilterPayloadTypes is the payload types a gas price floor can be set for.
+var txFilterPayloadTypes = map[string]bool{
+	TxPayloadBinaryType:    true,
+	TxPayloadDeployType:    true,
+	TxPayloadCallType:      true,
+	TxPayloadDelegateType:  true,
+	TxPayloadCandidateType: true,
+	TxPayloadBatchType:     true,
+	TxPayloadEvidenceType:  true,
+	TxPayloadClaimType:     true,
+	TxPayloadUnjailType:    true,
+	TxPayloadProposalType:  true,
+}
+
+// ParseTxFilterValue parses a decimal amount in the tx filter config.
+func ParseTxFilterValue(str string) (*util.Uint128, error) {
+	value, ok := util.NewUint128().FromString(str)
+	if !ok {
+		return nil, ErrInvalidTxFilterValue
+	}
+	if err := value.Validate(); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// ParsePayloadGasPrices parses the gas price floors by payload type in the tx filter config.
+func ParsePayloadGasPrices(conf map[string]string) (map[string]*util.Uint128, error) {
+	gasPrices := make(map[string]*util.Uint128)
+	for payloadType, str := range conf {
+		if !txFilterPayloadTypes[payloadType] {
+			return nil, ErrInvalidTxPayloadType
+		}
+		gasPrice, err := ParseTxFilterValue(str)
+		if err != nil {
+			return nil, err
+		}
+		gasPrices[payloadType] = gasPrice
+	}
+	return gasPrices, nil
+}
+
+// MinValueFilter rejects txs whose value is below the minimum.
+type MinValueFilter struct {
+	value *util.Uint128
+}
+
+// NewMinValueFilter create a new MinValueFilter
+func NewMinValueFilter(value *util.Uint128) *MinValueFilter {
+	return &MinValueFilter{value: value}
+}
+
+// Filter implements TxFilter
+func (f *MinValueFilter) Filter(tx *Transaction, local bool) error {
+	if tx.value.Cmp(f.value.Int) < 0 {
+		return ErrBelowMinValue
+	}
+	return nil
+}
+
+// PayloadGasPriceFilter rejects txs whose gasPrice is below the floor of their payload type.
+// Txs from local accounts bypass the floor.
+type PayloadGasPriceFilter struct {
+	gasPrices map[string]*util.Uint128
+}
+
+// NewPayloadGasPriceFilter create a new PayloadGasPriceFilter, gasPrices is keyed by payload type.
+func NewPayloadGasPriceFilter(gasPrices map[string]*util.Uint128) *PayloadGasPriceFilter {
+	return &PayloadGasPriceFilter{gasPrices: gasPrices}
+}
+
+// Filter implements TxFilter
+func (f *PayloadGasPriceFilter) Filter(tx *Transaction, local bool) error {
+	if local {
+		return nil
+	}
+	if gasPrice, ok := f.gasPrices[tx.Type()]; ok && tx.gasPrice.Cmp(gasPrice.Int) < 0 {
+		return ErrBelowGasPrice
+	}
+	return nil
+}
@@ -157,3 +157,120 @@ func TestPushTxs(t *testing.T) {
 	assert.Equal(t, txPool.push(txs[0]), ErrBelowGasPrice)
 	assert.Equal(t, txPool.push(txs[1]), ErrOutOfGasLimit)
 }
+
+func TestTxFilters(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv1 := secp256k1.GeneratePrivateKey()
+	pubdata1, _ := priv1.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata1)
+	ks.SetKey(from.String(), priv1, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key1, _ := ks.GetUnlocked(from.String())
+	signature1, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature1.InitSign(key1.(keystore.PrivateKey))
+
+	priv2 := secp256k1.GeneratePrivateKey()
+	pubdata2, _ := priv2.PublicKey().Encoded()
+	to, _ := NewAddressFromPublicKey(pubdata2)
+
+	txPool, _ := NewTransactionPool(10)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+
+	heighPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2).Int))
+	callPayload, _ := NewCallPayload("func", "").ToBytes()
+	txs := []*Transaction{
+		NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(100), 2, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(100), 3, TxPayloadCallType, callPayload, TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), from, from, util.NewUint128FromInt(100), 4, TxPayloadCallType, callPayload, heighPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), from, from, util.NewUint128FromInt(100), 5, TxPayloadCallType, callPayload, TransactionGasPrice, util.NewUint128FromInt(200000)),
+	}
+	for _, tx := range txs {
+		assert.Nil(t, tx.Sign(signature1))
+	}
+
+	txPool.AddFilter(NewMinValueFilter(util.NewUint128FromInt(10)))
+	txPool.AddFilter(NewContractAllowlistFilter([]*Address{from}))
+	txPool.AddFilter(NewPayloadGasPriceFilter(map[string]*util.Uint128{TxPayloadCallType: heighPrice}))
+	assert.Equal(t, txPool.Push(txs[0]), ErrBelowMinValue)
+	assert.Nil(t, txPool.Push(txs[1]))
+	assert.Equal(t, txPool.Push(txs[2]), ErrContractNotAllowed)
+	assert.Nil(t, txPool.Push(txs[3]))
+	assert.Equal(t, txPool.Push(txs[4]), ErrBelowGasPrice)
+
+	// local accounts bypass the gasPrice floor
+	txPool.AddLocalAccount(from)
+	assert.Nil(t, txPool.Push(txs[4]))
+
+	txPool.AddFilter(NewBlacklistFilter([]*Address{to}))
+	txs[0].nonce = 6
+	txs[0].value = util.NewUint128FromInt(100)
+	assert.Nil(t, txs[0].Sign(signature1))
+	assert.Equal(t, txPool.Push(txs[0]), ErrTxBlacklisted)
+}
+
+func TestParseTxFilterConfig(t *testing.T) {
+	value, err := ParseTxFilterValue("1000000")
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000", value.String())
+	_, err = ParseTxFilterValue("1e6")
+	assert.Equal(t, ErrInvalidTxFilterValue, err)
+	_, err = ParseTxFilterValue("-1")
+	assert.Equal(t, util.ErrUint128Underflow, err)
+
+	gasPrices, err := ParsePayloadGasPrices(map[string]string{TxPayloadCallType: "2000000"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2000000", gasPrices[TxPayloadCallType].String())
+	_, err = ParsePayloadGasPrices(map[string]string{"cal": "2000000"})
+	assert.Equal(t, ErrInvalidTxPayloadType, err)
+	_, err = ParsePayloadGasPrices(map[string]string{TxPayloadCallType: ""})
+	assert.Equal(t, ErrInvalidTxFilterValue, err)
+}
+
+func TestLocalTxsEviction(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv1 := secp256k1.GeneratePrivateKey()
+	pubdata1, _ := priv1.PublicKey().Encoded()
+	local, _ := NewAddressFromPublicKey(pubdata1)
+	ks.SetKey(local.String(), priv1, []byte("passphrase"))
+	ks.Unlock(local.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key1, _ := ks.GetUnlocked(local.String())
+	signature1, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature1.InitSign(key1.(keystore.PrivateKey))
+
+	priv2 := secp256k1.GeneratePrivateKey()
+	pubdata2, _ := priv2.PublicKey().Encoded()
+	remote, _ := NewAddressFromPublicKey(pubdata2)
+	ks.SetKey(remote.String(), priv2, []byte("passphrase"))
+	ks.Unlock(remote.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key2, _ := ks.GetUnlocked(remote.String())
+	signature2, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature2.InitSign(key2.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(2)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetLocalAccounts([]*Address{local})
+
+	heighPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2).Int))
+	txs := []*Transaction{
+		NewTransaction(bc.ChainID(), local, remote, util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), heighPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), local, remote, util.NewUint128(), 2, TxPayloadBinaryType, []byte("data"), heighPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), remote, local, util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+	}
+	assert.Nil(t, txs[0].Sign(signature1))
+	assert.Nil(t, txs[1].Sign(signature1))
+	assert.Nil(t, txs[2].Sign(signature2))
+
+	assert.Nil(t, txPool.Push(txs[0]))
+	assert.Nil(t, txPool.Push(txs[2]))
+	// the pool is full, the remote tx is evicted before the local ones
+	assert.Nil(t, txPool.Push(txs[1]))
+	assert.Equal(t, txPool.cache.Len(), 2)
+	assert.NotNil(t, txPool.GetTransaction(txs[0].hash))
+	assert.NotNil(t, txPool.GetTransaction(txs[1].hash))
+	assert.Nil(t, txPool.GetTransaction(txs[2].hash))
+}
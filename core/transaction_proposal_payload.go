@@ -0,0 +1,168 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Proposal Action
+const (
+	ProposeAction = "propose"
+	VoteAction    = "vote"
+)
+
+// ProposalPayload proposes a governed param change or votes on a proposal
+type ProposalPayload struct {
+	Action string
+
+	// param change of propose action, activated at Height if the proposal passes.
+	Param  string `json:",omitempty"`
+	Value  string `json:",omitempty"`
+	Height uint64 `json:",omitempty"`
+
+	// hex id of the proposal of vote action.
+	Proposal string `json:",omitempty"`
+	Approve  bool   `json:",omitempty"`
+}
+
+// LoadProposalPayload from bytes
+func LoadProposalPayload(bytes []byte) (*ProposalPayload, error) {
+	payload := &ProposalPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewProposePayload create a payload proposing to change the param at height
+func NewProposePayload(param, value string, height uint64) *ProposalPayload {
+	return &ProposalPayload{
+		Action: ProposeAction,
+		Param:  param,
+		Value:  value,
+		Height: height,
+	}
+}
+
+// NewVotePayload create a payload voting on the proposal
+func NewVotePayload(proposal string, approve bool) *ProposalPayload {
+	return &ProposalPayload{
+		Action:   VoteAction,
+		Proposal: proposal,
+		Approve:  approve,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *ProposalPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *ProposalPayload) BaseGasCount() *util.Uint128 {
+	return ProposalBaseGasCount
+}
+
+// Execute the proposal payload in tx, only delegators with bonded stake can propose or vote
+func (payload *ProposalPayload) Execute(block *Block, tx *Transaction) (*util.Uint128, string, error) {
+	stakeTrie := block.dposContext.stakeTrie
+	sender := tx.from.Bytes()
+	stake, err := getStake(stakeTrie, bondedStakeKey(sender))
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if stake.Sign() == 0 {
+		return ZeroGasCount, "", ErrNoStakeToGovern
+	}
+
+	switch payload.Action {
+	case ProposeAction:
+		params, err := block.GovernedParams()
+		if err != nil {
+			return ZeroGasCount, "", err
+		}
+		deposit, maxProposals := params.ProposalDeposit, params.MaxProposalsPerHeight
+		end := block.height + params.VotingPeriod
+		if err := params.set(payload.Param, payload.Value); err != nil {
+			return ZeroGasCount, "", err
+		}
+		if payload.Height <= end {
+			return ZeroGasCount, "", ErrInvalidActivationHeight
+		}
+		ending, err := iterateValues(stakeTrie, proposalEndKey(end, nil))
+		if err != nil {
+			return ZeroGasCount, "", err
+		}
+		if len(ending) >= maxProposals {
+			return ZeroGasCount, "", ErrTooManyProposals
+		}
+		acc, err := block.accState.GetOrCreateUserAccount(sender)
+		if err != nil {
+			return ZeroGasCount, "", err
+		}
+		if err := acc.SubBalance(deposit); err != nil {
+			return ZeroGasCount, "", err
+		}
+		proposal := &Proposal{
+			ID:               tx.hash,
+			Proposer:         sender,
+			Param:            payload.Param,
+			Value:            payload.Value,
+			EndHeight:        end,
+			ActivationHeight: payload.Height,
+			Status:           ProposalVoting,
+			Yes:              util.NewUint128(),
+			No:               util.NewUint128(),
+			Deposit:          deposit,
+		}
+		if err := putProposal(stakeTrie, proposal); err != nil {
+			return ZeroGasCount, "", err
+		}
+		if _, err := stakeTrie.Put(proposalEndKey(end, proposal.ID), proposal.ID); err != nil {
+			return ZeroGasCount, "", err
+		}
+	case VoteAction:
+		id, err := byteutils.FromHex(payload.Proposal)
+		if err != nil {
+			return ZeroGasCount, "", ErrProposalNotFound
+		}
+		proposal, err := getProposal(stakeTrie, id)
+		if err != nil {
+			return ZeroGasCount, "", err
+		}
+		if proposal.Status != ProposalVoting || block.height > proposal.EndHeight {
+			return ZeroGasCount, "", ErrProposalVotingClosed
+		}
+		approve := byte(0)
+		if payload.Approve {
+			approve = 1
+		}
+		vote := append(byteutils.Hash{}, sender...)
+		if _, err := stakeTrie.Put(proposalVoteKey(id, sender), append(vote, approve)); err != nil {
+			return ZeroGasCount, "", err
+		}
+	default:
+		return ZeroGasCount, "", ErrInvalidProposalPayloadAction
+	}
+	return ZeroGasCount, "", nil
+}
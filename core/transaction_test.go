@@ -537,3 +537,59 @@ func TestTransaction_LocalExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestTransaction_ValidityWindow(t *testing.T) {
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	tx := NewTransaction(1, from, mockAddress(), util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	hashWithout, err := HashTransaction(tx)
+	assert.Nil(t, err)
+	tx.SetValidUntil(ValidityWindowHeight+10, 1000)
+	hashWith, err := HashTransaction(tx)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hashWithout, hashWith)
+
+	// the window is covered by the signature
+	assert.Nil(t, tx.Sign(signature))
+	msg, _ := tx.ToProto()
+	ntx := new(Transaction)
+	assert.Nil(t, ntx.FromProto(msg))
+	assert.Equal(t, ntx.ValidUntilHeight(), uint64(ValidityWindowHeight+10))
+	assert.Equal(t, ntx.ValidUntilTimestamp(), int64(1000))
+	assert.Nil(t, ntx.VerifyIntegrity(1))
+	ntx.validUntilHeight++
+	assert.Equal(t, ntx.VerifyIntegrity(1), ErrInvalidTransactionHash)
+
+	tests := []struct {
+		name      string
+		height    uint64
+		timestamp int64
+		until     []int64
+		wanted    error
+	}{
+		{"no window", 1, 0, []int64{0, 0}, nil},
+		{"not activated", ValidityWindowHeight, 0, []int64{ValidityWindowHeight + 10, 0}, ErrValidityWindowNotActivated},
+		{"valid height", ValidityWindowHeight + 10, 0, []int64{ValidityWindowHeight + 10, 0}, nil},
+		{"expired height", ValidityWindowHeight + 11, 0, []int64{ValidityWindowHeight + 10, 0}, ErrTransactionExpired},
+		{"valid timestamp", ValidityWindowHeight + 1, 1000, []int64{0, 1000}, nil},
+		{"expired timestamp", ValidityWindowHeight + 1, 1001, []int64{0, 1000}, ErrTransactionExpired},
+		{"expired timestamp with valid height", ValidityWindowHeight + 1, 1001, []int64{ValidityWindowHeight + 10, 1000}, ErrTransactionExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx.SetValidUntil(uint64(tt.until[0]), tt.until[1])
+			assert.Equal(t, tt.wanted, tx.CheckValidityWindow(DefaultForkSchedule.ChainRules(tt.height), tt.height, tt.timestamp))
+		})
+	}
+
+	// the pool rejects the window before activation
+	bc, _ := NewBlockChain(testNeb())
+	tx = NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.SetValidUntil(ValidityWindowHeight+10, 0)
+	assert.Nil(t, tx.Sign(signature))
+	assert.Equal(t, bc.txPool.Push(tx), ErrValidityWindowNotActivated)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// UnjailPayload returns a jailed validator to elections after its jail period
+type UnjailPayload struct {
+}
+
+// LoadUnjailPayload from bytes
+func LoadUnjailPayload(bytes []byte) (*UnjailPayload, error) {
+	payload := &UnjailPayload{}
+	if len(bytes) == 0 {
+		return payload, nil
+	}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewUnjailPayload create an unjail payload
+func NewUnjailPayload() *UnjailPayload {
+	return &UnjailPayload{}
+}
+
+// ToBytes serialize payload
+func (payload *UnjailPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *UnjailPayload) BaseGasCount() *util.Uint128 {
+	return UnjailBaseGasCount
+}
+
+// Execute removes the jail record of the sender once the jail period ends
+func (payload *UnjailPayload) Execute(block *Block, tx *Transaction) (*util.Uint128, string, error) {
+	validator := tx.from.Bytes()
+	stakeTrie := block.dposContext.stakeTrie
+	jail, err := getJail(stakeTrie, validator)
+	if err == storage.ErrKeyNotFound {
+		return ZeroGasCount, "", ErrValidatorNotJailed
+	}
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if block.Timestamp()/block.DposParams().DynastyInterval < jail.Release {
+		return ZeroGasCount, "", ErrValidatorStillJailed
+	}
+	if _, err := stakeTrie.Del(jailKey(validator)); err != nil {
+		return ZeroGasCount, "", err
+	}
+	return ZeroGasCount, "", nil
+}
@@ -20,7 +20,6 @@ package core
 
 import (
 	"errors"
-	"strconv"
 
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
@@ -30,13 +29,50 @@ import (
 
 // const definition
 const (
-	// OptimizeHeight after this height,
+	// OptimizeHeight is the default height of ForkOptimize,
 	// update transaction execution result event,
 	// update binary transaction payload.
 	OptimizeHeight = 480000
 
+	// NewOptimizeHeight is the default height of ForkDeployAddressCheck,
 	// update deploy execution, from & to must equal
 	NewOptimizeHeight = 750000
+
+	// BatchPayloadHeight is the default height of ForkBatchPayload.
+	BatchPayloadHeight = 1000000
+
+	// ValidityWindowHeight is the default height of ForkValidityWindow.
+	ValidityWindowHeight = 1000000
+
+	// DoubleSignEvidenceHeight is the default height of ForkDoubleSignEvidence.
+	DoubleSignEvidenceHeight = 1000000
+
+	// StakingHeight is the default height of ForkStaking.
+	StakingHeight = 1000000
+
+	// RewardSharingHeight is the default height of ForkRewardSharing.
+	RewardSharingHeight = 1000000
+
+	// CandidateMetadataHeight is the default height of ForkCandidateMetadata.
+	CandidateMetadataHeight = 1000000
+
+	// JailingHeight is the default height of ForkJailing.
+	JailingHeight = 1000000
+
+	// GovernanceHeight is the default height of ForkGovernance.
+	GovernanceHeight = 1000000
+
+	// BlockLimitsHeight is the default height of ForkBlockLimits.
+	BlockLimitsHeight = 1000000
+
+	// ReceiptsHeight is the default height of ForkReceipts.
+	ReceiptsHeight = 1000000
+
+	// LogsBloomHeight is the default height of ForkLogsBloom.
+	LogsBloomHeight = 1000000
+
+	// BatchCallUsageHeight is the default height of ForkBatchCallUsage.
+	BatchCallUsageHeight = 1000000
 )
 
 // Payload Types
@@ -46,6 +82,11 @@ const (
 	TxPayloadCallType      = "call"
 	TxPayloadDelegateType  = "delegate"
 	TxPayloadCandidateType = "candidate"
+	TxPayloadBatchType     = "batch"
+	TxPayloadEvidenceType  = "evidence"
+	TxPayloadClaimType     = "claim"
+	TxPayloadUnjailType    = "unjail"
+	TxPayloadProposalType  = "proposal"
 )
 
 const (
@@ -74,9 +115,10 @@ var (
 	ErrInvalidSignature                                  = errors.New("invalid transaction signature")
 	ErrInvalidTransactionHash                            = errors.New("invalid transaction hash")
 	ErrMissingParentBlock                                = errors.New("cannot find the block's parent block in storage")
-	ErrTooFewCandidates                                  = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
+	ErrTooFewCandidates                                  = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal safe size")
 	ErrNotBlockForgTime                                  = errors.New("now is not time to forg block")
 	ErrInvalidBlockHash                                  = errors.New("invalid block hash")
+	ErrInvalidBlockHeader                                = errors.New("invalid block header")
 	ErrInvalidBlockStateRoot                             = errors.New("invalid block state root hash")
 	ErrInvalidBlockTxsRoot                               = errors.New("invalid block txs root hash")
 	ErrInvalidBlockEventsRoot                            = errors.New("invalid block events root hash")
@@ -95,7 +137,7 @@ var (
 	ErrInvalidDelegateToNonCandidate                     = errors.New("cannot delegate to non-candidate")
 	ErrInvalidUnDelegateFromNonDelegatee                 = errors.New("cannot un-delegate from non-delegatee")
 	ErrInvalidBaseAndNextDynastyID                       = errors.New("cannot kickout from baseDynastyID to nextDynastyID if nextDynastyID <= baseDynastyID")
-	ErrInitialDynastyNotEnough                           = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
+	ErrInitialDynastyNotEnough                           = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal safe size")
 	ErrInvalidTransactionSigner                          = errors.New("transaction recover public key address not equal to from")
 	ErrNotBlockInCanonicalChain                          = errors.New("cannot find the block in canonical chain")
 	ErrCloneAccountState                                 = errors.New("Failed to clone account state")
@@ -106,7 +148,9 @@ var (
 	ErrCloneCandidatesTrie                               = errors.New("Failed to clone candidates trie")
 	ErrCloneVoteTrie                                     = errors.New("Failed to clone vote trie")
 	ErrCloneMintCntTrie                                  = errors.New("Failed to clone mint count trie")
+	ErrCloneStakeTrie                                    = errors.New("Failed to clone stake trie")
 	ErrCloneEventsState                                  = errors.New("Failed to clone events state")
+	ErrCloneReceiptsState                                = errors.New("Failed to clone receipts state")
 	ErrGenerateNextDynastyContext                        = errors.New("Failed to generate next dynasty context")
 	ErrLoadNextDynastyContext                            = errors.New("Failed to load next dynasty context")
 	ErrGenesisConfNotMatch                               = errors.New("Failed to load genesis from sotrage, different with genesis conf")
@@ -120,6 +164,63 @@ var (
 	ErrFoundNilProposer                                  = errors.New("found a nil proposer")
 	ErrContractNotFound                                  = errors.New("contract not found")
 	ErrContractTransactionAddressNotEqual                = errors.New("contract transaction from-address not equal to to-address")
+	ErrTxBlacklisted                                     = errors.New("transaction from or to a blacklisted address")
+	ErrContractNotAllowed                                = errors.New("contract is not in the allowlist")
+	ErrBelowMinValue                                     = errors.New("below the min transaction value")
+	ErrInvalidTxFilterValue                              = errors.New("invalid transaction filter value")
+	ErrInvalidBatchPayloadItems                          = errors.New("invalid batch payload items count")
+	ErrInvalidBatchItemValue                             = errors.New("invalid batch item value")
+	ErrBatchTransactionAddressNotEqual                   = errors.New("batch transaction from-address not equal to to-address")
+	ErrValidityWindowNotActivated                        = errors.New("transaction validity window is not activated")
+	ErrTransactionExpired                                = errors.New("transaction is expired")
+	ErrUnknownFork                                       = errors.New("unknown fork in genesis fork schedule")
+	ErrDuplicatedFork                                    = errors.New("duplicated fork in genesis fork schedule")
+	ErrInvalidGenesisBlockInterval                       = errors.New("invalid block interval in genesis, should be greater than 0")
+	ErrInvalidGenesisDynastyInterval                     = errors.New("invalid dynasty interval in genesis, should be a multiple of block interval and contain a slot for each validator")
+	ErrInvalidGenesisDynastySize                         = errors.New("invalid dynasty size in genesis, should be greater than 0")
+	ErrInvalidGenesisSafeSize                            = errors.New("invalid safe size in genesis, should be in (0, dynasty size]")
+	ErrInvalidGenesisConsensusSize                       = errors.New("invalid consensus size in genesis, should be in (dynasty size*2/3, dynasty size]")
+	ErrInvalidGenesisBurnPercent                         = errors.New("invalid double sign burn percent in genesis, should be in [0, 100]")
+	ErrInvalidGenesisCandidateDeposit                    = errors.New("invalid candidate deposit in genesis")
+	ErrInvalidGenesisBlockGasLimit                       = errors.New("invalid block gas limit in genesis, should be at least the max gas of a transaction")
+	ErrInvalidGenesisBlockSizeLimit                      = errors.New("invalid block size limit in genesis, should be at least 128KB")
+	ErrInvalidGenesisChainID                             = errors.New("invalid chain id in genesis, should be greater than 0")
+	ErrDuplicatedGenesisAddress                          = errors.New("duplicated address in genesis dynasty or token distribution")
+	ErrInvalidGenesisTokenDistribution                   = errors.New("invalid token distribution in genesis, values should be positive and the total fit in uint128")
+	ErrInvalidEvidence                                   = errors.New("invalid double sign evidence")
+	ErrInvalidEvidenceSigner                             = errors.New("double sign evidence blocks are signed by different miners")
+	ErrInvalidEvidenceProposer                           = errors.New("double sign evidence blocks are not signed by the proposer of the slot")
+	ErrDuplicatedEvidence                                = errors.New("double sign evidence has been submitted")
+	ErrFinalizeNotCanonicalBlock                         = errors.New("cannot finalize a block not on the canonical chain")
+	ErrUnknownForkChoiceRule                             = errors.New("unknown fork choice rule")
+	ErrInvalidStakeValue                                 = errors.New("invalid delegate stake value")
+	ErrNoStakeToUnbond                                   = errors.New("no bonded stake to unbond")
+	ErrInvalidCommission                                 = errors.New("invalid candidate commission, should be in [0, 100]")
+	ErrNoRewardToClaim                                   = errors.New("no reward to claim")
+	ErrValidatorNotJailed                                = errors.New("validator is not jailed")
+	ErrValidatorStillJailed                              = errors.New("validator is still jailed")
+	ErrUnknownGovernedParam                              = errors.New("unknown governed param")
+	ErrInvalidGovernedParamValue                         = errors.New("invalid governed param value")
+	ErrInvalidActivationHeight                           = errors.New("proposal activation height should be after the voting period")
+	ErrProposalNotFound                                  = errors.New("proposal not found")
+	ErrProposalVotingClosed                              = errors.New("proposal voting is closed")
+	ErrNoStakeToGovern                                   = errors.New("no bonded stake to propose or vote")
+	ErrTooManyProposals                                  = errors.New("too many proposals end voting at the height")
+	ErrInvalidProposalPayloadAction                      = errors.New("invalid transaction proposal payload action")
+	ErrInvalidCandidateMetadata                          = errors.New("invalid candidate metadata, name should be at most 64 bytes and url, endpoint at most 256 bytes")
+	ErrExceedBlockGasLimit                               = errors.New("transaction exceeds the block gas limit")
+	ErrExceedBlockSizeLimit                              = errors.New("transaction exceeds the block size limit")
+	ErrTxExceedBlockGasLimit                             = errors.New("transaction gas limit exceeds the block gas limit")
+	ErrTxExceedBlockSizeLimit                            = errors.New("transaction is larger than the block size limit")
+	ErrBlockWithoutChain                                 = errors.New("block is not linked to a chain")
+	ErrInvalidBlockGasUsed                               = errors.New("invalid block gas used")
+	ErrInvalidBlockReceiptsRoot                          = errors.New("invalid block receipts root hash")
+	ErrInvalidBlockLogsBloom                             = errors.New("invalid block logs bloom")
+	ErrInvalidLogsRange                                  = errors.New("invalid logs height range")
+	ErrExceedMaxLogsRange                                = errors.New("logs height range exceeds the max range")
+	ErrExceedMaxLogsRangeWithoutBloom                    = errors.New("logs height range before the logs bloom fork exceeds the max range")
+	ErrMissingDeveloperRewarder                          = errors.New("developer rewarder of the chain is missing")
+	ErrReceiptNotFound                                   = errors.New("receipt not found")
 )
 
 // Default gas count
@@ -151,6 +252,18 @@ type Consensus interface {
 	ForkChoice() error
 }
 
+// DynastyElector tallies the weights of candidates to elect the next dynasty,
+// candidates are elected by votes if no elector is set.
+type DynastyElector interface {
+	Tally(context *DynastyContext) (map[string]*util.Uint128, error)
+}
+
+// DeveloperRewarder computes the rewards issued to contract developers in a block,
+// the rewards are minted to the addresses before the txs are executed.
+type DeveloperRewarder interface {
+	Rewards(block *Block) (map[string]*util.Uint128, error)
+}
+
 // SyncService interface of sync service
 type SyncService interface {
 	Start()
@@ -0,0 +1,353 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dip
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/dip/pb"
+	"github.com/nebulasio/go-nebulas/nr"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultWindow is the default count of blocks in a reward period.
+const DefaultWindow = 1000
+
+// Errors
+var (
+	ErrInvalidReward       = errors.New("invalid dip reward")
+	ErrPeriodNotStarted    = errors.New("dip period not started yet")
+	ErrMissingPeriodBlocks = errors.New("missing blocks of dip period")
+)
+
+// DIP Developer Incentive Protocol, the developers of contracts are rewarded
+// by the nebulas rank of the distinct addresses calling their contracts.
+// Periods are the same as nr periods, the rewards of a period are issued
+// in the first block of the next period.
+type DIP struct {
+	quitCh chan bool
+
+	chain  *core.BlockChain
+	events *core.EventSubscriber
+	window uint64
+	reward *util.Uint128
+
+	rewards *lru.Cache // period tail block hash -> rewards
+}
+
+// NewDIP create a new DIP.
+func NewDIP(chain *core.BlockChain, conf *corepb.GenesisConsensusDip) (*DIP, error) {
+	window := conf.Window
+	if window == 0 {
+		window = DefaultWindow
+	}
+	reward, ok := util.NewUint128().FromString(conf.Reward)
+	if !ok || reward.Sign() < 0 || reward.Validate() != nil {
+		return nil, ErrInvalidReward
+	}
+	rewards, _ := lru.New(16)
+	return &DIP{
+		quitCh:  make(chan bool, 1),
+		chain:   chain,
+		events:  core.NewEventSubscriber(16, []string{core.TopicLinkBlock}),
+		window:  window,
+		reward:  reward,
+		rewards: rewards,
+	}, nil
+}
+
+// Start start dip service.
+func (d *DIP) Start() {
+	logging.CLog().Info("Starting DIP Service...")
+	d.chain.EventEmitter().Register(d.events)
+	go d.loop()
+}
+
+// Stop stop dip service.
+func (d *DIP) Stop() {
+	logging.CLog().Info("Stopping DIP Service...")
+	d.chain.EventEmitter().Deregister(d.events)
+	d.quitCh <- true
+}
+
+func (d *DIP) loop() {
+	logging.CLog().Info("Started DIP Service.")
+	for {
+		select {
+		case <-d.events.EventChan():
+			d.precompute()
+		case <-d.quitCh:
+			logging.CLog().Info("Stopped DIP Service.")
+			return
+		}
+	}
+}
+
+// precompute computes the rewards of a period once its last block becomes the tail,
+// so the block paying the rewards loads them instead of computing them in its execution.
+func (d *DIP) precompute() {
+	tail := d.chain.TailBlock()
+	period, ok := d.payoutPeriod(tail.Height() + 1)
+	if !ok {
+		return
+	}
+	if _, err := d.loadOrCompute(tail, period); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"period": period,
+			"tail":   tail,
+			"err":    err,
+		}).Error("Failed to compute dip rewards.")
+	}
+}
+
+// Window returns the count of blocks in a reward period.
+func (d *DIP) Window() uint64 {
+	return d.window
+}
+
+// payoutPeriod returns the period rewarded in the block at height.
+func (d *DIP) payoutPeriod(height uint64) (uint64, bool) {
+	if height < d.window+2 || (height-2)%d.window != 0 {
+		return 0, false
+	}
+	return (height-2)/d.window - 1, true
+}
+
+// Rewards implements core.DeveloperRewarder.
+func (d *DIP) Rewards(block *core.Block) (map[string]*util.Uint128, error) {
+	period, ok := d.payoutPeriod(block.Height())
+	if !ok {
+		return nil, nil
+	}
+	tail := d.chain.GetBlock(block.ParentHash())
+	if tail == nil {
+		return nil, ErrMissingPeriodBlocks
+	}
+	data, err := d.loadOrCompute(tail, period)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make(map[string]*util.Uint128)
+	for _, item := range data.Items {
+		rewards[item.Developer] = util.NewUint128FromString(item.Reward)
+	}
+	return rewards, nil
+}
+
+// Reward returns the rewards of the period on the canonical chain, the
+// rewards of the unfinished period are estimated from the blocks so far.
+func (d *DIP) Reward(period uint64) (*dippb.DIPReward, error) {
+	start, end := nr.PeriodHeights(period, d.window)
+	tail := d.chain.TailBlock()
+	if tail.Height() < start {
+		return nil, ErrPeriodNotStarted
+	}
+	if tail.Height() < end {
+		data, err := d.computePeriod(tail, period, tail.Height()-start+1)
+		if err != nil {
+			return nil, err
+		}
+		data.Pending = true
+		return data, nil
+	}
+
+	tail = d.chain.GetBlockOnCanonicalChainByHeight(end)
+	if tail == nil {
+		return nil, ErrMissingPeriodBlocks
+	}
+	return d.loadOrCompute(tail, period)
+}
+
+func rewardKey(tail *core.Block) []byte {
+	return append([]byte("dip_"), tail.Hash()...)
+}
+
+func (d *DIP) loadOrCompute(tail *core.Block, period uint64) (*dippb.DIPReward, error) {
+	if v, ok := d.rewards.Get(tail.Hash().Hex()); ok {
+		return v.(*dippb.DIPReward), nil
+	}
+
+	data := new(dippb.DIPReward)
+	bytes, err := d.chain.Storage().Get(rewardKey(tail))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		if err := proto.Unmarshal(bytes, data); err != nil {
+			return nil, err
+		}
+	} else {
+		if data, err = d.computePeriod(tail, period, d.window); err != nil {
+			return nil, err
+		}
+		if bytes, err = proto.Marshal(data); err != nil {
+			return nil, err
+		}
+		if err := d.chain.Storage().Put(rewardKey(tail), bytes); err != nil {
+			return nil, err
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"period":     period,
+			"tail":       tail,
+			"developers": len(data.Items),
+		}).Info("Computed dip rewards.")
+	}
+	d.rewards.Add(tail.Hash().Hex(), data)
+	return data, nil
+}
+
+// computePeriod computes the rewards of the period from count blocks ending at tail.
+func (d *DIP) computePeriod(tail *core.Block, period, count uint64) (*dippb.DIPReward, error) {
+	calls, err := d.periodCalls(tail, count)
+	if err != nil {
+		return nil, err
+	}
+	usage := collectUsage(calls)
+	graph, err := nr.CollectGraph(d.chain, tail, int(count))
+	if err != nil {
+		return nil, err
+	}
+
+	developers := make(map[string]string)
+	for contract := range usage {
+		addr, err := core.AddressParse(contract)
+		if err != nil {
+			return nil, err
+		}
+		developer, err := tail.ContractDeployer(addr)
+		if err != nil {
+			return nil, err
+		}
+		developers[contract] = developer.String()
+	}
+	return d.compute(period, usage, nr.Rank(graph), developers), nil
+}
+
+// periodCalls returns the contract calls of count blocks ending at tail, the genesis block is excluded.
+func (d *DIP) periodCalls(tail *core.Block, count uint64) ([]core.ContractCalls, error) {
+	var calls []core.ContractCalls
+	block := tail
+	for i := uint64(0); i < count && !core.CheckGenesisBlock(block); i++ {
+		blockCalls, err := block.ContractCalls()
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, blockCalls)
+		block = d.chain.GetBlock(block.ParentHash())
+		if block == nil {
+			return nil, ErrMissingPeriodBlocks
+		}
+	}
+	return calls, nil
+}
+
+// collectUsage collects the distinct callers of each contract from the contract calls.
+func collectUsage(calls []core.ContractCalls) map[string]map[string]bool {
+	usage := make(map[string]map[string]bool)
+	for _, blockCalls := range calls {
+		for _, call := range blockCalls {
+			callers, ok := usage[call.Contract.String()]
+			if !ok {
+				callers = make(map[string]bool)
+				usage[call.Contract.String()] = callers
+			}
+			callers[call.Caller.String()] = true
+		}
+	}
+	return usage
+}
+
+// compute splits the reward of the period between the developers by the weights of their
+// contracts, the weight of a contract is the sum of the nebulas rank of its distinct callers.
+// The rewards are rounded down, so they never exceed the reward of the period.
+func (d *DIP) compute(period uint64, usage map[string]map[string]bool, scores map[string]*util.Uint128, developers map[string]string) *dippb.DIPReward {
+	start, end := nr.PeriodHeights(period, d.window)
+	data := &dippb.DIPReward{
+		Period:      period,
+		Window:      d.window,
+		StartHeight: start,
+		EndHeight:   end,
+		Total:       d.reward.String(),
+	}
+
+	contracts := make([]string, 0, len(usage))
+	for contract := range usage {
+		contracts = append(contracts, contract)
+	}
+	sort.Strings(contracts)
+
+	items := make(map[string]*dippb.DIPRewardItem)
+	weights := make(map[string]*big.Int)
+	total := new(big.Int)
+	for _, contract := range contracts {
+		weight := new(big.Int)
+		for caller := range usage[contract] {
+			if score, ok := scores[caller]; ok {
+				weight.Add(weight, score.Int)
+			}
+		}
+		if weight.Sign() == 0 {
+			continue
+		}
+		developer := developers[contract]
+		item, ok := items[developer]
+		if !ok {
+			item = &dippb.DIPRewardItem{Developer: developer}
+			items[developer] = item
+			weights[developer] = new(big.Int)
+		}
+		item.Contracts = append(item.Contracts, contract)
+		weights[developer].Add(weights[developer], weight)
+		total.Add(total, weight)
+	}
+
+	for developer, item := range items {
+		weight := weights[developer]
+		reward := new(big.Int).Mul(d.reward.Int, weight)
+		reward.Div(reward, total)
+		item.Weight = weight.String()
+		item.Reward = reward.String()
+		data.Items = append(data.Items, item)
+	}
+	sort.Slice(data.Items, func(i, j int) bool {
+		return data.Items[i].Developer < data.Items[j].Developer
+	})
+	return data
+}
+
+// Find returns the item of the developer in the rewards, nil if not rewarded.
+func Find(data *dippb.DIPReward, developer string) *dippb.DIPRewardItem {
+	i := sort.Search(len(data.Items), func(i int) bool {
+		return data.Items[i].Developer >= developer
+	})
+	if i < len(data.Items) && data.Items[i].Developer == developer {
+		return data.Items[i]
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dip
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/dip/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockAddress(name string) *core.Address {
+	addr, _ := core.NewContractAddressFromHash(hash.Sha3256([]byte(name)))
+	return addr
+}
+
+func TestNewDIP(t *testing.T) {
+	d, err := NewDIP(nil, &corepb.GenesisConsensusDip{Reward: "1000"})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(DefaultWindow), d.Window())
+
+	_, err = NewDIP(nil, &corepb.GenesisConsensusDip{Reward: "-1"})
+	assert.Equal(t, ErrInvalidReward, err)
+	_, err = NewDIP(nil, &corepb.GenesisConsensusDip{Reward: "nas"})
+	assert.Equal(t, ErrInvalidReward, err)
+}
+
+func TestDIP_payoutPeriod(t *testing.T) {
+	d, _ := NewDIP(nil, &corepb.GenesisConsensusDip{Window: 10, Reward: "1000"})
+	tests := []struct {
+		height uint64
+		period uint64
+		payout bool
+	}{
+		{2, 0, false},
+		{11, 0, false},
+		{12, 0, true},
+		{13, 0, false},
+		{22, 1, true},
+		{102, 9, true},
+	}
+	for _, tt := range tests {
+		period, payout := d.payoutPeriod(tt.height)
+		assert.Equal(t, tt.payout, payout, "height %d", tt.height)
+		assert.Equal(t, tt.period, period, "height %d", tt.height)
+	}
+}
+
+func TestFind(t *testing.T) {
+	data := &dippb.DIPReward{
+		Items: []*dippb.DIPRewardItem{
+			{Developer: "a", Reward: "10"},
+			{Developer: "c", Reward: "20"},
+		},
+	}
+	assert.Equal(t, "10", Find(data, "a").Reward)
+	assert.Nil(t, Find(data, "b"))
+	assert.Nil(t, Find(data, "d"))
+}
+
+func TestCollectUsage(t *testing.T) {
+	a, b, alice, bob := mockAddress("a"), mockAddress("b"), mockAddress("alice"), mockAddress("bob")
+	usage := collectUsage([]core.ContractCalls{
+		{{Contract: a, Caller: alice}, {Contract: a, Caller: alice}},
+		{},
+		{{Contract: a, Caller: bob}, {Contract: b, Caller: alice}},
+	})
+	assert.Equal(t, map[string]map[string]bool{
+		a.String(): {alice.String(): true, bob.String(): true},
+		b.String(): {alice.String(): true},
+	}, usage)
+}
+
+func TestDIP_compute(t *testing.T) {
+	d, _ := NewDIP(nil, &corepb.GenesisConsensusDip{Window: 10, Reward: "1000"})
+	usage := map[string]map[string]bool{
+		"a": {"alice": true, "bob": true},
+		"b": {"alice": true},
+		"c": {"carol": true},
+		"d": {"dave": true},
+	}
+	scores := map[string]*util.Uint128{
+		"alice": util.NewUint128FromInt(1),
+		"bob":   util.NewUint128FromInt(2),
+		"dave":  util.NewUint128(),
+	}
+	developers := map[string]string{"a": "x", "b": "y", "c": "y", "d": "z"}
+
+	data := d.compute(1, usage, scores, developers)
+	assert.Equal(t, uint64(1), data.Period)
+	assert.Equal(t, uint64(12), data.StartHeight)
+	assert.Equal(t, uint64(21), data.EndHeight)
+	assert.Equal(t, "1000", data.Total)
+
+	// the contracts without scored callers are not rewarded, the rewards are rounded down.
+	assert.Equal(t, []*dippb.DIPRewardItem{
+		{Developer: "x", Contracts: []string{"a"}, Weight: "3", Reward: "750"},
+		{Developer: "y", Contracts: []string{"b"}, Weight: "1", Reward: "250"},
+	}, data.Items)
+
+	scores["carol"] = util.NewUint128FromInt(2)
+	data = d.compute(1, usage, scores, developers)
+	assert.Equal(t, []*dippb.DIPRewardItem{
+		{Developer: "x", Contracts: []string{"a"}, Weight: "3", Reward: "500"},
+		{Developer: "y", Contracts: []string{"b", "c"}, Weight: "3", Reward: "500"},
+	}, data.Items)
+
+	scores["dave"] = util.NewUint128FromInt(1)
+	data = d.compute(1, usage, scores, developers)
+	assert.Equal(t, "428", data.Items[0].Reward)
+	assert.Equal(t, "428", data.Items[1].Reward)
+	assert.Equal(t, "142", data.Items[2].Reward)
+
+	data = d.compute(1, map[string]map[string]bool{}, scores, developers)
+	assert.Empty(t, data.Items)
+}
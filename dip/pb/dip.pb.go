@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dip.proto
+
+/*
+Package dippb is a generated protocol buffer package.
+
+It is generated from these files:
+	dip.proto
+
+It has these top-level messages:
+	DIPReward
+	DIPRewardItem
+*/
+package dippb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// DIPReward is the developer rewards of a period.
+type DIPReward struct {
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// heights of the first and last blocks in the period
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total rewards issued in the period
+	Total string `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	// sorted by developer
+	Items []*DIPRewardItem `protobuf:"bytes,6,rep,name=items" json:"items,omitempty"`
+	// the period is not finished, rewards are estimated from the blocks so far
+	Pending bool `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *DIPReward) Reset()                    { *m = DIPReward{} }
+func (m *DIPReward) String() string            { return proto.CompactTextString(m) }
+func (*DIPReward) ProtoMessage()               {}
+func (*DIPReward) Descriptor() ([]byte, []int) { return fileDescriptorDip, []int{0} }
+
+func (m *DIPReward) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *DIPReward) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *DIPReward) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DIPReward) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *DIPReward) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *DIPReward) GetItems() []*DIPRewardItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *DIPReward) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+type DIPRewardItem struct {
+	Developer string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	// contracts of the developer called in the period
+	Contracts []string `protobuf:"bytes,2,rep,name=contracts" json:"contracts,omitempty"`
+	// sum of the nebulas rank of distinct callers of the contracts
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Reward string `protobuf:"bytes,4,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (m *DIPRewardItem) Reset()                    { *m = DIPRewardItem{} }
+func (m *DIPRewardItem) String() string            { return proto.CompactTextString(m) }
+func (*DIPRewardItem) ProtoMessage()               {}
+func (*DIPRewardItem) Descriptor() ([]byte, []int) { return fileDescriptorDip, []int{1} }
+
+func (m *DIPRewardItem) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *DIPRewardItem) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *DIPRewardItem) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+func (m *DIPRewardItem) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DIPReward)(nil), "dippb.DIPReward")
+	proto.RegisterType((*DIPRewardItem)(nil), "dippb.DIPRewardItem")
+}
+
+func init() { proto.RegisterFile("dip.proto", fileDescriptorDip) }
+
+var fileDescriptorDip = []byte{
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0xe5, 0xa6, 0x49, 0xf1, 0x15, 0x16, 0xab, 0x42, 0x1e, 0x40, 0x32, 0x9d, 0x22, 0x86,
+	0x0c, 0xf0, 0x0a, 0x0c, 0x74, 0x43, 0x7e, 0x01, 0x94, 0xd6, 0xa7, 0xd6, 0x52, 0x6b, 0x5b, 0xce,
+	0x89, 0x0c, 0x3c, 0x29, 0x6f, 0x83, 0x72, 0x49, 0x5b, 0x75, 0xfc, 0xbe, 0xff, 0x2c, 0xdf, 0x7f,
+	0x20, 0x9d, 0x4f, 0x4d, 0xca, 0x91, 0xa2, 0x2a, 0x9d, 0x4f, 0x69, 0xbb, 0xfe, 0x13, 0x20, 0x3f,
+	0x36, 0x5f, 0x16, 0xfb, 0x36, 0x3b, 0xf5, 0x08, 0x55, 0xc2, 0xec, 0xa3, 0xd3, 0xc2, 0x88, 0x7a,
+	0x6e, 0x27, 0x1a, 0x7c, 0xef, 0x83, 0x8b, 0xbd, 0x9e, 0x8d, 0x7e, 0x24, 0xf5, 0x02, 0xf7, 0x1d,
+	0xb5, 0x99, 0xbe, 0x0f, 0xe8, 0xf7, 0x07, 0xd2, 0x05, 0xa7, 0x4b, 0x76, 0x9f, 0xac, 0xd4, 0x33,
+	0x00, 0x06, 0x77, 0x1e, 0x98, 0xf3, 0x80, 0xc4, 0xe0, 0xa6, 0x78, 0x05, 0x25, 0x45, 0x6a, 0x8f,
+	0xba, 0x34, 0xa2, 0x96, 0x76, 0x04, 0xf5, 0x0a, 0xa5, 0x27, 0x3c, 0x75, 0xba, 0x32, 0x45, 0xbd,
+	0x7c, 0x5b, 0x35, 0xbc, 0x6c, 0x73, 0x59, 0x74, 0x43, 0x78, 0xb2, 0xe3, 0x88, 0xd2, 0xb0, 0x48,
+	0x18, 0x9c, 0x0f, 0x7b, 0xbd, 0x30, 0xa2, 0xbe, 0xb3, 0x67, 0x5c, 0xff, 0xc2, 0xc3, 0xcd, 0x0b,
+	0xf5, 0x04, 0xd2, 0xe1, 0x0f, 0x1e, 0x63, 0xc2, 0xcc, 0x0d, 0xa5, 0xbd, 0x8a, 0x21, 0xdd, 0xc5,
+	0x40, 0xb9, 0xdd, 0x51, 0xa7, 0x67, 0xa6, 0x18, 0xd2, 0x8b, 0xe0, 0x13, 0x5c, 0x4b, 0x4a, 0x3b,
+	0xd1, 0xe0, 0x33, 0xff, 0xc0, 0xdd, 0xa4, 0x9d, 0x68, 0x5b, 0xf1, 0x99, 0xdf, 0xff, 0x07, 0x00,
+	0x87, 0xc5, 0x5f, 0x9c, 0x73, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,580 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// storage: key -> value
+// light_header_ + block hash -> signed header
+// light_height_ + height -> block hash on the canonical chain
+// light_dynasty_ + dynasty root -> dynasty members
+// light_tail -> block hash of the tail
+const (
+	headerPrefix  = "light_header_"
+	heightPrefix  = "light_height_"
+	dynastyPrefix = "light_dynasty_"
+	tailKey       = "light_tail"
+)
+
+func headerKey(hash byteutils.Hash) []byte {
+	return append([]byte(headerPrefix), hash...)
+}
+
+func heightKey(height uint64) []byte {
+	return append([]byte(heightPrefix), byteutils.FromUint64(height)...)
+}
+
+func dynastyKey(root byteutils.Hash) []byte {
+	return append([]byte(dynastyPrefix), root...)
+}
+
+// Client syncs and verifies the block headers only, and answers the queries of the state
+// by verifying the merkle proofs from full peers against the state root in the headers.
+//
+// A header is accepted if it links to a known header, is not dated after the next slot,
+// is signed by the proposer of its slot in its dynasty, and its dynasty root is committed in
+// its parent as the dynasty or the next dynasty. The dynasty elected after a gap of more than
+// one dynasty interval without blocks can not be verified without the state, it is accepted
+// only if committed in the parent or the header is a configured checkpoint. The absence of an
+// account or a storage key can not be proved either.
+//
+// The dynasties sent by peers are kept in a bounded cache, only those of accepted headers are saved.
+type Client struct {
+	quitCh chan bool
+
+	chain       *core.BlockChain
+	ns          net.Service
+	storage     storage.Storage
+	checkpoints map[byteutils.HexHash]bool
+	dynasties   *lru.Cache
+	now         func() int64
+
+	receiveCh chan net.Message
+
+	mu       sync.RWMutex
+	tail     *lightpb.SignedHeader
+	syncFrom uint64
+
+	nextID  uint64
+	pending map[uint64]chan *lightpb.Proof
+}
+
+// dynastyEntry is a dynasty in the cache, saved if an accepted header is signed in it.
+type dynastyEntry struct {
+	dynasty *lightpb.Dynasty
+	members *trie.BatchTrie
+	saved   bool
+}
+
+// NewClient create Client instance.
+func NewClient(neb Neblet) (*Client, error) {
+	c := &Client{
+		quitCh:      make(chan bool, 1),
+		chain:       neb.BlockChain(),
+		ns:          neb.NetService(),
+		storage:     neb.Storage(),
+		checkpoints: make(map[byteutils.HexHash]bool),
+		now:         func() int64 { return time.Now().Unix() },
+		receiveCh:   make(chan net.Message, 128),
+		pending:     make(map[uint64]chan *lightpb.Proof),
+	}
+	var err error
+	if c.dynasties, err = lru.New(MaxDynastiesInMemory); err != nil {
+		return nil, err
+	}
+	if conf := neb.Config().GetChain(); conf != nil {
+		for _, checkpoint := range conf.LightCheckpoints {
+			hash, err := byteutils.FromHex(checkpoint)
+			if err != nil || len(hash) != core.BlockHashLength {
+				return nil, ErrInvalidCheckpoint
+			}
+			c.checkpoints[byteutils.Hash(hash).Hex()] = true
+		}
+	}
+	if err := c.loadTail(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Start start light client.
+func (c *Client) Start() {
+	logging.CLog().Info("Starting Light Client...")
+	c.ns.Register(c.subscribers()...)
+	go c.loop()
+}
+
+// Stop stop light client.
+func (c *Client) Stop() {
+	logging.CLog().Info("Stopping Light Client...")
+	c.ns.Deregister(c.subscribers()...)
+	c.quitCh <- true
+}
+
+func (c *Client) subscribers() []*net.Subscriber {
+	return []*net.Subscriber{
+		net.NewSubscriber(c, c.receiveCh, false, MessageTypeHeaders, net.MessageWeightZero),
+		net.NewSubscriber(c, c.receiveCh, false, MessageTypeProof, net.MessageWeightZero),
+	}
+}
+
+func (c *Client) loop() {
+	logging.CLog().Info("Started Light Client.")
+	ticker := time.NewTicker(SyncInterval)
+	defer ticker.Stop()
+	c.requestHeaders()
+	for {
+		select {
+		case <-ticker.C:
+			c.requestHeaders()
+		case msg := <-c.receiveCh:
+			switch msg.MessageType() {
+			case MessageTypeHeaders:
+				headers := new(lightpb.Headers)
+				if err := proto.Unmarshal(msg.Data(), headers); err != nil {
+					continue
+				}
+				more, err := c.onHeaders(headers)
+				if err != nil {
+					logging.VLog().WithFields(logrus.Fields{
+						"from": msg.MessageFrom(),
+						"err":  err,
+					}).Debug("Failed to sync headers.")
+					continue
+				}
+				if more {
+					c.requestHeaders()
+				}
+			case MessageTypeProof:
+				proof := new(lightpb.Proof)
+				if err := proto.Unmarshal(msg.Data(), proof); err != nil {
+					continue
+				}
+				c.onProof(proof)
+			}
+		case <-c.quitCh:
+			logging.CLog().Info("Stopped Light Client.")
+			return
+		}
+	}
+}
+
+func (c *Client) loadTail() error {
+	hash, err := c.storage.Get([]byte(tailKey))
+	if err == nil {
+		tail, err := c.getHeader(hash)
+		if err != nil {
+			return err
+		}
+		c.tail = tail
+		return nil
+	}
+	if err != storage.ErrKeyNotFound {
+		return err
+	}
+
+	genesis := c.chain.GenesisBlock()
+	evidence, err := core.NewBlockEvidence(genesis)
+	if err != nil {
+		return err
+	}
+	header := &lightpb.SignedHeader{Header: evidence.Header, Height: genesis.Height()}
+	if err := c.putHeader(header); err != nil {
+		return err
+	}
+	dynasty, err := trie.NewBatchTrie(genesis.DposContext().DynastyRoot, c.chain.Storage())
+	if err != nil {
+		return err
+	}
+	members, err := core.TraverseDynasty(dynasty)
+	if err != nil {
+		return err
+	}
+	pbDynasty := &lightpb.Dynasty{}
+	for _, member := range members {
+		pbDynasty.Members = append(pbDynasty.Members, member)
+	}
+	entry, err := c.cacheDynasty(pbDynasty, false)
+	if err != nil {
+		return err
+	}
+	if err := c.saveDynasty(entry); err != nil {
+		return err
+	}
+	return c.setTail(header)
+}
+
+func (c *Client) getHeader(hash byteutils.Hash) (*lightpb.SignedHeader, error) {
+	bytes, err := c.storage.Get(headerKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	header := new(lightpb.SignedHeader)
+	if err := proto.Unmarshal(bytes, header); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (c *Client) putHeader(header *lightpb.SignedHeader) error {
+	bytes, err := proto.Marshal(header)
+	if err != nil {
+		return err
+	}
+	return c.storage.Put(headerKey(header.Header.Hash), bytes)
+}
+
+// cacheDynasty keeps the members in memory under the root of their dynasty trie.
+func (c *Client) cacheDynasty(dynasty *lightpb.Dynasty, saved bool) (*dynastyEntry, error) {
+	members, err := dynastyTrie(dynasty.Members)
+	if err != nil {
+		return nil, err
+	}
+	entry := &dynastyEntry{dynasty: dynasty, members: members, saved: saved}
+	c.dynasties.Add(byteutils.Hash(members.RootHash()).Hex(), entry)
+	return entry, nil
+}
+
+// saveDynasty saves the members under the root of their dynasty trie.
+func (c *Client) saveDynasty(entry *dynastyEntry) error {
+	if entry.saved {
+		return nil
+	}
+	bytes, err := proto.Marshal(entry.dynasty)
+	if err != nil {
+		return err
+	}
+	if err := c.storage.Put(dynastyKey(entry.members.RootHash()), bytes); err != nil {
+		return err
+	}
+	entry.saved = true
+	return nil
+}
+
+func (c *Client) getDynasty(root byteutils.Hash) (*dynastyEntry, error) {
+	if entry, ok := c.dynasties.Get(root.Hex()); ok {
+		return entry.(*dynastyEntry), nil
+	}
+	bytes, err := c.storage.Get(dynastyKey(root))
+	if err == storage.ErrKeyNotFound {
+		return nil, ErrUnknownDynasty
+	}
+	if err != nil {
+		return nil, err
+	}
+	dynasty := new(lightpb.Dynasty)
+	if err := proto.Unmarshal(bytes, dynasty); err != nil {
+		return nil, err
+	}
+	return c.cacheDynasty(dynasty, true)
+}
+
+// setTail switches the canonical chain to the header and its ancestors.
+func (c *Client) setTail(tail *lightpb.SignedHeader) error {
+	header := tail
+	for {
+		hash, err := c.storage.Get(heightKey(header.Height))
+		if err == nil && byteutils.Hash(hash).Equals(header.Header.Hash) {
+			break
+		}
+		if err := c.storage.Put(heightKey(header.Height), header.Header.Hash); err != nil {
+			return err
+		}
+		if c.chain.GenesisBlock().Hash().Equals(header.Header.Hash) {
+			break
+		}
+		if header, err = c.getHeader(header.Header.ParentHash); err != nil {
+			return err
+		}
+	}
+	if err := c.storage.Put([]byte(tailKey), tail.Header.Hash); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.tail = tail
+	c.mu.Unlock()
+	return nil
+}
+
+// Tail returns the latest verified header on the canonical chain.
+func (c *Client) Tail() *lightpb.SignedHeader {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.tail
+}
+
+// GetHeaderByHeight returns the verified header on the canonical chain, the tail if height is 0.
+func (c *Client) GetHeaderByHeight(height uint64) (*lightpb.SignedHeader, error) {
+	if height == 0 {
+		return c.Tail(), nil
+	}
+	hash, err := c.storage.Get(heightKey(height))
+	if err == storage.ErrKeyNotFound {
+		return nil, ErrHeaderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.getHeader(hash)
+}
+
+func (c *Client) requestHeaders() {
+	c.mu.RLock()
+	from := c.tail.Height + 1
+	if c.syncFrom > 0 {
+		from = c.syncFrom
+	}
+	c.mu.RUnlock()
+
+	data, err := proto.Marshal(&lightpb.GetHeaders{From: from, Count: MaxHeadersPerRequest})
+	if err != nil {
+		return
+	}
+	c.ns.SendMessageToPeers(MessageTypeGetHeaders, data, net.MessagePriorityNormal, &net.RandomPeerFilter{})
+}
+
+// onHeaders verifies and saves the headers, the longest chain is the canonical chain.
+// It returns true if the peer may have more headers.
+func (c *Client) onHeaders(headers *lightpb.Headers) (bool, error) {
+	if len(headers.Headers) == 0 {
+		return false, nil
+	}
+	if len(headers.Dynasties) > len(headers.Headers) || len(headers.Dynasties) > MaxDynastiesInMemory {
+		return false, ErrTooManyDynasties
+	}
+	for _, dynasty := range headers.Dynasties {
+		if _, err := c.cacheDynasty(dynasty, false); err != nil {
+			return false, err
+		}
+	}
+
+	first := headers.Headers[0]
+	if first.Header == nil {
+		return false, core.ErrInvalidEvidence
+	}
+	parent, err := c.getHeader(first.Header.ParentHash)
+	if err == storage.ErrKeyNotFound {
+		// the peer is on a fork from an earlier height, step back to find the common ancestor.
+		from := c.chain.GenesisBlock().Height() + 1
+		if first.Height > from+MaxHeadersPerRequest {
+			from = first.Height - MaxHeadersPerRequest
+		}
+		c.mu.Lock()
+		c.syncFrom = from
+		c.mu.Unlock()
+		return true, ErrInvalidHeaderParent
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, header := range headers.Headers {
+		if err := c.verifyHeader(parent, header); err != nil {
+			return false, err
+		}
+		dynasty, err := c.getDynasty(header.Header.DposContext.DynastyRoot)
+		if err != nil {
+			return false, err
+		}
+		if err := c.saveDynasty(dynasty); err != nil {
+			return false, err
+		}
+		if err := c.putHeader(header); err != nil {
+			return false, err
+		}
+		parent = header
+	}
+
+	c.mu.Lock()
+	c.syncFrom = 0
+	longer := parent.Height > c.tail.Height
+	c.mu.Unlock()
+	if longer {
+		if err := c.setTail(parent); err != nil {
+			return false, err
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"height": parent.Height,
+			"hash":   byteutils.Hash(parent.Header.Hash).Hex(),
+		}).Info("Synced light headers.")
+	}
+	return len(headers.Headers) == MaxHeadersPerRequest, nil
+}
+
+// verifyHeader checks the header is signed by the proposer in the dynasty following its parent.
+func (c *Client) verifyHeader(parent, header *lightpb.SignedHeader) error {
+	evidence := &core.BlockEvidence{Header: header.Header}
+	for _, hash := range header.TxHashes {
+		evidence.TxHashes = append(evidence.TxHashes, hash)
+	}
+	miner, err := evidence.Signer()
+	if err != nil {
+		return err
+	}
+
+	h, p := header.Header, parent.Header
+	if !byteutils.Hash(h.ParentHash).Equals(p.Hash) || header.Height != parent.Height+1 {
+		return ErrInvalidHeaderParent
+	}
+	if h.ChainId != c.chain.ChainID() {
+		return ErrInvalidHeaderChainID
+	}
+	if h.Timestamp <= p.Timestamp {
+		return ErrInvalidHeaderTimestamp
+	}
+	params := c.chain.DposParams()
+	if h.Timestamp > c.now()+params.BlockInterval {
+		return ErrFutureHeader
+	}
+
+	root := byteutils.Hash(h.DposContext.DynastyRoot)
+	parentDynastyID := p.Timestamp / params.DynastyInterval
+	dynastyID := h.Timestamp / params.DynastyInterval
+	switch {
+	case dynastyID == parentDynastyID:
+		if !root.Equals(p.DposContext.DynastyRoot) {
+			return ErrInvalidDynastyRoot
+		}
+	case dynastyID == parentDynastyID+1:
+		if !root.Equals(p.DposContext.NextDynastyRoot) {
+			return ErrInvalidDynastyRoot
+		}
+	default:
+		// the dynasty elected after a gap is proved by the signature of the parent if it is
+		// the one committed in the parent, otherwise the header must be a trusted checkpoint.
+		if !root.Equals(p.DposContext.DynastyRoot) && !root.Equals(p.DposContext.NextDynastyRoot) &&
+			!c.checkpoints[byteutils.Hash(h.Hash).Hex()] {
+			return ErrUnprovableDynasty
+		}
+	}
+
+	dynasty, err := c.getDynasty(root)
+	if err != nil {
+		return err
+	}
+	proposer, err := params.FindProposer(h.Timestamp, dynasty.members)
+	if err != nil {
+		return err
+	}
+	if !proposer.Equals(miner.Bytes()) {
+		return ErrInvalidHeaderProposer
+	}
+	return nil
+}
+
+func (c *Client) onProof(proof *lightpb.Proof) {
+	c.mu.Lock()
+	ch, ok := c.pending[proof.Id]
+	delete(c.pending, proof.Id)
+	c.mu.Unlock()
+	if ok {
+		ch <- proof
+	}
+}
+
+// requestProof sends the request to a random peer and waits for the proof.
+func (c *Client) requestProof(header *lightpb.SignedHeader, addr *core.Address, key []byte) (*lightpb.Proof, error) {
+	ch := make(chan *lightpb.Proof, 1)
+	c.mu.Lock()
+	c.nextID++
+	id := c.nextID
+	c.pending[id] = ch
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	data, err := proto.Marshal(&lightpb.GetProof{
+		Id:        id,
+		BlockHash: header.Header.Hash,
+		Address:   addr.Bytes(),
+		Key:       key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if peers := c.ns.SendMessageToPeers(MessageTypeGetProof, data, net.MessagePriorityHigh, &net.RandomPeerFilter{}); len(peers) == 0 {
+		return nil, ErrNoPeerForProof
+	}
+
+	select {
+	case proof := <-ch:
+		switch proof.Error {
+		case "":
+			return proof, nil
+		case storage.ErrKeyNotFound.Error():
+			return nil, ErrNotFoundByPeer
+		default:
+			return nil, errors.New(proof.Error)
+		}
+	case <-time.After(ProofTimeout):
+		return nil, ErrProofTimeout
+	}
+}
+
+// GetAccount returns the account in the state of the block at the height, the tail if height is 0.
+func (c *Client) GetAccount(addr *core.Address, height uint64) (*corepb.Account, error) {
+	header, err := c.GetHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := c.requestProof(header, addr, nil)
+	if err != nil {
+		return nil, err
+	}
+	return core.VerifyAccountProof(header.Header.StateRoot, addr.Bytes(), proof.Account, proofFromProto(proof.AccountProof))
+}
+
+// GetStorage returns the value of the key in the account's storage in the state of the block at the height.
+func (c *Client) GetStorage(addr *core.Address, key []byte, height uint64) ([]byte, error) {
+	header, err := c.GetHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := c.requestProof(header, addr, key)
+	if err != nil {
+		return nil, err
+	}
+	account, err := core.VerifyAccountProof(header.Header.StateRoot, addr.Bytes(), proof.Account, proofFromProto(proof.AccountProof))
+	if err != nil {
+		return nil, err
+	}
+	if err := trie.VerifyProof(account.VarsHash, key, proof.Value, proofFromProto(proof.StorageProof)); err != nil {
+		return nil, err
+	}
+	return proof.Value, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// Light protocol messages, served by full nodes and sent by light nodes.
+const (
+	MessageTypeGetHeaders = "lightgetheaders"
+	MessageTypeHeaders    = "lightheaders"
+	MessageTypeGetProof   = "lightgetproof"
+	MessageTypeProof      = "lightproof"
+)
+
+// Light protocol constants.
+const (
+	MaxHeadersPerRequest = 128
+	MaxDynastiesInMemory = 32
+	ProofTimeout         = 10 * time.Second
+	SyncInterval         = 5 * time.Second
+)
+
+// Errors in light protocol.
+var (
+	ErrInvalidHeaderParent    = errors.New("header does not link to its parent")
+	ErrInvalidHeaderChainID   = errors.New("header has a different chain id")
+	ErrInvalidHeaderTimestamp = errors.New("header timestamp is not after its parent")
+	ErrFutureHeader           = errors.New("header timestamp is later than the next slot")
+	ErrInvalidDynastyRoot     = errors.New("header dynasty root does not follow its parent")
+	ErrUnknownDynasty         = errors.New("members of the header dynasty are unknown")
+	ErrUnprovableDynasty      = errors.New("header dynasty is neither committed by its parent nor a checkpoint")
+	ErrTooManyDynasties       = errors.New("too many dynasties sent with the headers")
+	ErrInvalidCheckpoint      = errors.New("invalid light checkpoint, should be a block hash in hex")
+	ErrInvalidHeaderProposer  = errors.New("header is not signed by the proposer of the slot")
+	ErrHeaderNotFound         = errors.New("header not found")
+	ErrNoPeerForProof         = errors.New("no peer to request the proof from")
+	ErrProofTimeout           = errors.New("timeout to receive the proof")
+	ErrNotFoundByPeer         = errors.New("not found in the state by the full peer")
+)
+
+// Neblet interface breaks cycle import dependency and hides unused services.
+type Neblet interface {
+	Config() *nebletpb.Config
+	Genesis() *corepb.Genesis
+	BlockChain() *core.BlockChain
+	NetService() net.Service
+	Storage() storage.Storage
+}
+
+func proofToProto(proof trie.MerkleProof) []*lightpb.ProofNode {
+	nodes := make([]*lightpb.ProofNode, len(proof))
+	for i, val := range proof {
+		nodes[i] = &lightpb.ProofNode{Val: val}
+	}
+	return nodes
+}
+
+func proofFromProto(nodes []*lightpb.ProofNode) trie.MerkleProof {
+	proof := make(trie.MerkleProof, len(nodes))
+	for i, node := range nodes {
+		if node != nil {
+			proof[i] = node.Val
+		}
+	}
+	return proof
+}
+
+// dynastyTrie rebuilds the dynasty trie of the members in memory.
+func dynastyTrie(members [][]byte) (*trie.BatchTrie, error) {
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	dynasty, err := trie.NewBatchTrie(nil, stor)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if _, err := dynasty.Put(member, member); err != nil {
+			return nil, err
+		}
+	}
+	return dynasty, nil
+}
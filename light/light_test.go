@@ -0,0 +1,296 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConsensus struct{}
+
+func (c *mockConsensus) Start()                    {}
+func (c *mockConsensus) Stop()                     {}
+func (c *mockConsensus) EnableMining(string) error { return nil }
+func (c *mockConsensus) DisableMining() error      { return nil }
+func (c *mockConsensus) Enable() bool              { return false }
+func (c *mockConsensus) ResumeMining()             {}
+func (c *mockConsensus) SuspendMining()            {}
+func (c *mockConsensus) Pending() bool             { return false }
+func (c *mockConsensus) ForkChoice() error         { return nil }
+
+func (c *mockConsensus) VerifyBlock(block *core.Block, parent *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c *mockConsensus) FastVerifyBlock(block *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c *mockConsensus) State() string                        { return "" }
+func (c *mockConsensus) Transitions() []*consensus.Transition { return nil }
+
+// mockNetService delivers the proof requests of the client to the server.
+type mockNetService struct {
+	server *Server
+	client *Client
+	tamper func(*lightpb.Proof)
+}
+
+func (n *mockNetService) Start() error                                        { return nil }
+func (n *mockNetService) Stop()                                               {}
+func (n *mockNetService) Node() *net.Node                                     { return nil }
+func (n *mockNetService) Register(...*net.Subscriber)                         {}
+func (n *mockNetService) Deregister(...*net.Subscriber)                       {}
+func (n *mockNetService) Broadcast(string, net.Serializable, int)             {}
+func (n *mockNetService) Relay(string, net.Serializable, int)                 {}
+func (n *mockNetService) SendMsg(string, []byte, string, int) error           { return nil }
+func (n *mockNetService) ClosePeer(string, error)                             {}
+func (n *mockNetService) BroadcastNetworkID([]byte)                           {}
+func (n *mockNetService) BuildRawMessageData([]byte, string) []byte           { return nil }
+func (n *mockNetService) SendMessageToPeer(string, []byte, int, string) error { return nil }
+
+func (n *mockNetService) SendMessageToPeers(name string, data []byte, priority int, filter net.PeerFilterAlgorithm) []string {
+	if name != MessageTypeGetProof || n.server == nil {
+		return nil
+	}
+	req := new(lightpb.GetProof)
+	if err := proto.Unmarshal(data, req); err != nil {
+		return nil
+	}
+	resp := n.server.proof(req)
+	if n.tamper != nil {
+		n.tamper(resp)
+	}
+	go n.client.onProof(resp)
+	return []string{"peer"}
+}
+
+type mockNeb struct {
+	genesis *corepb.Genesis
+	config  *nebletpb.Config
+	storage storage.Storage
+	emitter *core.EventEmitter
+	chain   *core.BlockChain
+	ns      *mockNetService
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis         { return n.genesis }
+func (n *mockNeb) Config() *nebletpb.Config         { return n.config }
+func (n *mockNeb) Storage() storage.Storage         { return n.storage }
+func (n *mockNeb) EventEmitter() *core.EventEmitter { return n.emitter }
+func (n *mockNeb) BlockChain() *core.BlockChain     { return n.chain }
+func (n *mockNeb) NetService() net.Service          { return n.ns }
+
+func mockKey() *core.Address {
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	addr, _ := core.NewAddressFromPublicKey(pubdata)
+	keystore.DefaultKS.SetKey(addr.String(), priv, []byte("passphrase"))
+	keystore.DefaultKS.Unlock(addr.String(), []byte("passphrase"), time.Hour)
+	return addr
+}
+
+func mockNeblet(t *testing.T, genesis *corepb.Genesis, keydir string, ns *mockNetService) *mockNeb {
+	stor, _ := storage.NewMemoryStorage()
+	neb := &mockNeb{
+		genesis: genesis,
+		config:  &nebletpb.Config{Chain: &nebletpb.ChainConfig{ChainId: 100, Keydir: keydir}},
+		storage: stor,
+		emitter: core.NewEventEmitter(1024),
+		ns:      ns,
+	}
+	var err error
+	neb.chain, err = core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain.SetConsensusHandler(&mockConsensus{})
+	return neb
+}
+
+// mintBlock creates the block on the parent signed by the signer, or the proposer of the slot if nil.
+func mintBlock(t *testing.T, neb *mockNeb, am *account.Manager, parent *core.Block, elapsed int64, signer *core.Address) *core.Block {
+	context, err := parent.NextDynastyContext(neb.chain, elapsed)
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(context.Proposer)
+	assert.Nil(t, err)
+	if signer == nil {
+		signer = proposer
+	}
+	block, err := core.NewBlock(neb.chain.ChainID(), signer, parent)
+	assert.Nil(t, err)
+	assert.Nil(t, block.LoadDynastyContext(context))
+	block.SetMiner(signer)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, am.SignBlock(signer, block))
+	return block
+}
+
+func signedHeader(t *testing.T, block *core.Block) *lightpb.SignedHeader {
+	evidence, err := core.NewBlockEvidence(block)
+	assert.Nil(t, err)
+	header := &lightpb.SignedHeader{Header: evidence.Header, Height: block.Height()}
+	for _, hash := range evidence.TxHashes {
+		header.TxHashes = append(header.TxHashes, hash)
+	}
+	return header
+}
+
+func TestLight(t *testing.T) {
+	keydir, err := ioutil.TempDir("", "keydir")
+	assert.Nil(t, err)
+	defer os.RemoveAll(keydir)
+
+	addrs := make([]*core.Address, 4)
+	dynasty := []string{}
+	for i := range addrs {
+		addrs[i] = mockKey()
+		dynasty = append(dynasty, addrs[i].String())
+	}
+	genesis := &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: 100},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{Dynasty: dynasty, DynastySize: 4},
+		},
+	}
+
+	// the full node mints 3 blocks
+	full := mockNeblet(t, genesis, keydir, &mockNetService{})
+	full.chain.BlockPool().RegisterInNetwork(full.ns)
+	am := account.NewManager(full)
+	tail := full.chain.TailBlock()
+	for i := 0; i < 3; i++ {
+		block := mintBlock(t, full, am, tail, core.BlockInterval, nil)
+		assert.Nil(t, full.chain.BlockPool().Push(block))
+		assert.Nil(t, full.chain.SetTailBlock(block))
+		tail = block
+	}
+	server := NewServer(full)
+
+	ns := &mockNetService{server: server}
+	lightNeb := mockNeblet(t, genesis, keydir, ns)
+	client, err := NewClient(lightNeb)
+	assert.Nil(t, err)
+	ns.client = client
+	assert.Equal(t, []byte(full.chain.GenesisBlock().Hash()), client.Tail().Header.Hash)
+
+	// headers not linked to a known header step back to find the common ancestor
+	headers, err := server.headers(3, MaxHeadersPerRequest)
+	assert.Nil(t, err)
+	more, err := client.onHeaders(headers)
+	assert.Equal(t, ErrInvalidHeaderParent, err)
+	assert.True(t, more)
+	assert.Equal(t, uint64(2), client.syncFrom)
+
+	headers, err = server.headers(2, MaxHeadersPerRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(headers.Headers))
+	assert.Equal(t, 1, len(headers.Dynasties))
+	more, err = client.onHeaders(headers)
+	assert.Nil(t, err)
+	assert.False(t, more)
+	assert.Equal(t, tail.Height(), client.Tail().Height)
+	assert.Equal(t, []byte(tail.Hash()), client.Tail().Header.Hash)
+	header, err := client.GetHeaderByHeight(3)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(full.chain.GetBlockOnCanonicalChainByHeight(3).Hash()), header.Header.Hash)
+
+	// forged headers are rejected
+	genesisHeader := signedHeader(t, full.chain.GenesisBlock())
+	proposed := signedHeader(t, mintBlock(t, full, am, full.chain.GenesisBlock(), 2*core.BlockInterval, nil))
+	assert.Nil(t, client.verifyHeader(genesisHeader, proposed))
+	for _, addr := range addrs {
+		if addr.Equals(mustAddress(t, proposed.Header.Coinbase)) {
+			continue
+		}
+		forged := signedHeader(t, mintBlock(t, full, am, full.chain.GenesisBlock(), 2*core.BlockInterval, addr))
+		assert.Equal(t, ErrInvalidHeaderProposer, client.verifyHeader(genesisHeader, forged))
+		break
+	}
+	client.now = func() int64 { return core.GenesisTimestamp }
+	assert.Equal(t, ErrFutureHeader, client.verifyHeader(genesisHeader, proposed))
+	client.now = func() int64 { return time.Now().Unix() }
+
+	// the dynasty after a gap must be committed in the parent or start at a checkpoint
+	gap := signedHeader(t, mintBlock(t, full, am, full.chain.GenesisBlock(), 2*core.DynastyInterval, nil))
+	assert.Nil(t, client.verifyHeader(genesisHeader, gap))
+	uncommitted := proto.Clone(genesisHeader).(*lightpb.SignedHeader)
+	uncommitted.Header.DposContext.DynastyRoot = tail.Hash()
+	uncommitted.Header.DposContext.NextDynastyRoot = tail.Hash()
+	assert.Equal(t, ErrUnprovableDynasty, client.verifyHeader(uncommitted, gap))
+	client.checkpoints[byteutils.Hash(gap.Header.Hash).Hex()] = true
+	assert.Nil(t, client.verifyHeader(uncommitted, gap))
+
+	// the dynasties sent with the headers are bounded
+	headers.Dynasties = append(headers.Dynasties, headers.Dynasties[0], headers.Dynasties[0], headers.Dynasties[0])
+	_, err = client.onHeaders(headers)
+	assert.Equal(t, ErrTooManyDynasties, err)
+
+	proposed.Header.Timestamp++
+	assert.Equal(t, core.ErrInvalidBlockHash, client.verifyHeader(genesisHeader, proposed))
+	assert.Equal(t, ErrInvalidHeaderParent, client.verifyHeader(genesisHeader, signedHeader(t, tail)))
+
+	// account queries are verified by the merkle proofs
+	coinbase := tail.Coinbase()
+	balance, err := tail.GetBalance(coinbase.Bytes())
+	assert.Nil(t, err)
+	acc, err := client.GetAccount(coinbase, 0)
+	assert.Nil(t, err)
+	bytes, err := balance.ToFixedSizeByteSlice()
+	assert.Nil(t, err)
+	assert.Equal(t, bytes, acc.Balance)
+
+	_, err = client.GetAccount(mockKey(), 0)
+	assert.Equal(t, ErrNotFoundByPeer, err)
+
+	ns.tamper = func(proof *lightpb.Proof) {
+		proof.Account[len(proof.Account)-1]++
+	}
+	_, err = client.GetAccount(coinbase, 0)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+	ns.tamper = func(proof *lightpb.Proof) {
+		proof.AccountProof = proof.AccountProof[1:]
+	}
+	_, err = client.GetAccount(coinbase, 0)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func mustAddress(t *testing.T, bytes []byte) *core.Address {
+	addr, err := core.AddressParseFromBytes(bytes)
+	assert.Nil(t, err)
+	return addr
+}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: light.proto
+
+/*
+Package lightpb is a generated protocol buffer package.
+
+It is generated from these files:
+	light.proto
+
+It has these top-level messages:
+	GetHeaders
+	Headers
+	SignedHeader
+	Dynasty
+	GetProof
+	Proof
+	ProofNode
+*/
+package lightpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import corepb "github.com/nebulasio/go-nebulas/core/pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// GetHeaders requests the headers on the canonical chain from the height.
+type GetHeaders struct {
+	From  uint64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GetHeaders) Reset()                    { *m = GetHeaders{} }
+func (m *GetHeaders) String() string            { return proto.CompactTextString(m) }
+func (*GetHeaders) ProtoMessage()               {}
+func (*GetHeaders) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{0} }
+
+func (m *GetHeaders) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetHeaders) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Headers is the response of GetHeaders, with the members of the dynasties the headers are signed in.
+type Headers struct {
+	Headers   []*SignedHeader `protobuf:"bytes,1,rep,name=headers" json:"headers,omitempty"`
+	Dynasties []*Dynasty      `protobuf:"bytes,2,rep,name=dynasties" json:"dynasties,omitempty"`
+}
+
+func (m *Headers) Reset()                    { *m = Headers{} }
+func (m *Headers) String() string            { return proto.CompactTextString(m) }
+func (*Headers) ProtoMessage()               {}
+func (*Headers) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{1} }
+
+func (m *Headers) GetHeaders() []*SignedHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Headers) GetDynasties() []*Dynasty {
+	if m != nil {
+		return m.Dynasties
+	}
+	return nil
+}
+
+// SignedHeader is a block header with the hashes of the block's txs to verify the block hash.
+type SignedHeader struct {
+	Header   *corepb.BlockHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	TxHashes [][]byte            `protobuf:"bytes,2,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+	Height   uint64              `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SignedHeader) Reset()                    { *m = SignedHeader{} }
+func (m *SignedHeader) String() string            { return proto.CompactTextString(m) }
+func (*SignedHeader) ProtoMessage()               {}
+func (*SignedHeader) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{2} }
+
+func (m *SignedHeader) GetHeader() *corepb.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignedHeader) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *SignedHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type Dynasty struct {
+	Members [][]byte `protobuf:"bytes,1,rep,name=members" json:"members,omitempty"`
+}
+
+func (m *Dynasty) Reset()                    { *m = Dynasty{} }
+func (m *Dynasty) String() string            { return proto.CompactTextString(m) }
+func (*Dynasty) ProtoMessage()               {}
+func (*Dynasty) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{3} }
+
+func (m *Dynasty) GetMembers() [][]byte {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// GetProof requests the proof of an account, and a key in its storage if set, in the state of a block.
+type GetProof struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Address   []byte `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Key       []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *GetProof) Reset()                    { *m = GetProof{} }
+func (m *GetProof) String() string            { return proto.CompactTextString(m) }
+func (*GetProof) ProtoMessage()               {}
+func (*GetProof) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{4} }
+
+func (m *GetProof) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetProof) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *GetProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// Proof is the response of GetProof.
+type Proof struct {
+	Id           uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account      []byte       `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountProof []*ProofNode `protobuf:"bytes,3,rep,name=account_proof,json=accountProof" json:"account_proof,omitempty"`
+	Value        []byte       `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	StorageProof []*ProofNode `protobuf:"bytes,5,rep,name=storage_proof,json=storageProof" json:"storage_proof,omitempty"`
+	Error        string       `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *Proof) Reset()                    { *m = Proof{} }
+func (m *Proof) String() string            { return proto.CompactTextString(m) }
+func (*Proof) ProtoMessage()               {}
+func (*Proof) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{5} }
+
+func (m *Proof) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proof) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *Proof) GetAccountProof() []*ProofNode {
+	if m != nil {
+		return m.AccountProof
+	}
+	return nil
+}
+
+func (m *Proof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Proof) GetStorageProof() []*ProofNode {
+	if m != nil {
+		return m.StorageProof
+	}
+	return nil
+}
+
+func (m *Proof) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ProofNode struct {
+	Val [][]byte `protobuf:"bytes,1,rep,name=val" json:"val,omitempty"`
+}
+
+func (m *ProofNode) Reset()                    { *m = ProofNode{} }
+func (m *ProofNode) String() string            { return proto.CompactTextString(m) }
+func (*ProofNode) ProtoMessage()               {}
+func (*ProofNode) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{6} }
+
+func (m *ProofNode) GetVal() [][]byte {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetHeaders)(nil), "lightpb.GetHeaders")
+	proto.RegisterType((*Headers)(nil), "lightpb.Headers")
+	proto.RegisterType((*SignedHeader)(nil), "lightpb.SignedHeader")
+	proto.RegisterType((*Dynasty)(nil), "lightpb.Dynasty")
+	proto.RegisterType((*GetProof)(nil), "lightpb.GetProof")
+	proto.RegisterType((*Proof)(nil), "lightpb.Proof")
+	proto.RegisterType((*ProofNode)(nil), "lightpb.ProofNode")
+}
+
+func init() { proto.RegisterFile("light.proto", fileDescriptorLight) }
+
+var fileDescriptorLight = []byte{
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xdd, 0x6a, 0xdb, 0x30,
+	0x14, 0xc6, 0xf9, 0x73, 0x7d, 0xe2, 0x8e, 0xa2, 0x75, 0x43, 0x6c, 0x14, 0x82, 0x77, 0x13, 0x18,
+	0xb3, 0xa1, 0x85, 0xed, 0x7e, 0x0c, 0xda, 0xab, 0x31, 0xb4, 0x07, 0x28, 0x96, 0x75, 0x6a, 0x7b,
+	0x75, 0x22, 0x23, 0x29, 0xa5, 0x79, 0xca, 0xbd, 0xd2, 0xd0, 0x91, 0x9c, 0xee, 0x62, 0xbb, 0x3b,
+	0x9f, 0xf2, 0xfd, 0x9c, 0xf3, 0xc5, 0xb0, 0x1e, 0xfa, 0xb6, 0x73, 0xe5, 0x68, 0xb4, 0xd3, 0x2c,
+	0x25, 0x30, 0xca, 0x77, 0x37, 0x6d, 0xef, 0xba, 0x83, 0x2c, 0x1b, 0xbd, 0xab, 0xf6, 0x28, 0x0f,
+	0x43, 0x6d, 0x7b, 0x5d, 0xb5, 0xfa, 0x53, 0x04, 0x55, 0xa3, 0x0d, 0x56, 0xa3, 0xac, 0xe4, 0xa0,
+	0x9b, 0xc7, 0xa0, 0x2e, 0x3e, 0x03, 0xdc, 0xa2, 0xbb, 0xc3, 0x5a, 0xa1, 0xb1, 0x8c, 0xc1, 0xe2,
+	0xc1, 0xe8, 0x1d, 0x4f, 0x36, 0xc9, 0x76, 0x21, 0x68, 0x66, 0x97, 0xb0, 0x6c, 0xf4, 0x61, 0xef,
+	0xf8, 0x6c, 0x93, 0x6c, 0xcf, 0x45, 0x00, 0xc5, 0x2f, 0x48, 0x27, 0x51, 0x05, 0x69, 0x17, 0x46,
+	0x9e, 0x6c, 0xe6, 0xdb, 0xf5, 0xf5, 0x9b, 0x32, 0xae, 0x54, 0xfe, 0xec, 0xdb, 0x3d, 0xaa, 0x40,
+	0x14, 0x13, 0x8b, 0x95, 0x90, 0xa9, 0xe3, 0xbe, 0xb6, 0xae, 0x47, 0xcb, 0x67, 0x24, 0xb9, 0x38,
+	0x49, 0xbe, 0xd1, 0x2f, 0x47, 0xf1, 0x42, 0x29, 0x46, 0xc8, 0xff, 0x36, 0x62, 0x1f, 0x61, 0x15,
+	0xac, 0x68, 0xcf, 0xf5, 0xf5, 0xeb, 0xd2, 0x5f, 0x36, 0xca, 0xf2, 0xab, 0x3f, 0x2c, 0xa6, 0x45,
+	0x0a, 0x7b, 0x0f, 0x99, 0x7b, 0xbe, 0xef, 0x6a, 0xdb, 0xc5, 0xb0, 0x5c, 0x9c, 0xb9, 0xe7, 0x3b,
+	0xc2, 0xec, 0xad, 0x77, 0xf2, 0xc1, 0x7c, 0x4e, 0x17, 0x47, 0x54, 0x7c, 0x80, 0x34, 0xee, 0xc1,
+	0x38, 0xa4, 0x3b, 0xdc, 0xc9, 0xe9, 0xba, 0x5c, 0x4c, 0xb0, 0x40, 0x38, 0xbb, 0x45, 0xf7, 0xc3,
+	0x68, 0xfd, 0xc0, 0x5e, 0xc1, 0xac, 0x57, 0xb1, 0xb6, 0x59, 0xaf, 0xd8, 0x15, 0x00, 0xb5, 0x4c,
+	0xc1, 0xd4, 0x5c, 0x2e, 0x32, 0x7a, 0xf1, 0xc9, 0xde, 0xb4, 0x56, 0xca, 0xa0, 0xb5, 0x14, 0x9c,
+	0x8b, 0x09, 0xb2, 0x0b, 0x98, 0x3f, 0xe2, 0x91, 0x2f, 0xe8, 0xd5, 0x8f, 0xc5, 0xef, 0x04, 0x96,
+	0xff, 0x0e, 0xf1, 0x2e, 0xcd, 0xcb, 0x7f, 0x93, 0x8b, 0x09, 0xb2, 0x2f, 0x70, 0x1e, 0xc7, 0xfb,
+	0xd1, 0x4b, 0xf9, 0x9c, 0x5a, 0x66, 0xa7, 0x96, 0xc9, 0xf0, 0xbb, 0x56, 0x28, 0xf2, 0x48, 0x0c,
+	0x11, 0x97, 0xb0, 0x7c, 0xaa, 0x87, 0x03, 0xc6, 0x05, 0x02, 0xf0, 0x76, 0xd6, 0x69, 0x53, 0xb7,
+	0x18, 0xed, 0x96, 0xff, 0xb7, 0x8b, 0xc4, 0x93, 0x1d, 0x1a, 0xa3, 0x0d, 0x5f, 0x6d, 0x92, 0x6d,
+	0x26, 0x02, 0x28, 0xae, 0x20, 0x3b, 0x09, 0xfc, 0xc1, 0x4f, 0xf5, 0x10, 0xbb, 0xf5, 0xa3, 0x5c,
+	0xd1, 0x97, 0x79, 0xf3, 0x67, 0x00, 0x69, 0x5d, 0x6f, 0x6e, 0xe6, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Server serves the headers on the canonical chain and the merkle proofs of the state to light nodes.
+type Server struct {
+	quitCh chan bool
+
+	chain *core.BlockChain
+	ns    net.Service
+
+	receiveCh chan net.Message
+}
+
+// NewServer create Server instance.
+func NewServer(neb Neblet) *Server {
+	return &Server{
+		quitCh:    make(chan bool, 1),
+		chain:     neb.BlockChain(),
+		ns:        neb.NetService(),
+		receiveCh: make(chan net.Message, 128),
+	}
+}
+
+// Start start light server.
+func (s *Server) Start() {
+	logging.CLog().Info("Starting Light Server...")
+	s.ns.Register(s.subscribers()...)
+	go s.loop()
+}
+
+// Stop stop light server.
+func (s *Server) Stop() {
+	logging.CLog().Info("Stopping Light Server...")
+	s.ns.Deregister(s.subscribers()...)
+	s.quitCh <- true
+}
+
+func (s *Server) subscribers() []*net.Subscriber {
+	return []*net.Subscriber{
+		net.NewSubscriber(s, s.receiveCh, false, MessageTypeGetHeaders, net.MessageWeightZero),
+		net.NewSubscriber(s, s.receiveCh, false, MessageTypeGetProof, net.MessageWeightZero),
+	}
+}
+
+func (s *Server) loop() {
+	logging.CLog().Info("Started Light Server.")
+	for {
+		select {
+		case msg := <-s.receiveCh:
+			if err := s.onMessage(msg); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"type": msg.MessageType(),
+					"from": msg.MessageFrom(),
+					"err":  err,
+				}).Debug("Failed to serve light node.")
+			}
+		case <-s.quitCh:
+			logging.CLog().Info("Stopped Light Server.")
+			return
+		}
+	}
+}
+
+func (s *Server) onMessage(msg net.Message) error {
+	var (
+		reply     proto.Message
+		replyType string
+	)
+	switch msg.MessageType() {
+	case MessageTypeGetHeaders:
+		req := new(lightpb.GetHeaders)
+		if err := proto.Unmarshal(msg.Data(), req); err != nil {
+			return err
+		}
+		headers, err := s.headers(req.From, req.Count)
+		if err != nil {
+			return err
+		}
+		reply, replyType = headers, MessageTypeHeaders
+	case MessageTypeGetProof:
+		req := new(lightpb.GetProof)
+		if err := proto.Unmarshal(msg.Data(), req); err != nil {
+			return err
+		}
+		reply, replyType = s.proof(req), MessageTypeProof
+	default:
+		return nil
+	}
+	data, err := proto.Marshal(reply)
+	if err != nil {
+		return err
+	}
+	return s.ns.SendMessageToPeer(replyType, data, net.MessagePriorityNormal, msg.MessageFrom())
+}
+
+// headers returns the signed headers on the canonical chain from the height,
+// with the members of the dynasties they are signed in.
+func (s *Server) headers(from uint64, count uint32) (*lightpb.Headers, error) {
+	if count > MaxHeadersPerRequest {
+		count = MaxHeadersPerRequest
+	}
+	headers := &lightpb.Headers{}
+	dynasties := make(map[byteutils.HexHash]bool)
+	for height := from; height < from+uint64(count); height++ {
+		block := s.chain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			break
+		}
+		evidence, err := core.NewBlockEvidence(block)
+		if err != nil {
+			return nil, err
+		}
+		header := &lightpb.SignedHeader{Header: evidence.Header, Height: block.Height()}
+		for _, hash := range evidence.TxHashes {
+			header.TxHashes = append(header.TxHashes, hash)
+		}
+		headers.Headers = append(headers.Headers, header)
+
+		root := byteutils.Hash(block.DposContext().DynastyRoot)
+		if dynasties[root.Hex()] {
+			continue
+		}
+		dynasties[root.Hex()] = true
+		dynasty, err := trie.NewBatchTrie(root, s.chain.Storage())
+		if err != nil {
+			return nil, err
+		}
+		members, err := core.TraverseDynasty(dynasty)
+		if err != nil {
+			return nil, err
+		}
+		pbDynasty := &lightpb.Dynasty{}
+		for _, member := range members {
+			pbDynasty.Members = append(pbDynasty.Members, member)
+		}
+		headers.Dynasties = append(headers.Dynasties, pbDynasty)
+	}
+	return headers, nil
+}
+
+// proof returns the account and the storage value with their merkle proofs in the state of the block.
+func (s *Server) proof(req *lightpb.GetProof) *lightpb.Proof {
+	resp := &lightpb.Proof{Id: req.Id}
+	block := s.chain.GetBlock(req.BlockHash)
+	if block == nil {
+		resp.Error = ErrHeaderNotFound.Error()
+		return resp
+	}
+	account, accountProof, err := block.ProveAccount(req.Address)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.Account, resp.AccountProof = account, proofToProto(accountProof)
+	if len(req.Key) > 0 {
+		value, storageProof, err := block.ProveStorage(req.Address, req.Key)
+		if err != nil {
+			resp.Error = err.Error()
+			return resp
+		}
+		resp.Value, resp.StorageProof = value, proofToProto(storageProof)
+	}
+	return resp
+}
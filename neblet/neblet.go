@@ -22,11 +22,16 @@ import (
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/consensus/pod"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/dip"
+	"github.com/nebulasio/go-nebulas/light"
 	"github.com/nebulasio/go-nebulas/metrics"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	nebnet "github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nr"
 	"github.com/nebulasio/go-nebulas/rpc"
 	"github.com/nebulasio/go-nebulas/storage"
 	nsync "github.com/nebulasio/go-nebulas/sync"
@@ -59,6 +64,16 @@ type Neblet struct {
 
 	consensus consensus.Consensus
 
+	dip *dip.DIP
+
+	nrService *nr.Service
+
+	finality *finality.Gadget
+
+	lightServer *light.Server
+
+	lightClient *light.Client
+
 	storage storage.Storage
 
 	blockChain *core.BlockChain
@@ -128,11 +143,28 @@ func (n *Neblet) Setup() {
 	gasPrice := util.NewUint128FromString(n.config.Chain.GasPrice)
 	gasLimit := util.NewUint128FromString(n.config.Chain.GasLimit)
 	n.blockChain.TransactionPool().SetGasConfig(gasPrice, gasLimit)
-	n.blockChain.BlockPool().RegisterInNetwork(n.netService)
-	n.blockChain.TransactionPool().RegisterInNetwork(n.netService)
+	forkChoiceRule, err := core.NewForkChoiceRule(n.config.Chain.ForkChoice)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"rule": n.config.Chain.ForkChoice,
+			"err":  err,
+		}).Fatal("Failed to setup fork choice rule.")
+	}
+	n.blockChain.SetForkChoiceRule(forkChoiceRule)
+	n.blockChain.TransactionPool().SetLocalAccounts(n.accountManager.Accounts())
+	n.setupTxFilters()
+	// the light node has no block or tx pool in the network, the rpc server rejects the methods needing them.
+	if !n.config.Chain.Light {
+		n.blockChain.BlockPool().RegisterInNetwork(n.netService)
+		n.blockChain.TransactionPool().RegisterInNetwork(n.netService)
+	}
 
 	// consensus
-	n.consensus, err = dpos.NewDpos(n)
+	if n.genesis.Consensus.Pod != nil {
+		n.consensus, err = pod.NewPod(n)
+	} else {
+		n.consensus, err = dpos.NewDpos(n)
+	}
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
 			"err": err,
@@ -140,16 +172,97 @@ func (n *Neblet) Setup() {
 	}
 	n.blockChain.SetConsensusHandler(n.consensus)
 
+	// dip
+	if n.genesis.Consensus.Dip != nil {
+		n.dip, err = dip.NewDIP(n.blockChain, n.genesis.Consensus.Dip)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Fatal("Failed to setup dip.")
+		}
+		n.blockChain.SetDeveloperRewarder(n.dip)
+	}
+
+	// finality
+	if n.config.Chain.Finality {
+		n.finality, err = finality.NewGadget(n)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Fatal("Failed to setup finality.")
+		}
+	}
+
 	// sync
 	n.syncService = nsync.NewService(n.blockChain, n.netService)
 	n.blockChain.SetSyncService(n.syncService)
 
+	// light
+	if n.config.Chain.Light {
+		n.lightClient, err = light.NewClient(n)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Fatal("Failed to setup light client.")
+		}
+	} else {
+		n.lightServer = light.NewServer(n)
+		n.nrService = nr.NewService(n.blockChain, n.eventEmitter, nr.GenesisWindow(n.genesis))
+	}
+
 	// rpc
 	n.rpcServer = rpc.NewServer(n)
 
 	logging.CLog().Info("Setuped Neblet.")
 }
 
+func (n *Neblet) setupTxFilters() {
+	conf := n.config.Chain
+	txPool := n.blockChain.TransactionPool()
+
+	parseAddrs := func(addrs []string) []*core.Address {
+		var result []*core.Address
+		for _, v := range addrs {
+			addr, err := core.AddressParse(v)
+			if err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"address": v,
+					"err":     err,
+				}).Fatal("Failed to parse tx filter address.")
+			}
+			result = append(result, addr)
+		}
+		return result
+	}
+
+	if len(conf.TxBlacklist) > 0 {
+		txPool.AddFilter(core.NewBlacklistFilter(parseAddrs(conf.TxBlacklist)))
+	}
+	if len(conf.TxContractAllowlist) > 0 {
+		txPool.AddFilter(core.NewContractAllowlistFilter(parseAddrs(conf.TxContractAllowlist)))
+	}
+	if len(conf.TxMinValue) > 0 {
+		value, err := core.ParseTxFilterValue(conf.TxMinValue)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"tx_min_value": conf.TxMinValue,
+				"err":          err,
+			}).Fatal("Failed to parse tx min value.")
+		}
+		txPool.AddFilter(core.NewMinValueFilter(value))
+	}
+	if len(conf.TxPayloadGasPrice) > 0 {
+		gasPrices, err := core.ParsePayloadGasPrices(conf.TxPayloadGasPrice)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"tx_payload_gas_price": conf.TxPayloadGasPrice,
+				"err":                  err,
+			}).Fatal("Failed to parse tx payload gas price.")
+		}
+		txPool.AddFilter(core.NewPayloadGasPriceFilter(gasPrices))
+	}
+}
+
 // StartPprof start pprof http listen
 func (n *Neblet) StartPprof(listen string) error {
 	if len(listen) > 0 {
@@ -205,18 +318,32 @@ func (n *Neblet) Start() {
 		}).Fatal("Failed to start api gateway.")
 	}
 
+	n.eventEmitter.Start()
+
+	// the light node only syncs the headers, no blocks are synced or mined.
+	if n.lightClient != nil {
+		n.lightClient.Start()
+		metricsNebstartGauge.Update(1)
+		logging.CLog().Info("Started Neblet in light mode.")
+		return
+	}
+
 	n.blockChain.Start()
 	n.blockChain.BlockPool().Start()
 	n.blockChain.TransactionPool().Start()
-	n.eventEmitter.Start()
 	n.syncService.Start()
+	n.lightServer.Start()
+	n.nrService.Start()
+	if n.dip != nil {
+		n.dip.Start()
+	}
 
 	// start consensus
 	chainConf := n.config.Chain
 	n.consensus.Start()
 	if chainConf.StartMine {
 		passphrase := n.config.Chain.Passphrase
-		if len(passphrase) == 0 {
+		if len(passphrase) == 0 && len(chainConf.Signer) == 0 {
 			fmt.Println("***********************************************")
 			fmt.Println("miner address:" + n.config.Chain.Miner)
 			prompt := console.Stdin
@@ -231,6 +358,10 @@ func (n *Neblet) Start() {
 		}
 	}
 
+	if n.finality != nil {
+		n.finality.Start()
+	}
+
 	// first sync
 	if len(n.Config().Network.Seed) > 0 {
 		n.blockChain.StartActiveSync()
@@ -254,6 +385,30 @@ func (n *Neblet) Stop() {
 	// try Stop Profiling.
 	n.TryStopProfiling()
 
+	if n.lightClient != nil {
+		n.lightClient.Stop()
+		n.lightClient = nil
+	}
+
+	if n.lightServer != nil {
+		n.lightServer.Stop()
+		n.lightServer = nil
+	}
+
+	if n.nrService != nil {
+		n.nrService.Stop()
+		n.nrService = nil
+	}
+
+	if n.dip != nil {
+		n.dip.Stop()
+	}
+
+	if n.finality != nil {
+		n.finality.Stop()
+		n.finality = nil
+	}
+
 	if n.consensus != nil {
 		n.consensus.Stop()
 		n.consensus = nil
@@ -342,6 +497,21 @@ func (n *Neblet) Consensus() consensus.Consensus {
 	return n.consensus
 }
 
+// Dip returns dip reference, nil if dip is not enabled.
+func (n *Neblet) Dip() *dip.DIP {
+	return n.dip
+}
+
+// Finality returns finality gadget reference, nil if finality is not enabled.
+func (n *Neblet) Finality() *finality.Gadget {
+	return n.finality
+}
+
+// LightClient returns light client reference, nil if the neblet is not in light mode.
+func (n *Neblet) LightClient() *light.Client {
+	return n.lightClient
+}
+
 // SyncService return sync service
 func (n *Neblet) SyncService() *nsync.Service {
 	return n.syncService
@@ -174,7 +174,7 @@ type ChainConfig struct {
 	Keydir string `protobuf:"bytes,12,opt,name=keydir,proto3" json:"keydir,omitempty"`
 	// start mine at launch
 	StartMine bool `protobuf:"varint,20,opt,name=start_mine,json=startMine,proto3" json:"start_mine,omitempty"`
-	// Coinbase.
+	// Coinbase, it receives the block rewards until the reward sharing fork, the miner accrues them after.
 	Coinbase string `protobuf:"bytes,21,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
 	// Miner.
 	Miner string `protobuf:"bytes,22,opt,name=miner,proto3" json:"miner,omitempty"`
@@ -186,6 +186,28 @@ type ChainConfig struct {
 	GasLimit string `protobuf:"bytes,25,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// Supported signature cipher list. ["ECC_SECP256K1"]
 	SignatureCiphers []string `protobuf:"bytes,26,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers,omitempty"`
+	// Transaction pool admission filters.
+	// Reject txs from or to these addresses.
+	TxBlacklist []string `protobuf:"bytes,30,rep,name=tx_blacklist,json=txBlacklist" json:"tx_blacklist,omitempty"`
+	// Only accept contract calls to these contracts, accept all if empty.
+	TxContractAllowlist []string `protobuf:"bytes,31,rep,name=tx_contract_allowlist,json=txContractAllowlist" json:"tx_contract_allowlist,omitempty"`
+	// Lowest tx value.
+	TxMinValue string `protobuf:"bytes,32,opt,name=tx_min_value,json=txMinValue,proto3" json:"tx_min_value,omitempty"`
+	// Lowest GasPrice of each payload type, e.g. {"call": "2000000"}.
+	TxPayloadGasPrice map[string]string `protobuf:"bytes,33,rep,name=tx_payload_gas_price,json=txPayloadGasPrice" json:"tx_payload_gas_price,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// External block signer, unix:///path/to/socket.
+	// Blocks are signed by the signer and the miner key is never unlocked in the node if set.
+	Signer string `protobuf:"bytes,40,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Take part in the finality gadget, gossip and count prevote/commit votes of dynasty members.
+	Finality bool `protobuf:"varint,41,opt,name=finality,proto3" json:"finality,omitempty"`
+	// Rule to pick the tail among forks, "longest" (default) or "miners".
+	ForkChoice string `protobuf:"bytes,42,opt,name=fork_choice,json=forkChoice,proto3" json:"fork_choice,omitempty"`
+	// Light mode, only verified block headers are synced and account queries are answered by
+	// merkle proofs from full peers.
+	Light bool `protobuf:"varint,43,opt,name=light,proto3" json:"light,omitempty"`
+	// Hex hashes of the blocks trusted by the light node, a header with one of the hashes may
+	// start a dynasty not provable from its parent, e.g. elected after a gap without blocks.
+	LightCheckpoints []string `protobuf:"bytes,44,rep,name=light_checkpoints,json=lightCheckpoints" json:"light_checkpoints,omitempty"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -270,6 +292,69 @@ func (m *ChainConfig) GetSignatureCiphers() []string {
 	return nil
 }
 
+func (m *ChainConfig) GetTxBlacklist() []string {
+	if m != nil {
+		return m.TxBlacklist
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetTxContractAllowlist() []string {
+	if m != nil {
+		return m.TxContractAllowlist
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetTxMinValue() string {
+	if m != nil {
+		return m.TxMinValue
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetTxPayloadGasPrice() map[string]string {
+	if m != nil {
+		return m.TxPayloadGasPrice
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetFinality() bool {
+	if m != nil {
+		return m.Finality
+	}
+	return false
+}
+
+func (m *ChainConfig) GetForkChoice() string {
+	if m != nil {
+		return m.ForkChoice
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetLight() bool {
+	if m != nil {
+		return m.Light
+	}
+	return false
+}
+
+func (m *ChainConfig) GetLightCheckpoints() []string {
+	if m != nil {
+		return m.LightCheckpoints
+	}
+	return nil
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen,omitempty"`
@@ -542,59 +627,72 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x5f, 0x6f, 0xdb, 0x36,
-	0x10, 0x9f, 0x9d, 0x7f, 0xd6, 0xd9, 0x71, 0x5d, 0x36, 0x6d, 0xd8, 0x16, 0x6b, 0x33, 0x01, 0x01,
-	0x0c, 0x14, 0x30, 0xb0, 0x6c, 0xaf, 0x7b, 0x28, 0x0c, 0x0c, 0x08, 0x92, 0x0c, 0x81, 0xb6, 0x3d,
-	0x0b, 0xb4, 0x74, 0x96, 0x89, 0xc8, 0x12, 0x41, 0xd2, 0x69, 0x83, 0xbd, 0xec, 0x0b, 0xec, 0x03,
-	0xec, 0x3b, 0xed, 0x2b, 0x0d, 0x18, 0xee, 0x44, 0x59, 0x8e, 0xb1, 0x37, 0xdd, 0xef, 0xf7, 0xe3,
-	0x91, 0xbc, 0xfb, 0x1d, 0x05, 0xa3, 0xac, 0xae, 0x96, 0xba, 0x98, 0x19, 0x5b, 0xfb, 0x5a, 0x0c,
-	0x2a, 0x5c, 0x94, 0xe8, 0xcd, 0x22, 0xfe, 0xab, 0x0f, 0xc7, 0x73, 0xa6, 0xc4, 0xf7, 0x70, 0x52,
-	0xa1, 0xff, 0x52, 0xdb, 0x07, 0xd9, 0xbb, 0xe8, 0x4d, 0x87, 0x57, 0xe7, 0xb3, 0x56, 0x36, 0xfb,
-	0xa5, 0x21, 0x1a, 0x65, 0xd2, 0xea, 0xc4, 0x27, 0x38, 0xca, 0x56, 0x4a, 0x57, 0xb2, 0xcf, 0x0b,
-	0x5e, 0x77, 0x0b, 0xe6, 0x04, 0x07, 0x79, 0xa3, 0x11, 0x97, 0x70, 0x60, 0x4d, 0x26, 0x0f, 0x58,
-	0xfa, 0xaa, 0x93, 0x26, 0xf7, 0xf3, 0x20, 0x24, 0x9e, 0x72, 0x3a, 0xaf, 0xbc, 0x93, 0xf9, 0x7e,
-	0xce, 0x5f, 0x09, 0x6e, 0x73, 0xb2, 0x46, 0x4c, 0xe1, 0x70, 0xad, 0x5d, 0x26, 0x91, 0xb5, 0x67,
-	0x9d, 0xf6, 0x4e, 0xbb, 0x2c, 0x48, 0x59, 0x41, 0xbb, 0x2b, 0x63, 0xe4, 0x72, 0x7f, 0xf7, 0xcf,
-	0xc6, 0xb4, 0xbb, 0x2b, 0x63, 0xe2, 0x3f, 0xe0, 0xf4, 0xd9, 0x5d, 0x85, 0x80, 0x43, 0x87, 0x98,
-	0xcb, 0xde, 0xc5, 0xc1, 0x34, 0x4a, 0xf8, 0x5b, 0xbc, 0x81, 0xe3, 0x52, 0x3b, 0x8f, 0x74, 0x6f,
-	0x42, 0x43, 0x24, 0x3e, 0xc2, 0xd0, 0x58, 0xfd, 0xa8, 0x3c, 0xa6, 0x0f, 0xf8, 0xc4, 0x37, 0x8d,
-	0x12, 0x08, 0xd0, 0x0d, 0x3e, 0x89, 0x6f, 0x01, 0x42, 0xe9, 0x52, 0x9d, 0xcb, 0xc3, 0x8b, 0xde,
-	0xf4, 0x34, 0x89, 0x02, 0x72, 0x9d, 0xc7, 0xff, 0xf4, 0x61, 0xb8, 0x53, 0x38, 0xf1, 0x16, 0x06,
-	0x5c, 0x3a, 0x12, 0xf7, 0x58, 0x7c, 0xc2, 0xf1, 0x75, 0x2e, 0x24, 0x9c, 0x14, 0x58, 0xa1, 0xd3,
-	0x8e, 0x6b, 0x1f, 0x25, 0x6d, 0x48, 0x4c, 0xae, 0xbc, 0xca, 0xb5, 0x95, 0xc3, 0x86, 0x09, 0x21,
-	0x1d, 0xfb, 0x01, 0x9f, 0x88, 0x18, 0x31, 0x11, 0x22, 0x3a, 0x95, 0xf3, 0xca, 0xfa, 0x74, 0xad,
-	0x2b, 0x94, 0x67, 0x17, 0xbd, 0xe9, 0x20, 0x89, 0x18, 0xb9, 0xd3, 0x15, 0x8a, 0x77, 0x30, 0xc8,
-	0x6a, 0x5d, 0x2d, 0x94, 0x43, 0xf9, 0x9a, 0x17, 0x6e, 0x63, 0x71, 0x06, 0x47, 0xb4, 0xc8, 0xca,
-	0x37, 0x4c, 0x34, 0x81, 0xf8, 0x00, 0x60, 0x94, 0x73, 0x66, 0x65, 0x69, 0xcd, 0x79, 0x28, 0xc3,
-	0x16, 0x11, 0xef, 0x21, 0x2a, 0x94, 0x4b, 0x8d, 0xd5, 0x19, 0x4a, 0xd9, 0xa4, 0x2c, 0x94, 0xbb,
-	0xa7, 0xb8, 0x25, 0x4b, 0xbd, 0xd6, 0x5e, 0xbe, 0xdd, 0x92, 0xb7, 0x14, 0x8b, 0x4f, 0xf0, 0xd2,
-	0xe9, 0xa2, 0x52, 0x7e, 0x63, 0x31, 0xcd, 0xb4, 0x59, 0xa1, 0x75, 0xf2, 0x1d, 0x37, 0x61, 0xb2,
-	0x25, 0xe6, 0x0d, 0x1e, 0xff, 0xdd, 0x83, 0x68, 0x6b, 0x2e, 0xba, 0xa5, 0x35, 0x59, 0x1a, 0x1a,
-	0xd7, 0xb4, 0x33, 0xb2, 0x26, 0xbb, 0xdd, 0xf6, 0x6e, 0xe5, 0xbd, 0x49, 0x9f, 0x35, 0x16, 0x08,
-	0xda, 0x13, 0xac, 0xeb, 0x7c, 0x53, 0xa2, 0x3c, 0xe8, 0x04, 0x77, 0x8c, 0xd0, 0xd9, 0xb2, 0xba,
-	0xaa, 0x30, 0xf3, 0xba, 0xae, 0x9a, 0xf3, 0x3b, 0xee, 0xf1, 0x51, 0x32, 0xe9, 0x08, 0xbe, 0x87,
-	0x8b, 0xff, 0xed, 0x41, 0xb4, 0xb5, 0x1e, 0xdd, 0xb9, 0xac, 0x8b, 0xb4, 0xc4, 0x47, 0x2c, 0xb9,
-	0xd3, 0x51, 0x32, 0x28, 0xeb, 0xe2, 0x96, 0x62, 0x72, 0x01, 0x91, 0x4b, 0x5d, 0x62, 0xdb, 0xeb,
-	0xb2, 0x2e, 0x7e, 0xd6, 0x25, 0x8a, 0x73, 0xa0, 0xcf, 0x54, 0x15, 0xc8, 0x66, 0x3b, 0x4d, 0x8e,
-	0xcb, 0xba, 0xf8, 0x5c, 0xa0, 0x98, 0xc1, 0x2b, 0xac, 0xd4, 0xa2, 0xc4, 0x34, 0xb3, 0xca, 0xad,
-	0x52, 0x8b, 0xa6, 0xb6, 0x9e, 0x4f, 0x33, 0x48, 0x5e, 0x36, 0xd4, 0x9c, 0x98, 0x84, 0x09, 0x31,
-	0x85, 0xc9, 0xae, 0x30, 0xdd, 0xd8, 0x52, 0x1e, 0xf1, 0x5e, 0xe3, 0xac, 0x93, 0xfd, 0x6e, 0x4b,
-	0x1a, 0x4f, 0x63, 0x6c, 0xbd, 0x94, 0xc7, 0xfb, 0xe3, 0x79, 0x4f, 0x70, 0x3b, 0x9e, 0xac, 0x21,
-	0x2f, 0x3e, 0xa2, 0x75, 0xba, 0xae, 0x78, 0x9a, 0xa3, 0xa4, 0x0d, 0xe3, 0x0a, 0x86, 0x3b, 0xfa,
-	0xfd, 0xea, 0x37, 0x25, 0xd8, 0xad, 0xfe, 0x07, 0x80, 0xcc, 0x6c, 0x68, 0x45, 0x57, 0x86, 0x1d,
-	0x84, 0xf8, 0x35, 0xae, 0x5b, 0x3e, 0x4c, 0x5e, 0x87, 0xc4, 0x37, 0x00, 0xdd, 0x93, 0x20, 0x7e,
-	0x82, 0xf7, 0x39, 0x2e, 0xd5, 0xa6, 0xf4, 0x34, 0xa8, 0xce, 0xd7, 0x16, 0xb9, 0xbe, 0x64, 0x29,
-	0xb4, 0x61, 0x7b, 0x19, 0x24, 0x37, 0x41, 0x41, 0x15, 0x9f, 0x13, 0x1f, 0xff, 0xd9, 0x87, 0xe1,
-	0xce, 0x63, 0x24, 0x2e, 0x61, 0x1c, 0xaa, 0xbd, 0x46, 0x6f, 0x75, 0xe6, 0x38, 0xc3, 0x20, 0x39,
-	0x6d, 0xd0, 0xbb, 0x06, 0x14, 0xf7, 0x30, 0x69, 0xca, 0xab, 0xab, 0xa2, 0xb5, 0x11, 0xf9, 0x6c,
-	0x7c, 0x75, 0xf9, 0xbf, 0x8f, 0xdc, 0x2c, 0x69, 0xd5, 0x8d, 0xc3, 0x92, 0x17, 0xf6, 0x39, 0x20,
-	0x7e, 0x84, 0x81, 0xae, 0x96, 0xe5, 0xe6, 0x6b, 0xbe, 0xe0, 0x61, 0x1f, 0x5e, 0xc9, 0x2e, 0xd3,
-	0x75, 0x60, 0x42, 0x4b, 0xb6, 0x4a, 0xf1, 0x1d, 0x8c, 0xc2, 0x39, 0x53, 0xaf, 0x0a, 0x27, 0x47,
-	0x6c, 0xe5, 0x61, 0xc0, 0x7e, 0x53, 0x85, 0x8b, 0x3f, 0xc2, 0x8b, 0xbd, 0xcd, 0xc5, 0x08, 0x06,
-	0x6d, 0xc6, 0xc9, 0x37, 0xf1, 0x57, 0x18, 0x3f, 0xcf, 0x4f, 0x0f, 0xe5, 0xaa, 0x76, 0x3e, 0x14,
-	0x8f, 0xbf, 0x09, 0x63, 0xdf, 0xf5, 0xd9, 0x9c, 0xfc, 0x2d, 0xc6, 0xd0, 0xcf, 0x17, 0xa1, 0x43,
-	0xfd, 0x7c, 0x41, 0x9a, 0x8d, 0x43, 0xcb, 0xde, 0x8c, 0x12, 0xfe, 0xa6, 0x27, 0x87, 0x9e, 0x8b,
-	0x2f, 0xb5, 0xcd, 0x83, 0x0d, 0xb7, 0xf1, 0xe2, 0x98, 0x7f, 0x61, 0x3f, 0xfc, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0xcd, 0xeb, 0x41, 0xdc, 0xd2, 0x06, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0xdd, 0x6b, 0x23, 0xb7,
+	0x17, 0xfd, 0xd9, 0xde, 0x38, 0xf6, 0x75, 0x92, 0x75, 0x94, 0x2f, 0x6d, 0x96, 0x5f, 0xe2, 0x35,
+	0x04, 0xdc, 0x66, 0x31, 0x34, 0xed, 0x43, 0x29, 0xf4, 0x21, 0x75, 0x3f, 0x08, 0x49, 0x4a, 0x98,
+	0x6e, 0xfb, 0x56, 0x06, 0x79, 0x46, 0x1e, 0x8b, 0xc8, 0x33, 0x42, 0x92, 0x13, 0x9b, 0xbe, 0x94,
+	0xbe, 0xf7, 0x0f, 0xe8, 0x1f, 0x5b, 0x28, 0x57, 0xd2, 0x78, 0x1c, 0x93, 0x37, 0xdd, 0x73, 0x8e,
+	0x3e, 0xee, 0xd1, 0xd5, 0x9d, 0x81, 0x9d, 0xa4, 0xc8, 0x27, 0x22, 0x1b, 0x2a, 0x5d, 0xd8, 0x82,
+	0xb4, 0x72, 0x3e, 0x96, 0xdc, 0xaa, 0x71, 0xff, 0xef, 0x3a, 0x34, 0x47, 0x8e, 0x22, 0x5f, 0xc0,
+	0x76, 0xce, 0xed, 0x73, 0xa1, 0x1f, 0x69, 0xad, 0x57, 0x1b, 0x74, 0xae, 0x4e, 0x86, 0xa5, 0x6c,
+	0xf8, 0xb3, 0x27, 0xbc, 0x32, 0x2a, 0x75, 0xe4, 0x12, 0xb6, 0x92, 0x29, 0x13, 0x39, 0xad, 0xbb,
+	0x09, 0x47, 0xd5, 0x84, 0x11, 0xc2, 0x41, 0xee, 0x35, 0xe4, 0x02, 0x1a, 0x5a, 0x25, 0xb4, 0xe1,
+	0xa4, 0x07, 0x95, 0x34, 0x7a, 0x18, 0x05, 0x21, 0xf2, 0xb8, 0xa6, 0xb1, 0xcc, 0x1a, 0x9a, 0x6e,
+	0xae, 0xf9, 0x0b, 0xc2, 0xe5, 0x9a, 0x4e, 0x43, 0x06, 0xf0, 0x66, 0x26, 0x4c, 0x42, 0xb9, 0xd3,
+	0x1e, 0x56, 0xda, 0x7b, 0x61, 0x92, 0x20, 0x75, 0x0a, 0xdc, 0x9d, 0x29, 0x45, 0x27, 0x9b, 0xbb,
+	0x5f, 0x2b, 0x55, 0xee, 0xce, 0x94, 0xea, 0xff, 0x01, 0xbb, 0x2f, 0x72, 0x25, 0x04, 0xde, 0x18,
+	0xce, 0x53, 0x5a, 0xeb, 0x35, 0x06, 0xed, 0xc8, 0x8d, 0xc9, 0x31, 0x34, 0xa5, 0x30, 0x96, 0x63,
+	0xde, 0x88, 0x86, 0x88, 0x9c, 0x43, 0x47, 0x69, 0xf1, 0xc4, 0x2c, 0x8f, 0x1f, 0xf9, 0xd2, 0x65,
+	0xda, 0x8e, 0x20, 0x40, 0xb7, 0x7c, 0x49, 0xfe, 0x0f, 0x10, 0xac, 0x8b, 0x45, 0x4a, 0xdf, 0xf4,
+	0x6a, 0x83, 0xdd, 0xa8, 0x1d, 0x90, 0x9b, 0xb4, 0xff, 0x57, 0x13, 0x3a, 0x6b, 0xc6, 0x91, 0x77,
+	0xd0, 0x72, 0xd6, 0xa1, 0xb8, 0xe6, 0xc4, 0xdb, 0x2e, 0xbe, 0x49, 0x09, 0x85, 0xed, 0x8c, 0xe7,
+	0xdc, 0x08, 0xe3, 0xbc, 0x6f, 0x47, 0x65, 0x88, 0x4c, 0xca, 0x2c, 0x4b, 0x85, 0xa6, 0x1d, 0xcf,
+	0x84, 0x10, 0x8f, 0xfd, 0xc8, 0x97, 0x48, 0xec, 0x38, 0x22, 0x44, 0x78, 0x2a, 0x63, 0x99, 0xb6,
+	0xf1, 0x4c, 0xe4, 0x9c, 0x1e, 0xf6, 0x6a, 0x83, 0x56, 0xd4, 0x76, 0xc8, 0xbd, 0xc8, 0x39, 0x39,
+	0x85, 0x56, 0x52, 0x88, 0x7c, 0xcc, 0x0c, 0xa7, 0x47, 0x6e, 0xe2, 0x2a, 0x26, 0x87, 0xb0, 0x85,
+	0x93, 0x34, 0x3d, 0x76, 0x84, 0x0f, 0xc8, 0x19, 0x80, 0x62, 0xc6, 0xa8, 0xa9, 0xc6, 0x39, 0x27,
+	0xc1, 0x86, 0x15, 0x42, 0xde, 0x43, 0x3b, 0x63, 0x26, 0x56, 0x5a, 0x24, 0x9c, 0x52, 0xbf, 0x64,
+	0xc6, 0xcc, 0x03, 0xc6, 0x25, 0x29, 0xc5, 0x4c, 0x58, 0xfa, 0x6e, 0x45, 0xde, 0x61, 0x4c, 0x2e,
+	0x61, 0xdf, 0x88, 0x2c, 0x67, 0x76, 0xae, 0x79, 0x9c, 0x08, 0x35, 0xe5, 0xda, 0xd0, 0x53, 0x77,
+	0x09, 0xdd, 0x15, 0x31, 0xf2, 0x38, 0xf9, 0x00, 0x3b, 0x76, 0x11, 0x8f, 0x25, 0x4b, 0x1e, 0xf1,
+	0x82, 0xe8, 0x99, 0xd3, 0x75, 0xec, 0xe2, 0xbb, 0x12, 0x22, 0x57, 0x70, 0x64, 0x17, 0x71, 0x52,
+	0xe4, 0x56, 0xb3, 0xc4, 0xc6, 0x4c, 0xca, 0xe2, 0xd9, 0x69, 0xcf, 0x9d, 0xf6, 0xc0, 0x2e, 0x46,
+	0x81, 0xbb, 0x2e, 0x29, 0xd2, 0x73, 0xcb, 0xce, 0x44, 0x1e, 0x3f, 0x31, 0x39, 0xe7, 0xb4, 0xe7,
+	0xf3, 0xb3, 0x8b, 0x7b, 0x91, 0xff, 0x86, 0x08, 0xf9, 0x1d, 0x0e, 0xed, 0x22, 0x56, 0x6c, 0x29,
+	0x0b, 0x96, 0xc6, 0x55, 0xaa, 0x1f, 0x7a, 0x8d, 0x41, 0xe7, 0xea, 0xe3, 0xab, 0xaf, 0x64, 0xf8,
+	0x69, 0xf1, 0xe0, 0x27, 0xfc, 0x14, 0x9c, 0xf8, 0x21, 0xb7, 0x7a, 0x19, 0xed, 0xdb, 0x4d, 0x1c,
+	0xef, 0x11, 0x73, 0xe5, 0x9a, 0x0e, 0xfc, 0x3d, 0xfa, 0x08, 0x2f, 0x6a, 0x22, 0x72, 0x26, 0x85,
+	0x5d, 0xd2, 0xcf, 0xdc, 0x2d, 0xae, 0x62, 0x2c, 0xcd, 0x09, 0x96, 0x5d, 0x32, 0x2d, 0xf0, 0x24,
+	0x9f, 0xfb, 0x33, 0x23, 0x34, 0x72, 0x08, 0xde, 0xa4, 0x14, 0xd9, 0xd4, 0xd2, 0x4b, 0x37, 0xd3,
+	0x07, 0xe8, 0xb7, 0x1b, 0xc4, 0xc9, 0x94, 0x27, 0x8f, 0xaa, 0x10, 0xb9, 0x35, 0xf4, 0xa3, 0xf7,
+	0xdb, 0x11, 0xa3, 0x0a, 0x3f, 0xfd, 0x1e, 0x8e, 0x5f, 0x4f, 0x82, 0x74, 0xa1, 0x81, 0x0f, 0xa2,
+	0xe6, 0x76, 0xc5, 0x21, 0x6e, 0xe7, 0xdd, 0xf3, 0xd5, 0xeb, 0x83, 0x6f, 0xea, 0x5f, 0xd7, 0xfa,
+	0xff, 0xd4, 0xa0, 0xbd, 0x6a, 0x09, 0x58, 0x9b, 0x5a, 0x25, 0x71, 0x78, 0x6e, 0xfe, 0x11, 0xb6,
+	0xb5, 0x4a, 0xee, 0x56, 0x2f, 0x6e, 0x6a, 0xad, 0x8a, 0x5f, 0x3c, 0x47, 0x40, 0x68, 0x43, 0x30,
+	0x2b, 0xd2, 0xb9, 0xe4, 0xb4, 0x51, 0x09, 0xee, 0x1d, 0x82, 0x19, 0x26, 0x45, 0x9e, 0xf3, 0xc4,
+	0x8a, 0x22, 0xf7, 0x55, 0x67, 0xdc, 0xcb, 0xdc, 0x8a, 0xba, 0x15, 0xe1, 0xaa, 0xcf, 0xf4, 0xff,
+	0xad, 0x41, 0x7b, 0xd5, 0x30, 0xb0, 0x52, 0x65, 0x91, 0xc5, 0x92, 0x3f, 0x71, 0x19, 0x72, 0x6b,
+	0xc9, 0x22, 0xbb, 0xc3, 0x18, 0xdf, 0x2e, 0x92, 0x13, 0x21, 0xcb, 0x1c, 0xb7, 0x65, 0x91, 0xfd,
+	0x28, 0x24, 0x27, 0x27, 0x80, 0xc3, 0x98, 0x65, 0xdc, 0xb5, 0x88, 0xdd, 0xa8, 0x29, 0x8b, 0xec,
+	0x3a, 0xe3, 0x64, 0x08, 0x07, 0x3c, 0x67, 0x63, 0xc9, 0xe3, 0x44, 0x33, 0x33, 0x8d, 0x35, 0x57,
+	0x85, 0xb6, 0xee, 0x34, 0xad, 0x68, 0xdf, 0x53, 0x23, 0x64, 0x22, 0x47, 0x90, 0x01, 0x74, 0xd7,
+	0x85, 0xf1, 0x5c, 0x4b, 0xba, 0xe5, 0xf6, 0xda, 0x4b, 0x2a, 0xd9, 0xaf, 0x5a, 0x62, 0x53, 0x55,
+	0x4a, 0x17, 0x13, 0xda, 0xdc, 0x6c, 0xaa, 0x0f, 0x08, 0x97, 0x4d, 0xd5, 0x69, 0xb0, 0x83, 0x3c,
+	0x71, 0x6d, 0x44, 0x91, 0xbb, 0x1e, 0xdc, 0x8e, 0xca, 0xb0, 0x9f, 0x43, 0x67, 0x4d, 0xbf, 0xe9,
+	0xbe, 0xb7, 0x60, 0xdd, 0xfd, 0x33, 0x80, 0x44, 0xcd, 0x71, 0x46, 0x65, 0xc3, 0x1a, 0x82, 0xfc,
+	0x8c, 0xcf, 0x4a, 0x3e, 0xf4, 0xcb, 0x0a, 0xe9, 0xdf, 0x02, 0x54, 0x8d, 0x9c, 0x7c, 0x0b, 0xef,
+	0x53, 0x3e, 0x61, 0x73, 0x69, 0xb1, 0xbd, 0x1a, 0x5b, 0x68, 0xee, 0xfc, 0xc5, 0x46, 0xc0, 0x75,
+	0xd8, 0x9e, 0x06, 0xc9, 0x6d, 0x50, 0xa0, 0xe3, 0x23, 0xe4, 0xfb, 0x7f, 0xd6, 0xa1, 0xb3, 0xf6,
+	0x09, 0x21, 0x17, 0xb0, 0x17, 0xdc, 0x9e, 0x71, 0xab, 0x45, 0x62, 0xdc, 0x0a, 0xad, 0x68, 0xd7,
+	0xa3, 0xf7, 0x1e, 0x24, 0x0f, 0xd0, 0xf5, 0xf6, 0x8a, 0x3c, 0x2b, 0xcb, 0x08, 0xeb, 0x6c, 0xef,
+	0xea, 0xe2, 0xd5, 0x4f, 0xd3, 0x30, 0x2a, 0xd5, 0xbe, 0xc2, 0xa2, 0xb7, 0xfa, 0x25, 0x40, 0xbe,
+	0x82, 0x96, 0xc8, 0x27, 0x72, 0xbe, 0x48, 0xc7, 0xae, 0x45, 0x77, 0xae, 0x68, 0xb5, 0xd2, 0x4d,
+	0x60, 0xc2, 0x95, 0xac, 0x94, 0xd8, 0xcd, 0xc2, 0x39, 0x63, 0xcb, 0x32, 0x43, 0x77, 0x7c, 0x37,
+	0x0b, 0xd8, 0x27, 0x96, 0x99, 0xfe, 0x39, 0xbc, 0xdd, 0xd8, 0x9c, 0xec, 0x40, 0xab, 0x5c, 0xb1,
+	0xfb, 0xbf, 0xfe, 0x02, 0xf6, 0x5e, 0xae, 0x8f, 0x9f, 0xb7, 0x69, 0x61, 0x6c, 0x30, 0xcf, 0x8d,
+	0x11, 0x73, 0x75, 0x57, 0x77, 0xc5, 0xe9, 0xc6, 0x64, 0x0f, 0xea, 0xe9, 0x38, 0xdc, 0x50, 0x3d,
+	0x1d, 0xa3, 0x66, 0x6e, 0xb8, 0x76, 0xb5, 0xd9, 0x8e, 0xdc, 0x18, 0xfb, 0x0f, 0x36, 0xf9, 0xe7,
+	0x42, 0xa7, 0xa1, 0x0c, 0x57, 0xf1, 0xb8, 0xe9, 0x7e, 0x3c, 0xbe, 0xfc, 0x6f, 0x00, 0x88, 0xab,
+	0x8c, 0x2a, 0x88, 0x08, 0x00, 0x00,
 }
@@ -0,0 +1,94 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core"
+)
+
+// Errors
+var (
+	ErrMissingBlock = errors.New("missing block in nr window")
+)
+
+// CollectGraph builds the transfer graph from the successful txs in the
+// window blocks ending at tail, tail included, the genesis block is excluded.
+// The balance of every address in the graph is sampled after each of the blocks.
+func CollectGraph(chain *core.BlockChain, tail *core.Block, window int) (*Graph, error) {
+	graph := NewGraph()
+	blocks := make([]*core.Block, 0)
+	block := tail
+	for i := 0; i < window && !core.CheckGenesisBlock(block); i++ {
+		for _, tx := range block.Transactions() {
+			succeed, err := ExecutionSucceed(block, tx)
+			if err != nil {
+				return nil, err
+			}
+			if succeed {
+				graph.AddTransfer(tx.From().String(), tx.To().String(), tx.Value())
+			}
+		}
+		blocks = append(blocks, block)
+		parent := chain.GetBlock(block.ParentHash())
+		if parent == nil {
+			return nil, ErrMissingBlock
+		}
+		block = parent
+	}
+
+	for _, node := range graph.Nodes() {
+		addr, err := core.AddressParse(node.Address)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range blocks {
+			balance, err := block.GetBalance(addr.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			node.AddBalance(balance)
+		}
+	}
+	return graph, nil
+}
+
+// ExecutionSucceed returns whether the tx in the block is executed successfully.
+func ExecutionSucceed(block *core.Block, tx *core.Transaction) (bool, error) {
+	events, err := block.FetchEvents(tx.Hash())
+	if err != nil {
+		return false, err
+	}
+	for _, v := range events {
+		if v.Topic == core.TopicTransactionExecutionResult {
+			txEvent := core.TransactionEvent{}
+			if err := json.Unmarshal([]byte(v.Data), &txEvent); err != nil {
+				return false, err
+			}
+			return txEvent.Status == core.TxExecutionSuccess, nil
+		} else if v.Topic == core.TopicExecuteTxSuccess {
+			return true, nil
+		} else if v.Topic == core.TopicExecuteTxFailed {
+			return false, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Node is an address in the transfer graph.
+type Node struct {
+	Address  string
+	InValue  *util.Uint128
+	OutValue *util.Uint128
+
+	in       map[string]bool
+	out      map[string]bool
+	balances []*util.Uint128
+}
+
+// InDegree returns the count of addresses transferred to the node.
+func (n *Node) InDegree() int {
+	return len(n.in)
+}
+
+// OutDegree returns the count of addresses the node transferred to.
+func (n *Node) OutDegree() int {
+	return len(n.out)
+}
+
+// AddBalance records a balance sample of the node.
+func (n *Node) AddBalance(balance *util.Uint128) {
+	n.balances = append(n.balances, balance)
+}
+
+// MedianBalance returns the median of the balance samples, zero if there is no sample.
+// A median is hard to pump by moving value in and out right before sampling.
+func (n *Node) MedianBalance() *util.Uint128 {
+	if len(n.balances) == 0 {
+		return util.NewUint128()
+	}
+	sorted := make([]*util.Uint128, len(n.balances))
+	copy(sorted, n.balances)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j].Int) < 0
+	})
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return util.NewUint128FromBigInt(new(big.Int).Set(sorted[mid].Int))
+	}
+	median := new(big.Int).Add(sorted[mid-1].Int, sorted[mid].Int)
+	return util.NewUint128FromBigInt(median.Rsh(median, 1))
+}
+
+// Graph is the value transfer graph between addresses.
+type Graph struct {
+	nodes map[string]*Node
+}
+
+// NewGraph create a new Graph
+func NewGraph() *Graph {
+	return &Graph{nodes: make(map[string]*Node)}
+}
+
+func (g *Graph) getOrCreateNode(addr string) *Node {
+	node, ok := g.nodes[addr]
+	if !ok {
+		node = &Node{
+			Address:  addr,
+			InValue:  util.NewUint128(),
+			OutValue: util.NewUint128(),
+			in:       make(map[string]bool),
+			out:      make(map[string]bool),
+		}
+		g.nodes[addr] = node
+	}
+	return node
+}
+
+// AddTransfer add a transfer into graph, transfers to self or without value are ignored.
+func (g *Graph) AddTransfer(from, to string, value *util.Uint128) {
+	if from == to || value.Sign() <= 0 {
+		return
+	}
+	fromNode := g.getOrCreateNode(from)
+	toNode := g.getOrCreateNode(to)
+	fromNode.OutValue.Add(fromNode.OutValue.Int, value.Int)
+	fromNode.out[to] = true
+	toNode.InValue.Add(toNode.InValue.Int, value.Int)
+	toNode.in[from] = true
+}
+
+// Node returns the node of address, nil if the address has no transfer.
+func (g *Graph) Node(addr string) *Node {
+	return g.nodes[addr]
+}
+
+// Nodes returns all nodes sorted by address.
+func (g *Graph) Nodes() []*Node {
+	nodes := make([]*Node, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Address < nodes[j].Address
+	})
+	return nodes
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/nr/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph(t *testing.T) {
+	graph := NewGraph()
+	graph.AddTransfer("a", "b", util.NewUint128FromInt(100))
+	graph.AddTransfer("a", "b", util.NewUint128FromInt(50))
+	graph.AddTransfer("b", "c", util.NewUint128FromInt(100))
+	graph.AddTransfer("c", "a", util.NewUint128FromInt(25))
+
+	// ignored transfers
+	graph.AddTransfer("a", "a", util.NewUint128FromInt(100))
+	graph.AddTransfer("a", "d", util.NewUint128())
+
+	assert.Nil(t, graph.Node("d"))
+	nodes := graph.Nodes()
+	assert.Equal(t, 3, len(nodes))
+	assert.Equal(t, "a", nodes[0].Address)
+
+	a := graph.Node("a")
+	assert.Equal(t, 1, a.InDegree())
+	assert.Equal(t, 1, a.OutDegree())
+	assert.Equal(t, util.NewUint128FromInt(25), a.InValue)
+	assert.Equal(t, util.NewUint128FromInt(150), a.OutValue)
+}
+
+func TestRank(t *testing.T) {
+	graph := NewGraph()
+	graph.AddTransfer("a", "b", util.NewUint128FromInt(100))
+	graph.AddTransfer("b", "c", util.NewUint128FromInt(100))
+	graph.AddTransfer("b", "d", util.NewUint128FromInt(300))
+	graph.AddTransfer("d", "b", util.NewUint128FromInt(400))
+
+	scores := Rank(graph)
+	// only sent or only received
+	assert.Equal(t, util.NewUint128(), scores["a"])
+	assert.Equal(t, util.NewUint128(), scores["c"])
+	// 4 degrees * sqrt(min(500, 400))
+	assert.Equal(t, util.NewUint128FromInt(80), scores["b"])
+	// 2 degrees * sqrt(min(300, 400))
+	assert.Equal(t, util.NewUint128FromInt(34), scores["d"])
+}
+
+func TestMedianBalance(t *testing.T) {
+	graph := NewGraph()
+	graph.AddTransfer("a", "b", util.NewUint128FromInt(100))
+	a := graph.Node("a")
+	assert.Equal(t, util.NewUint128(), a.MedianBalance())
+
+	a.AddBalance(util.NewUint128FromInt(30))
+	a.AddBalance(util.NewUint128FromInt(1000))
+	a.AddBalance(util.NewUint128FromInt(10))
+	assert.Equal(t, util.NewUint128FromInt(30), a.MedianBalance())
+	a.AddBalance(util.NewUint128FromInt(50))
+	assert.Equal(t, util.NewUint128FromInt(40), a.MedianBalance())
+}
+
+func TestRankWithBalance(t *testing.T) {
+	graph := NewGraph()
+	graph.AddTransfer("a", "b", util.NewUint128FromInt(100))
+	graph.AddTransfer("b", "a", util.NewUint128FromInt(100))
+	graph.Node("a").AddBalance(util.NewUint128FromInt(1))
+	graph.Node("b").AddBalance(util.NewUint128FromInt(1024))
+
+	scores := Rank(graph)
+	// 2 degrees * sqrt(100) * (1 + log2(1))
+	assert.Equal(t, util.NewUint128FromInt(40), scores["a"])
+	// 2 degrees * sqrt(100) * (1 + log2(1024))
+	assert.Equal(t, util.NewUint128FromInt(240), scores["b"])
+}
+
+func TestPeriod(t *testing.T) {
+	start, end := PeriodHeights(0, 10)
+	assert.Equal(t, uint64(2), start)
+	assert.Equal(t, uint64(11), end)
+	start, end = PeriodHeights(3, 10)
+	assert.Equal(t, uint64(32), start)
+	assert.Equal(t, uint64(41), end)
+
+	stor, _ := storage.NewMemoryStorage()
+	_, err := Load(stor, 3, 10)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	data := &nrpb.NRData{
+		Period:      3,
+		Window:      10,
+		StartHeight: start,
+		EndHeight:   end,
+		Items: []*nrpb.NRItem{
+			{Address: "a", Score: "10"},
+			{Address: "c", Score: "20"},
+		},
+	}
+	assert.Nil(t, Save(stor, data))
+	loaded, err := Load(stor, 3, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, data, loaded)
+	_, err = Load(stor, 3, 20)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	assert.Equal(t, "20", Find(loaded, "c").Score)
+	assert.Nil(t, Find(loaded, "b"))
+	assert.Nil(t, Find(loaded, "d"))
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nr.proto
+
+/*
+Package nrpb is a generated protocol buffer package.
+
+It is generated from these files:
+	nr.proto
+
+It has these top-level messages:
+	NRData
+	NRItem
+*/
+package nrpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// NRData is the nebulas rank of a period.
+type NRData struct {
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// heights of the first and last blocks in the period
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// sorted by address
+	Items []*NRItem `protobuf:"bytes,5,rep,name=items" json:"items,omitempty"`
+}
+
+func (m *NRData) Reset()                    { *m = NRData{} }
+func (m *NRData) String() string            { return proto.CompactTextString(m) }
+func (*NRData) ProtoMessage()               {}
+func (*NRData) Descriptor() ([]byte, []int) { return fileDescriptorNr, []int{0} }
+
+func (m *NRData) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *NRData) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *NRData) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *NRData) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *NRData) GetItems() []*NRItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type NRItem struct {
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	InValue       string `protobuf:"bytes,2,opt,name=in_value,json=inValue,proto3" json:"in_value,omitempty"`
+	OutValue      string `protobuf:"bytes,3,opt,name=out_value,json=outValue,proto3" json:"out_value,omitempty"`
+	InDegree      uint32 `protobuf:"varint,4,opt,name=in_degree,json=inDegree,proto3" json:"in_degree,omitempty"`
+	OutDegree     uint32 `protobuf:"varint,5,opt,name=out_degree,json=outDegree,proto3" json:"out_degree,omitempty"`
+	MedianBalance string `protobuf:"bytes,6,opt,name=median_balance,json=medianBalance,proto3" json:"median_balance,omitempty"`
+	Score         string `protobuf:"bytes,7,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *NRItem) Reset()                    { *m = NRItem{} }
+func (m *NRItem) String() string            { return proto.CompactTextString(m) }
+func (*NRItem) ProtoMessage()               {}
+func (*NRItem) Descriptor() ([]byte, []int) { return fileDescriptorNr, []int{1} }
+
+func (m *NRItem) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NRItem) GetInValue() string {
+	if m != nil {
+		return m.InValue
+	}
+	return ""
+}
+
+func (m *NRItem) GetOutValue() string {
+	if m != nil {
+		return m.OutValue
+	}
+	return ""
+}
+
+func (m *NRItem) GetInDegree() uint32 {
+	if m != nil {
+		return m.InDegree
+	}
+	return 0
+}
+
+func (m *NRItem) GetOutDegree() uint32 {
+	if m != nil {
+		return m.OutDegree
+	}
+	return 0
+}
+
+func (m *NRItem) GetMedianBalance() string {
+	if m != nil {
+		return m.MedianBalance
+	}
+	return ""
+}
+
+func (m *NRItem) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NRData)(nil), "nrpb.NRData")
+	proto.RegisterType((*NRItem)(nil), "nrpb.NRItem")
+}
+
+func init() { proto.RegisterFile("nr.proto", fileDescriptorNr) }
+
+var fileDescriptorNr = []byte{
+	// 270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xbd, 0x6a, 0xc3, 0x30,
+	0x14, 0x46, 0x49, 0xfd, 0x7f, 0x93, 0x74, 0x10, 0xa5, 0xa8, 0x94, 0x42, 0x6a, 0x28, 0x64, 0xf2,
+	0xd0, 0xbe, 0x41, 0xc9, 0xd0, 0x2e, 0x19, 0x34, 0x74, 0x35, 0x72, 0x74, 0x49, 0x04, 0xb1, 0x64,
+	0x64, 0xb9, 0x79, 0x9b, 0xbe, 0x54, 0x5f, 0xa8, 0xe8, 0xca, 0xe9, 0xf8, 0x9d, 0x23, 0x5f, 0x0e,
+	0x18, 0x4a, 0xe3, 0x9a, 0xc1, 0x59, 0x6f, 0x59, 0x6a, 0xdc, 0xd0, 0xd5, 0x3f, 0x0b, 0xc8, 0xf7,
+	0x62, 0x27, 0xbd, 0x64, 0xf7, 0x90, 0x0f, 0xe8, 0xb4, 0x55, 0x7c, 0xb1, 0x59, 0x6c, 0x53, 0x31,
+	0xaf, 0xc0, 0x2f, 0xda, 0x28, 0x7b, 0xe1, 0x37, 0x91, 0xc7, 0xc5, 0x9e, 0x61, 0x35, 0x7a, 0xe9,
+	0x7c, 0x7b, 0x42, 0x7d, 0x3c, 0x79, 0x9e, 0x90, 0x5d, 0x12, 0xfb, 0x20, 0xc4, 0x9e, 0x00, 0xd0,
+	0xa8, 0xeb, 0x83, 0x94, 0x1e, 0x54, 0x68, 0xd4, 0xac, 0x6b, 0xc8, 0xb4, 0xc7, 0x7e, 0xe4, 0xd9,
+	0x26, 0xd9, 0x2e, 0x5f, 0x57, 0x4d, 0x48, 0x6a, 0xf6, 0xe2, 0xd3, 0x63, 0x2f, 0xa2, 0xaa, 0x7f,
+	0x29, 0x30, 0x10, 0xc6, 0xa1, 0x90, 0x4a, 0x39, 0x1c, 0x47, 0x2a, 0xac, 0xc4, 0x75, 0xb2, 0x07,
+	0x28, 0xb5, 0x69, 0xbf, 0xe5, 0x79, 0x42, 0x8a, 0xac, 0x44, 0xa1, 0xcd, 0x57, 0x98, 0xec, 0x11,
+	0x2a, 0x3b, 0xf9, 0xd9, 0x25, 0xe4, 0x4a, 0x3b, 0xf9, 0x7f, 0xa9, 0x4d, 0xab, 0xf0, 0xe8, 0x10,
+	0x29, 0x6f, 0x2d, 0x4a, 0x6d, 0x76, 0xb4, 0x43, 0x7c, 0xf8, 0x72, 0xb6, 0x19, 0xd9, 0x70, 0x6b,
+	0xd6, 0x2f, 0x70, 0xdb, 0xa3, 0xd2, 0xd2, 0xb4, 0x9d, 0x3c, 0x4b, 0x73, 0x40, 0x9e, 0xd3, 0xf5,
+	0x75, 0xa4, 0xef, 0x11, 0xb2, 0x3b, 0xc8, 0xc6, 0x83, 0x75, 0xc8, 0x0b, 0xb2, 0x71, 0x74, 0x39,
+	0xfd, 0x83, 0xb7, 0xbf, 0x01, 0x00, 0xa9, 0x9f, 0xac, 0x55, 0x8f, 0x01, 0x00, 0x00,
+}
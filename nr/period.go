@@ -0,0 +1,162 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nr/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultWindow is the default count of blocks in a nr period.
+const DefaultWindow = 1000
+
+// Errors
+var (
+	ErrInvalidWindow         = errors.New("invalid nr window")
+	ErrPeriodNotFinished     = errors.New("nr period not finished yet")
+	ErrPeriodNotIrreversible = errors.New("nr period not irreversible yet")
+)
+
+// GenesisWindow returns the nr window in the genesis, DefaultWindow if not set.
+func GenesisWindow(genesis *corepb.Genesis) uint64 {
+	if conf := genesis.Consensus.Pod; conf != nil && conf.NrWindow > 0 {
+		return conf.NrWindow
+	}
+	return DefaultWindow
+}
+
+// PeriodHeights returns the heights of the first and last blocks in the period.
+// Periods are numbered from 0 and count from the block after genesis.
+func PeriodHeights(period, window uint64) (uint64, uint64) {
+	start := period*window + 2
+	return start, start + window - 1
+}
+
+// Compute computes the nebulas rank of the period on the canonical chain.
+// The period must be irreversible, so the result can't be changed by a reorg.
+func Compute(chain *core.BlockChain, period, window uint64) (*nrpb.NRData, error) {
+	if window == 0 {
+		return nil, ErrInvalidWindow
+	}
+	start, end := PeriodHeights(period, window)
+	if end > chain.TailBlock().Height() {
+		return nil, ErrPeriodNotFinished
+	}
+	if end > chain.LatestIrreversibleBlock().Height() {
+		return nil, ErrPeriodNotIrreversible
+	}
+	tail := chain.GetBlockOnCanonicalChainByHeight(end)
+	if tail == nil {
+		return nil, ErrMissingBlock
+	}
+	graph, err := CollectGraph(chain, tail, int(window))
+	if err != nil {
+		return nil, err
+	}
+
+	data := &nrpb.NRData{
+		Period:      period,
+		Window:      window,
+		StartHeight: start,
+		EndHeight:   end,
+	}
+	for _, node := range graph.Nodes() {
+		data.Items = append(data.Items, &nrpb.NRItem{
+			Address:       node.Address,
+			InValue:       node.InValue.String(),
+			OutValue:      node.OutValue.String(),
+			InDegree:      uint32(node.InDegree()),
+			OutDegree:     uint32(node.OutDegree()),
+			MedianBalance: node.MedianBalance().String(),
+			Score:         Score(node).String(),
+		})
+	}
+	return data, nil
+}
+
+func periodKey(period, window uint64) []byte {
+	return []byte(fmt.Sprintf("nr_%d_%d", window, period))
+}
+
+// Save stores the nebulas rank of a period, the data must be computed on an irreversible period.
+func Save(stor storage.Storage, data *nrpb.NRData) error {
+	bytes, err := proto.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return stor.Put(periodKey(data.Period, data.Window), bytes)
+}
+
+// Load loads the stored nebulas rank of a period.
+func Load(stor storage.Storage, period, window uint64) (*nrpb.NRData, error) {
+	bytes, err := stor.Get(periodKey(period, window))
+	if err != nil {
+		return nil, err
+	}
+	data := new(nrpb.NRData)
+	if err := proto.Unmarshal(bytes, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// LoadOrCompute loads the nebulas rank of a period, computes and stores it if not found.
+func LoadOrCompute(chain *core.BlockChain, period, window uint64) (*nrpb.NRData, error) {
+	data, err := Load(chain.Storage(), period, window)
+	if err == nil {
+		return data, nil
+	}
+	if err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+
+	data, err = Compute(chain, period, window)
+	if err != nil {
+		return nil, err
+	}
+	if err := Save(chain.Storage(), data); err != nil {
+		return nil, err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"period": period,
+		"window": window,
+		"nodes":  len(data.Items),
+	}).Info("Computed nebulas rank.")
+	return data, nil
+}
+
+// Find returns the item of the address in the period, nil if the address has no transfer.
+func Find(data *nrpb.NRData, address string) *nrpb.NRItem {
+	i := sort.Search(len(data.Items), func(i int) bool {
+		return data.Items[i].Address >= address
+	})
+	if i < len(data.Items) && data.Items[i].Address == address {
+		return data.Items[i]
+	}
+	return nil
+}
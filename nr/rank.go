@@ -0,0 +1,51 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Score returns the NR score of a node,
+// (in degree + out degree) * sqrt(min(in value, out value)) * (1 + log2(median balance)).
+// Only the value both received and sent out counts, which rewards addresses
+// circulating value with many others rather than holding or draining it,
+// the median balance weighs in logarithmically so holdings can't dominate the rank.
+// Scores are integers so all nodes compute the same ranking.
+func Score(node *Node) *util.Uint128 {
+	flow := node.InValue.Int
+	if node.OutValue.Cmp(flow) < 0 {
+		flow = node.OutValue.Int
+	}
+	score := new(big.Int).Sqrt(flow)
+	score.Mul(score, big.NewInt(int64(node.InDegree()+node.OutDegree())))
+	score.Mul(score, big.NewInt(int64(1+node.MedianBalance().BitLen())))
+	return util.NewUint128FromBigInt(score)
+}
+
+// Rank computes the NR scores of all addresses in the graph.
+func Rank(g *Graph) map[string]*util.Uint128 {
+	scores := make(map[string]*util.Uint128)
+	for addr, node := range g.nodes {
+		scores[addr] = Score(node)
+	}
+	return scores
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Service computes and stores the nebulas rank of each period once it is irreversible,
+// so the rank can be read by the rpc without the offline neb nr compute.
+type Service struct {
+	quitCh chan bool
+
+	chain   *core.BlockChain
+	emitter *core.EventEmitter
+	events  *core.EventSubscriber
+	window  uint64
+
+	next uint64
+}
+
+// NewService create a new Service computing the periods of the window.
+func NewService(chain *core.BlockChain, emitter *core.EventEmitter, window uint64) *Service {
+	return &Service{
+		quitCh:  make(chan bool, 1),
+		chain:   chain,
+		emitter: emitter,
+		events:  core.NewEventSubscriber(16, []string{core.TopicLibBlock}),
+		window:  window,
+	}
+}
+
+// Start start nr service.
+func (s *Service) Start() {
+	logging.CLog().Info("Starting NR Service...")
+	s.emitter.Register(s.events)
+	go s.loop()
+}
+
+// Stop stop nr service.
+func (s *Service) Stop() {
+	logging.CLog().Info("Stopping NR Service...")
+	s.emitter.Deregister(s.events)
+	s.quitCh <- true
+}
+
+func (s *Service) loop() {
+	logging.CLog().Info("Started NR Service.")
+	s.computeIrreversible()
+	for {
+		select {
+		case <-s.events.EventChan():
+			s.computeIrreversible()
+		case <-s.quitCh:
+			logging.CLog().Info("Stopped NR Service.")
+			return
+		}
+	}
+}
+
+// computeIrreversible computes the periods ending before the latest irreversible block,
+// the periods already stored are skipped.
+func (s *Service) computeIrreversible() {
+	lib := s.chain.LatestIrreversibleBlock().Height()
+	for {
+		if _, end := PeriodHeights(s.next, s.window); end > lib {
+			return
+		}
+		if _, err := LoadOrCompute(s.chain, s.next, s.window); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"period": s.next,
+				"window": s.window,
+				"err":    err,
+			}).Error("Failed to compute nebulas rank.")
+			return
+		}
+		s.next++
+	}
+}
@@ -43,6 +43,7 @@ func (s *AdminService) NewAccount(ctx context.Context, req *rpcpb.NewAccountRequ
 	if err != nil {
 		return nil, err
 	}
+	neb.BlockChain().TransactionPool().AddLocalAccount(addr)
 	return &rpcpb.NewAccountResponse{Address: addr.String()}, nil
 }
 
@@ -141,6 +142,67 @@ func (s *AdminService) StatisticsNodeInfo(ctx context.Context, req *rpcpb.NonPar
 	return resp, nil
 }
 
+// ConsensusState returns the state and recent transitions of the mining state machine.
+func (s *AdminService) ConsensusState(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.ConsensusStateResponse, error) {
+
+	neb := s.server.Neblet()
+	resp := &rpcpb.ConsensusStateResponse{
+		State:       neb.Consensus().State(),
+		Transitions: []*rpcpb.ConsensusTransition{},
+	}
+	for _, v := range neb.Consensus().Transitions() {
+		resp.Transitions = append(resp.Transitions, &rpcpb.ConsensusTransition{
+			From:      v.From,
+			To:        v.To,
+			Event:     string(v.Event),
+			Timestamp: v.Timestamp,
+		})
+	}
+	return resp, nil
+}
+
+// ForkTree returns the block tree above LIB
+func (s *AdminService) ForkTree(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.ForkTreeResponse, error) {
+
+	neb := s.server.Neblet()
+	tree, err := neb.BlockChain().ForkTree()
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.ForkTreeResponse{
+		Rule:   tree.Rule,
+		Lib:    toForkBlock(tree.LIB, true),
+		Tail:   toForkBlock(tree.Tail, true),
+		Blocks: []*rpcpb.ForkBlock{},
+		Tails:  []*rpcpb.ForkTail{},
+	}
+	for _, v := range tree.Blocks {
+		resp.Blocks = append(resp.Blocks, toForkBlock(v.Block, v.Canonical))
+	}
+	for _, v := range tree.Tails {
+		resp.Tails = append(resp.Tails, &rpcpb.ForkTail{
+			Block:    toForkBlock(v.Block, v.Block.Hash().Equals(tree.Tail.Hash())),
+			Ancestor: toForkBlock(v.Ancestor, true),
+		})
+	}
+	return resp, nil
+}
+
+func toForkBlock(block *core.Block, canonical bool) *rpcpb.ForkBlock {
+	miner := ""
+	if block.Miner() != nil {
+		miner = block.Miner().String()
+	}
+	return &rpcpb.ForkBlock{
+		Hash:       block.Hash().String(),
+		ParentHash: block.ParentHash().String(),
+		Height:     block.Height(),
+		Miner:      miner,
+		Timestamp:  block.Timestamp(),
+		Canonical:  canonical,
+	}
+}
+
 // ChangeNetworkID change the network id
 func (s *AdminService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNetworkIDRequest) (*rpcpb.ChangeNetworkIDResponse, error) {
 
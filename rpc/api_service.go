@@ -26,11 +26,16 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/finality"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/dip"
+	"github.com/nebulasio/go-nebulas/light"
 	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nr"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"golang.org/x/net/context"
@@ -46,13 +51,23 @@ func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsReques
 
 	neb := s.server.Neblet()
 
-	tail := neb.BlockChain().TailBlock()
-
 	resp := &rpcpb.GetNebStateResponse{}
 	resp.ChainId = neb.BlockChain().ChainID()
-	resp.Tail = tail.Hash().String()
-	resp.Height = tail.Height()
-	resp.Coinbase = tail.Coinbase().String()
+	if lc := neb.LightClient(); lc != nil {
+		tail := lc.Tail()
+		coinbase, err := core.AddressParseFromBytes(tail.Header.Coinbase)
+		if err != nil {
+			return nil, err
+		}
+		resp.Tail = byteutils.Hash(tail.Header.Hash).String()
+		resp.Height = tail.Height
+		resp.Coinbase = coinbase.String()
+	} else {
+		tail := neb.BlockChain().TailBlock()
+		resp.Tail = tail.Hash().String()
+		resp.Height = tail.Height()
+		resp.Coinbase = tail.Coinbase().String()
+	}
 	resp.Synchronized = neb.NetService().Node().IsSynchronizing()
 	resp.PeerCount = uint32(neb.NetService().Node().PeersCount())
 	resp.ProtocolVersion = net.NebProtocolID
@@ -117,6 +132,16 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 		return nil, err
 	}
 
+	if lc := neb.LightClient(); lc != nil {
+		resp, err := lightAccountState(lc, addr, req.Height)
+		if err != nil {
+			metricsAccountStateFailed.Mark(1)
+			return nil, err
+		}
+		metricsAccountStateSuccess.Mark(1)
+		return resp, nil
+	}
+
 	block := neb.BlockChain().TailBlock()
 	if req.Height > 0 {
 		block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
@@ -139,6 +164,56 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: fmt.Sprintf("%d", nonce)}, nil
 }
 
+// lightAccountState returns the account state verified by the merkle proof from a full peer.
+func lightAccountState(lc *light.Client, addr *core.Address, height uint64) (*rpcpb.GetAccountStateResponse, error) {
+	account, err := lc.GetAccount(addr, height)
+	if err == light.ErrNotFoundByPeer {
+		// the peer proves no absence, an empty account would trust a peer withholding the state.
+		return nil, errors.New("account absence not provable by the light client")
+	}
+	if err != nil {
+		return nil, err
+	}
+	balance, err := util.NewUint128FromFixedSizeByteSlice(account.Balance)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: fmt.Sprintf("%d", account.Nonce)}, nil
+}
+
+// GetAccountStorage is the RPC API handler.
+func (s *APIService) GetAccountStorage(ctx context.Context, req *rpcpb.GetAccountStorageRequest) (*rpcpb.GetAccountStorageResponse, error) {
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	key, err := byteutils.FromHex(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	var value []byte
+	if lc := neb.LightClient(); lc != nil {
+		value, err = lc.GetStorage(addr, key, req.Height)
+	} else {
+		block := neb.BlockChain().TailBlock()
+		if req.Height > 0 {
+			block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+			if block == nil {
+				return nil, errors.New("block not found")
+			}
+		}
+		value, err = block.GetStorage(addr.Bytes(), key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetAccountStorageResponse{Value: byteutils.Hex(value)}, nil
+}
+
 // SendTransaction is the RPC API handler.
 func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
 
@@ -202,13 +277,50 @@ func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transa
 		payload, err = core.NewCallPayload(reqTx.Contract.Function, reqTx.Contract.Args).ToBytes()
 	} else if reqTx.Candidate != nil {
 		payloadType = core.TxPayloadCandidateType
-		payload, err = core.NewCandidatePayload(reqTx.Candidate.Action).ToBytes()
+		candidate := core.NewCandidatePayload(reqTx.Candidate.Action)
+		candidate.Name = reqTx.Candidate.Name
+		candidate.URL = reqTx.Candidate.Url
+		candidate.Endpoint = reqTx.Candidate.Endpoint
+		candidate.Commission = reqTx.Candidate.Commission
+		payload, err = candidate.ToBytes()
 	} else if reqTx.Delegate != nil {
 		payloadType = core.TxPayloadDelegateType
-		payload, err = core.NewDelegatePayload(reqTx.Delegate.Action, reqTx.Delegate.Delegatee).ToBytes()
+		delegate := core.NewDelegatePayload(reqTx.Delegate.Action, reqTx.Delegate.Delegatee)
+		delegate.Value = reqTx.Delegate.Value
+		payload, err = delegate.ToBytes()
+	} else if reqTx.Batch != nil {
+		payloadType = core.TxPayloadBatchType
+		items := make([]*core.BatchItem, len(reqTx.Batch.Items))
+		for i, item := range reqTx.Batch.Items {
+			items[i] = &core.BatchItem{To: item.To, Value: item.Value, Function: item.Function, Args: item.Args}
+		}
+		payload, err = core.NewBatchPayload(items).ToBytes()
+	} else if len(reqTx.Evidence) > 0 {
+		payloadType = core.TxPayloadEvidenceType
+		var evidence *core.EvidencePayload
+		if evidence, err = core.LoadEvidencePayload([]byte(reqTx.Evidence)); err == nil {
+			payload, err = evidence.ToBytes()
+		}
+	} else if reqTx.Claim {
+		payloadType = core.TxPayloadClaimType
+		payload, err = core.NewClaimPayload().ToBytes()
+	} else if reqTx.Unjail {
+		payloadType = core.TxPayloadUnjailType
+		payload, err = core.NewUnjailPayload().ToBytes()
+	} else if reqTx.Proposal != nil {
+		payloadType = core.TxPayloadProposalType
+		proposal := &core.ProposalPayload{
+			Action:   reqTx.Proposal.Action,
+			Param:    reqTx.Proposal.Param,
+			Value:    reqTx.Proposal.Value,
+			Height:   reqTx.Proposal.Height,
+			Proposal: reqTx.Proposal.Proposal,
+			Approve:  reqTx.Proposal.Approve,
+		}
+		payload, err = proposal.ToBytes()
 	} else {
 		payloadType = core.TxPayloadBinaryType
-		if neb.BlockChain().TailBlock().Height() > core.OptimizeHeight {
+		if neb.BlockChain().TailBlock().ChainRules().IsOptimize {
 			payload, err = core.NewBinaryPayload(reqTx.Binary).ToBytes()
 		}
 	}
@@ -217,6 +329,7 @@ func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transa
 	}
 
 	tx := core.NewTransaction(neb.BlockChain().ChainID(), fromAddr, toAddr, value, reqTx.Nonce, payloadType, payload, gasPrice, gasLimit)
+	tx.SetValidUntil(reqTx.ValidUntilHeight, reqTx.ValidUntilTimestamp)
 	return tx, nil
 }
 
@@ -242,6 +355,10 @@ func handleTransactionResponse(neb Neblet, tx *core.Transaction) (resp *rpcpb.Se
 		if !tx.From().Equals(tx.To()) {
 			return nil, core.ErrContractTransactionAddressNotEqual
 		}
+	} else if tx.Type() == core.TxPayloadBatchType {
+		if !tx.From().Equals(tx.To()) {
+			return nil, core.ErrBatchTransactionAddressNotEqual
+		}
 	} else if tx.Type() == core.TxPayloadCallType {
 		if err := neb.BlockChain().TailBlock().CheckContract(tx.To()); err != nil {
 			return nil, err
@@ -327,6 +444,7 @@ func (s *APIService) toBlockResponse(block *core.Block, fullTransaction bool) (*
 		StateRoot:  block.StateRoot().String(),
 		TxsRoot:    block.TxsRoot().String(),
 		EventsRoot: block.EventsRoot().String(),
+		GasUsed:    block.GasUsed().String(),
 	}
 
 	// dpos context
@@ -391,7 +509,47 @@ func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTr
 		}
 	}
 
-	return s.toTransactionResponse(tx)
+	resp, err := s.toTransactionResponse(tx)
+	if err != nil {
+		return nil, err
+	}
+	resp.Receipt, err = toTransactionReceipt(neb.BlockChain().TailBlock(), tx.Hash())
+	if err != nil && err != core.ErrReceiptNotFound {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toTransactionReceipt returns the receipt with the merkle proof under the receipts root of the block.
+func toTransactionReceipt(block *core.Block, hash byteutils.Hash) (*rpcpb.TransactionReceipt, error) {
+	receipt, err := block.GetReceipt(hash)
+	if err != nil {
+		return nil, err
+	}
+	data, proof, err := block.ProveReceipt(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.TransactionReceipt{
+		Status:            int32(receipt.Status),
+		GasUsed:           receipt.GasUsed.String(),
+		CumulativeGasUsed: receipt.CumulativeGasUsed.String(),
+		Error:             receipt.Error,
+		BlockHash:         block.Hash().String(),
+		ReceiptsRoot:      block.ReceiptsRoot().String(),
+		Data:              data,
+	}
+	if receipt.ContractAddress != nil {
+		resp.ContractAddress = receipt.ContractAddress.String()
+	}
+	for _, event := range receipt.Events {
+		resp.Events = append(resp.Events, &rpcpb.Event{Topic: event.Topic, Data: event.Data})
+	}
+	for _, val := range proof {
+		resp.Proof = append(resp.Proof, &rpcpb.ProofNode{Val: val})
+	}
+	return resp, nil
 }
 
 func (s *APIService) toTransactionResponse(tx *core.Transaction) (*rpcpb.TransactionResponse, error) {
@@ -433,6 +591,9 @@ func (s *APIService) toTransactionResponse(tx *core.Transaction) (*rpcpb.Transac
 		GasPrice:  tx.GasPrice().String(),
 		GasLimit:  tx.GasLimit().String(),
 		Status:    status,
+
+		ValidUntilHeight:    tx.ValidUntilHeight(),
+		ValidUntilTimestamp: tx.ValidUntilTimestamp(),
 	}
 
 	if tx.Type() == core.TxPayloadDeployType {
@@ -512,6 +673,18 @@ func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.NonParamsReques
 	return &rpcpb.GasPriceResponse{GasPrice: gasPrice.String()}, nil
 }
 
+// GetGasPriceSuggestion get slow, standard and fast gas price suggestions from the gas price oracle.
+func (s *APIService) GetGasPriceSuggestion(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GasPriceSuggestionResponse, error) {
+	neb := s.server.Neblet()
+
+	suggestion := neb.BlockChain().GasPriceOracle().Suggest()
+	return &rpcpb.GasPriceSuggestionResponse{
+		Slow:     suggestion.Slow.String(),
+		Standard: suggestion.Standard.String(),
+		Fast:     suggestion.Fast.String(),
+	}, nil
+}
+
 // EstimateGas Compute the smart contract gas consumption.
 func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.GasResponse, error) {
 
@@ -541,6 +714,15 @@ func (s *APIService) GetGasUsed(ctx context.Context, req *rpcpb.HashRequest) (*r
 		return nil, errors.New("transaction not found")
 	}
 
+	// the gas used is recorded in the receipt since the receipts fork.
+	receipt, err := neb.BlockChain().TailBlock().GetReceipt(tx.Hash())
+	if err == nil {
+		return &rpcpb.GasResponse{Gas: receipt.GasUsed.String()}, nil
+	}
+	if err != core.ErrReceiptNotFound {
+		return nil, err
+	}
+
 	gas, err := neb.BlockChain().EstimateGas(tx)
 	if err != nil {
 		return nil, err
@@ -581,6 +763,51 @@ func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.HashRequest
 
 }
 
+// GetLogs return the events on the canonical chain matching the topics and contract in the height range.
+func (s *APIService) GetLogs(ctx context.Context, req *rpcpb.GetLogsRequest) (*rpcpb.GetLogsResponse, error) {
+
+	neb := s.server.Neblet()
+
+	filter := &core.LogFilter{
+		FromHeight: req.FromHeight,
+		ToHeight:   req.ToHeight,
+		Topics:     req.Topics,
+		Limit:      int(req.Limit),
+	}
+	if filter.ToHeight == 0 {
+		filter.ToHeight = neb.BlockChain().TailBlock().Height()
+	}
+	if len(req.Address) > 0 {
+		addr, err := core.AddressParse(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		filter.Address = addr
+	}
+
+	logs, next, err := neb.BlockChain().GetLogs(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetLogsResponse{Logs: []*rpcpb.Log{}, NextHeight: next}
+	for _, log := range logs {
+		address := ""
+		if log.Address != nil {
+			address = log.Address.String()
+		}
+		resp.Logs = append(resp.Logs, &rpcpb.Log{
+			BlockHeight: log.BlockHeight,
+			BlockHash:   log.BlockHash.String(),
+			TxHash:      log.TxHash.String(),
+			Address:     address,
+			Topic:       log.Topic,
+			Data:        log.Data,
+		})
+	}
+	return resp, nil
+}
+
 // GetDynasty is the RPC API handler.
 func (s *APIService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetDynastyResponse, error) {
 
@@ -605,6 +832,49 @@ func (s *APIService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightReq
 	return &rpcpb.GetDynastyResponse{Delegatees: result}, nil
 }
 
+// GetProposerSchedule is the RPC API handler.
+func (s *APIService) GetProposerSchedule(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetProposerScheduleResponse, error) {
+
+	neb := s.server.Neblet()
+	block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+	if block == nil {
+		block = neb.BlockChain().TailBlock()
+	}
+	current, next, err := block.ProposerSchedule(neb.BlockChain())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetProposerScheduleResponse{
+		Current: toDynastySchedule(current),
+		Next:    toDynastySchedule(next),
+	}, nil
+}
+
+func toDynastySchedule(schedule *core.DynastySchedule) *rpcpb.DynastySchedule {
+	result := &rpcpb.DynastySchedule{
+		Serial: schedule.Serial,
+		Start:  schedule.Start,
+		End:    schedule.End,
+		Slots:  []*rpcpb.ProposerSlot{},
+		Stats:  []*rpcpb.MintStat{},
+	}
+	for _, v := range schedule.Slots {
+		result.Slots = append(result.Slots, &rpcpb.ProposerSlot{
+			Timestamp: v.Timestamp,
+			Proposer:  string(v.Proposer.Hex()),
+		})
+	}
+	for _, v := range schedule.Stats {
+		result.Stats = append(result.Stats, &rpcpb.MintStat{
+			Validator: string(v.Validator.Hex()),
+			Expected:  v.Expected,
+			Minted:    v.Minted,
+			Missed:    v.Missed,
+		})
+	}
+	return result
+}
+
 // GetCandidates is the RPC API handler.
 func (s *APIService) GetCandidates(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetCandidatesResponse, error) {
 
@@ -618,15 +888,24 @@ func (s *APIService) GetCandidates(ctx context.Context, req *rpcpb.ByBlockHeight
 	if err != nil {
 		return nil, err
 	}
-	candidates, err := core.TraverseDynasty(candidateTrie)
+	candidates, err := core.TraverseCandidates(candidateTrie)
 	if err != nil {
 		return nil, err
 	}
 	result := []string{}
+	infos := []*rpcpb.CandidateInfo{}
 	for _, v := range candidates {
-		result = append(result, string(v.Hex()))
+		result = append(result, v.Address.String())
+		infos = append(infos, &rpcpb.CandidateInfo{
+			Address:    v.Address.String(),
+			Name:       v.Name,
+			Url:        v.URL,
+			Endpoint:   v.Endpoint,
+			Commission: v.Commission,
+			Deposit:    v.Deposit.String(),
+		})
 	}
-	return &rpcpb.GetCandidatesResponse{Candidates: result}, nil
+	return &rpcpb.GetCandidatesResponse{Candidates: result, Infos: infos}, nil
 }
 
 // GetDelegateVoters is the RPC API handler.
@@ -662,3 +941,246 @@ func (s *APIService) GetDelegateVoters(ctx context.Context, req *rpcpb.GetDelega
 	}
 	return &rpcpb.GetDelegateVotersResponse{Voters: voters}, nil
 }
+
+// GetClaimableReward is the RPC API handler.
+func (s *APIService) GetClaimableReward(ctx context.Context, req *rpcpb.GetClaimableRewardRequest) (*rpcpb.GetClaimableRewardResponse, error) {
+
+	neb := s.server.Neblet()
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+	if block == nil {
+		block = neb.BlockChain().TailBlock()
+	}
+	reward, err := block.ClaimableReward(addr)
+	if err != nil {
+		return nil, err
+	}
+	commission, err := block.Commission(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetClaimableRewardResponse{Reward: reward.String(), Commission: commission}, nil
+}
+
+// GetValidatorLiveness is the RPC API handler.
+func (s *APIService) GetValidatorLiveness(ctx context.Context, req *rpcpb.GetValidatorLivenessRequest) (*rpcpb.GetValidatorLivenessResponse, error) {
+
+	neb := s.server.Neblet()
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+	if block == nil {
+		block = neb.BlockChain().TailBlock()
+	}
+	records, err := block.LivenessRecords(addr)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.GetValidatorLivenessResponse{Records: []*rpcpb.LivenessRecord{}}
+	for _, record := range records {
+		resp.Records = append(resp.Records, &rpcpb.LivenessRecord{
+			Dynasty:  record.Serial,
+			Expected: record.Expected,
+			Produced: record.Produced,
+		})
+	}
+	jail, err := block.Jail(addr)
+	if err != nil {
+		return nil, err
+	}
+	if jail != nil {
+		resp.Jailed = true
+		resp.Release = jail.Release
+	}
+	return resp, nil
+}
+
+// GetJailedValidators is the RPC API handler.
+func (s *APIService) GetJailedValidators(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetJailedValidatorsResponse, error) {
+
+	neb := s.server.Neblet()
+	block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+	if block == nil {
+		block = neb.BlockChain().TailBlock()
+	}
+	records, err := block.JailedValidators()
+	if err != nil {
+		return nil, err
+	}
+	validators := []*rpcpb.JailedValidator{}
+	for _, record := range records {
+		addr, err := core.AddressParseFromBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, &rpcpb.JailedValidator{Address: addr.String(), Release: record.Release})
+	}
+	return &rpcpb.GetJailedValidatorsResponse{Validators: validators}, nil
+}
+
+// GetProposal is the RPC API handler.
+func (s *APIService) GetProposal(ctx context.Context, req *rpcpb.GetProposalRequest) (*rpcpb.GetProposalResponse, error) {
+
+	neb := s.server.Neblet()
+	id, err := byteutils.FromHex(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+	if block == nil {
+		block = neb.BlockChain().TailBlock()
+	}
+	proposal, err := block.Proposal(id)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := core.AddressParseFromBytes(proposal.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	status := map[uint32]string{
+		core.ProposalVoting:    "voting",
+		core.ProposalPassed:    "passed",
+		core.ProposalRejected:  "rejected",
+		core.ProposalActivated: "activated",
+	}[proposal.Status]
+	return &rpcpb.GetProposalResponse{
+		Id:               proposal.ID.String(),
+		Proposer:         proposer.String(),
+		Param:            proposal.Param,
+		Value:            proposal.Value,
+		EndHeight:        proposal.EndHeight,
+		ActivationHeight: proposal.ActivationHeight,
+		Status:           status,
+		Yes:              proposal.Yes.String(),
+		No:               proposal.No.String(),
+		Deposit:          proposal.Deposit.String(),
+	}, nil
+}
+
+// GetGovernedParams is the RPC API handler.
+func (s *APIService) GetGovernedParams(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetGovernedParamsResponse, error) {
+
+	neb := s.server.Neblet()
+	block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+	if block == nil {
+		block = neb.BlockChain().TailBlock()
+	}
+	params, err := block.GovernedParams()
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetGovernedParamsResponse{Params: params.Strings()}, nil
+}
+
+// GetNebulasRank is the RPC API handler.
+func (s *APIService) GetNebulasRank(ctx context.Context, req *rpcpb.GetNebulasRankRequest) (*rpcpb.GetNebulasRankResponse, error) {
+
+	neb := s.server.Neblet()
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	// the rank is computed by the node once the period is irreversible.
+	data, err := nr.Load(neb.BlockChain().Storage(), req.Period, nr.GenesisWindow(neb.Genesis()))
+	if err == storage.ErrKeyNotFound {
+		return nil, errors.New("nebulas rank of the period is not computed yet, it must be irreversible")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetNebulasRankResponse{
+		Period:        data.Period,
+		Window:        data.Window,
+		StartHeight:   data.StartHeight,
+		EndHeight:     data.EndHeight,
+		InValue:       "0",
+		OutValue:      "0",
+		MedianBalance: "0",
+		Score:         "0",
+	}
+	if item := nr.Find(data, addr.String()); item != nil {
+		resp.InValue = item.InValue
+		resp.OutValue = item.OutValue
+		resp.InDegree = item.InDegree
+		resp.OutDegree = item.OutDegree
+		resp.MedianBalance = item.MedianBalance
+		resp.Score = item.Score
+	}
+	return resp, nil
+}
+
+// GetDipReward is the RPC API handler.
+func (s *APIService) GetDipReward(ctx context.Context, req *rpcpb.GetDipRewardRequest) (*rpcpb.GetDipRewardResponse, error) {
+
+	neb := s.server.Neblet()
+	if neb.Dip() == nil {
+		return nil, errors.New("dip is not enabled")
+	}
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	data, err := neb.Dip().Reward(req.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetDipRewardResponse{
+		Period:      data.Period,
+		Window:      data.Window,
+		StartHeight: data.StartHeight,
+		EndHeight:   data.EndHeight,
+		Pending:     data.Pending,
+		Total:       data.Total,
+		Contracts:   []string{},
+		Weight:      "0",
+		Reward:      "0",
+	}
+	if item := dip.Find(data, addr.String()); item != nil {
+		resp.Contracts = item.Contracts
+		resp.Weight = item.Weight
+		resp.Reward = item.Reward
+	}
+	return resp, nil
+}
+
+// GetCommitCertificate is the RPC API handler.
+func (s *APIService) GetCommitCertificate(ctx context.Context, req *rpcpb.GetCommitCertificateRequest) (*rpcpb.GetCommitCertificateResponse, error) {
+
+	neb := s.server.Neblet()
+	if neb.Finality() == nil {
+		return nil, errors.New("finality is not enabled")
+	}
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := neb.Finality().Certificate(hash)
+	if err != nil {
+		return nil, err
+	}
+	return toCommitCertificate(cert), nil
+}
+
+func toCommitCertificate(cert *finality.Certificate) *rpcpb.GetCommitCertificateResponse {
+	resp := &rpcpb.GetCommitCertificateResponse{
+		Height:  cert.Height(),
+		Hash:    cert.BlockHash().String(),
+		Commits: []*rpcpb.CommitVote{},
+	}
+	for _, commit := range cert.Commits() {
+		resp.Commits = append(resp.Commits, &rpcpb.CommitVote{
+			Voter:     commit.Voter().String(),
+			Alg:       uint32(commit.Alg()),
+			Signature: commit.Signature().String(),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// lightMethods are the rpc methods served by a light node, the others read the blocks,
+// the state or the tx pool of the chain a light node never syncs.
+var lightMethods = map[string]bool{
+	"/rpcpb.ApiService/GetNebState":       true,
+	"/rpcpb.ApiService/NodeInfo":          true,
+	"/rpcpb.ApiService/Accounts":          true,
+	"/rpcpb.ApiService/GetAccountState":   true,
+	"/rpcpb.ApiService/GetAccountStorage": true,
+	"/rpcpb.AdminService/NewAccount":      true,
+	"/rpcpb.AdminService/UnlockAccount":   true,
+	"/rpcpb.AdminService/LockAccount":     true,
+	"/rpcpb.AdminService/StartPprof":      true,
+}
+
+func (s *Server) lightServed(method string) bool {
+	if s.neblet.LightClient() == nil || !strings.HasPrefix(method, "/rpcpb.") {
+		return true
+	}
+	return lightMethods[method]
+}
+
+func (s *Server) lightStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !s.lightServed(info.FullMethod) {
+		return ErrNotSupportedInLightMode
+	}
+	return handler(srv, ss)
+}
+
+func (s *Server) lightUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	if !s.lightServed(info.FullMethod) {
+		return nil, ErrNotSupportedInLightMode
+	}
+	return handler(ctx, req)
+}
@@ -13,21 +13,53 @@ It has these top-level messages:
 	NonParamsRequest
 	NodeInfoResponse
 	StatisticsNodeInfoResponse
+	ConsensusStateResponse
+	ConsensusTransition
+	ForkTreeResponse
+	ForkBlock
+	ForkTail
 	RouteTable
 	GetNebStateResponse
 	AccountsResponse
 	GetAccountStateRequest
 	GetAccountStateResponse
+	GetAccountStorageRequest
+	GetAccountStorageResponse
 	CallResponse
 	ByBlockHeightRequest
 	GetCandidatesResponse
+	CandidateInfo
 	GetDynastyResponse
+	GetProposerScheduleResponse
+	DynastySchedule
+	ProposerSlot
+	MintStat
 	GetDelegateVotersRequest
 	GetDelegateVotersResponse
+	GetClaimableRewardRequest
+	GetClaimableRewardResponse
+	GetValidatorLivenessRequest
+	GetValidatorLivenessResponse
+	LivenessRecord
+	GetJailedValidatorsResponse
+	JailedValidator
+	GetProposalRequest
+	GetProposalResponse
+	GetGovernedParamsResponse
+	GetNebulasRankRequest
+	GetNebulasRankResponse
+	GetDipRewardRequest
+	GetDipRewardResponse
+	GetCommitCertificateRequest
+	GetCommitCertificateResponse
+	CommitVote
 	TransactionRequest
 	ContractRequest
 	CandidateRequest
+	ProposalRequest
 	DelegateRequest
+	BatchRequest
+	BatchItemRequest
 	SendRawTransactionRequest
 	SendTransactionResponse
 	GetBlockByHashRequest
@@ -38,6 +70,8 @@ It has these top-level messages:
 	BlockResponse
 	DposContext
 	TransactionResponse
+	TransactionReceipt
+	ProofNode
 	NewAccountRequest
 	NewAccountResponse
 	UnlockAccountRequest
@@ -47,10 +81,14 @@ It has these top-level messages:
 	SignTransactionResponse
 	SendTransactionPassphraseRequest
 	GasPriceResponse
+	GasPriceSuggestionResponse
 	HashRequest
 	GasResponse
 	EventsResponse
 	Event
+	GetLogsRequest
+	GetLogsResponse
+	Log
 	ChangeNetworkIDRequest
 	ChangeNetworkIDResponse
 	StartMiningRequest
@@ -279,6 +317,205 @@ func (m *StatisticsNodeInfoResponse) GetPeerCount() uint32 {
 	return 0
 }
 
+// Response message of ConsensusState rpc.
+type ConsensusStateResponse struct {
+	// current state of the mining state machine.
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// recent transitions, the latest is the last.
+	Transitions []*ConsensusTransition `protobuf:"bytes,2,rep,name=transitions" json:"transitions,omitempty"`
+}
+
+func (m *ConsensusStateResponse) Reset()                    { *m = ConsensusStateResponse{} }
+func (m *ConsensusStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*ConsensusStateResponse) ProtoMessage()               {}
+func (*ConsensusStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{5} }
+
+func (m *ConsensusStateResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ConsensusStateResponse) GetTransitions() []*ConsensusTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+type ConsensusTransition struct {
+	From      string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Event     string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ConsensusTransition) Reset()                    { *m = ConsensusTransition{} }
+func (m *ConsensusTransition) String() string            { return proto.CompactTextString(m) }
+func (*ConsensusTransition) ProtoMessage()               {}
+func (*ConsensusTransition) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{6} }
+
+func (m *ConsensusTransition) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ConsensusTransition) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ConsensusTransition) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *ConsensusTransition) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// Response message of ForkTree rpc.
+type ForkTreeResponse struct {
+	// the fork choice rule in use.
+	Rule string     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Lib  *ForkBlock `protobuf:"bytes,2,opt,name=lib" json:"lib,omitempty"`
+	Tail *ForkBlock `protobuf:"bytes,3,opt,name=tail" json:"tail,omitempty"`
+	// all blocks above lib, ordered by height.
+	Blocks []*ForkBlock `protobuf:"bytes,4,rep,name=blocks" json:"blocks,omitempty"`
+	// the tails and their common ancestors with the canonical tail.
+	Tails []*ForkTail `protobuf:"bytes,5,rep,name=tails" json:"tails,omitempty"`
+}
+
+func (m *ForkTreeResponse) Reset()                    { *m = ForkTreeResponse{} }
+func (m *ForkTreeResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForkTreeResponse) ProtoMessage()               {}
+func (*ForkTreeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{7} }
+
+func (m *ForkTreeResponse) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *ForkTreeResponse) GetLib() *ForkBlock {
+	if m != nil {
+		return m.Lib
+	}
+	return nil
+}
+
+func (m *ForkTreeResponse) GetTail() *ForkBlock {
+	if m != nil {
+		return m.Tail
+	}
+	return nil
+}
+
+func (m *ForkTreeResponse) GetBlocks() []*ForkBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *ForkTreeResponse) GetTails() []*ForkTail {
+	if m != nil {
+		return m.Tails
+	}
+	return nil
+}
+
+type ForkBlock struct {
+	Hash       string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash string `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Height     uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Miner      string `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
+	Timestamp  int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Canonical  bool   `protobuf:"varint,6,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (m *ForkBlock) Reset()                    { *m = ForkBlock{} }
+func (m *ForkBlock) String() string            { return proto.CompactTextString(m) }
+func (*ForkBlock) ProtoMessage()               {}
+func (*ForkBlock) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{8} }
+
+func (m *ForkBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ForkBlock) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *ForkBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ForkBlock) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *ForkBlock) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ForkBlock) GetCanonical() bool {
+	if m != nil {
+		return m.Canonical
+	}
+	return false
+}
+
+type ForkTail struct {
+	Block    *ForkBlock `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
+	Ancestor *ForkBlock `protobuf:"bytes,2,opt,name=ancestor" json:"ancestor,omitempty"`
+}
+
+func (m *ForkTail) Reset()                    { *m = ForkTail{} }
+func (m *ForkTail) String() string            { return proto.CompactTextString(m) }
+func (*ForkTail) ProtoMessage()               {}
+func (*ForkTail) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{9} }
+
+func (m *ForkTail) GetBlock() *ForkBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *ForkTail) GetAncestor() *ForkBlock {
+	if m != nil {
+		return m.Ancestor
+	}
+	return nil
+}
+
 type RouteTable struct {
 	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Address []string `protobuf:"bytes,2,rep,name=address" json:"address,omitempty"`
@@ -287,7 +524,7 @@ type RouteTable struct {
 func (m *RouteTable) Reset()                    { *m = RouteTable{} }
 func (m *RouteTable) String() string            { return proto.CompactTextString(m) }
 func (*RouteTable) ProtoMessage()               {}
-func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{5} }
+func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{10} }
 
 func (m *RouteTable) GetId() string {
 	if m != nil {
@@ -328,7 +565,7 @@ type GetNebStateResponse struct {
 func (m *GetNebStateResponse) Reset()                    { *m = GetNebStateResponse{} }
 func (m *GetNebStateResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetNebStateResponse) ProtoMessage()               {}
-func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{6} }
+func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{11} }
 
 func (m *GetNebStateResponse) GetChainId() uint32 {
 	if m != nil {
@@ -402,7 +639,7 @@ type AccountsResponse struct {
 func (m *AccountsResponse) Reset()                    { *m = AccountsResponse{} }
 func (m *AccountsResponse) String() string            { return proto.CompactTextString(m) }
 func (*AccountsResponse) ProtoMessage()               {}
-func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{7} }
+func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{12} }
 
 func (m *AccountsResponse) GetAddresses() []string {
 	if m != nil {
@@ -422,7 +659,7 @@ type GetAccountStateRequest struct {
 func (m *GetAccountStateRequest) Reset()                    { *m = GetAccountStateRequest{} }
 func (m *GetAccountStateRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAccountStateRequest) ProtoMessage()               {}
-func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{8} }
+func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{13} }
 
 func (m *GetAccountStateRequest) GetAddress() string {
 	if m != nil {
@@ -449,7 +686,7 @@ type GetAccountStateResponse struct {
 func (m *GetAccountStateResponse) Reset()                    { *m = GetAccountStateResponse{} }
 func (m *GetAccountStateResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAccountStateResponse) ProtoMessage()               {}
-func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{9} }
+func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{14} }
 
 func (m *GetAccountStateResponse) GetBalance() string {
 	if m != nil {
@@ -465,6 +702,60 @@ func (m *GetAccountStateResponse) GetNonce() string {
 	return ""
 }
 
+// Request message of GetAccountStorage rpc.
+type GetAccountStorageRequest struct {
+	// Hex string of the account addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the key in the account's storage.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// block account storage with height. If not specified, use 0 as tail height.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetAccountStorageRequest) Reset()                    { *m = GetAccountStorageRequest{} }
+func (m *GetAccountStorageRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountStorageRequest) ProtoMessage()               {}
+func (*GetAccountStorageRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{15} }
+
+func (m *GetAccountStorageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAccountStorageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetAccountStorageRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetAccountStorage rpc.
+type GetAccountStorageResponse struct {
+	// Hex string of the value.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *GetAccountStorageResponse) Reset()                    { *m = GetAccountStorageResponse{} }
+func (m *GetAccountStorageResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountStorageResponse) ProtoMessage()               {}
+func (*GetAccountStorageResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
+
+func (m *GetAccountStorageResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // Response message of Call rpc.
 type CallResponse struct {
 	// result of smart contract method call.
@@ -474,7 +765,7 @@ type CallResponse struct {
 func (m *CallResponse) Reset()                    { *m = CallResponse{} }
 func (m *CallResponse) String() string            { return proto.CompactTextString(m) }
 func (*CallResponse) ProtoMessage()               {}
-func (*CallResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{10} }
+func (*CallResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
 
 func (m *CallResponse) GetResult() string {
 	if m != nil {
@@ -491,7 +782,7 @@ type ByBlockHeightRequest struct {
 func (m *ByBlockHeightRequest) Reset()                    { *m = ByBlockHeightRequest{} }
 func (m *ByBlockHeightRequest) String() string            { return proto.CompactTextString(m) }
 func (*ByBlockHeightRequest) ProtoMessage()               {}
-func (*ByBlockHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{11} }
+func (*ByBlockHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
 
 func (m *ByBlockHeightRequest) GetHeight() uint64 {
 	if m != nil {
@@ -503,77 +794,914 @@ func (m *ByBlockHeightRequest) GetHeight() uint64 {
 // GetCandidatesRequest message
 type GetCandidatesResponse struct {
 	Candidates []string `protobuf:"bytes,1,rep,name=candidates" json:"candidates,omitempty"`
+	// metadata of the candidates in the same order.
+	Infos []*CandidateInfo `protobuf:"bytes,2,rep,name=infos" json:"infos,omitempty"`
 }
 
 func (m *GetCandidatesResponse) Reset()                    { *m = GetCandidatesResponse{} }
 func (m *GetCandidatesResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetCandidatesResponse) ProtoMessage()               {}
-func (*GetCandidatesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{12} }
+func (*GetCandidatesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
 
 func (m *GetCandidatesResponse) GetCandidates() []string {
 	if m != nil {
 		return m.Candidates
 	}
-	return nil
+	return nil
+}
+
+func (m *GetCandidatesResponse) GetInfos() []*CandidateInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type CandidateInfo struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url        string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Endpoint   string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Commission uint32 `protobuf:"varint,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	Deposit    string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *CandidateInfo) Reset()                    { *m = CandidateInfo{} }
+func (m *CandidateInfo) String() string            { return proto.CompactTextString(m) }
+func (*CandidateInfo) ProtoMessage()               {}
+func (*CandidateInfo) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
+
+func (m *CandidateInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CandidateInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CandidateInfo) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CandidateInfo) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *CandidateInfo) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *CandidateInfo) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
+	}
+	return ""
+}
+
+// Response message of GetDynastyRequest rpc
+type GetDynastyResponse struct {
+	Delegatees []string `protobuf:"bytes,1,rep,name=delegatees" json:"delegatees,omitempty"`
+}
+
+func (m *GetDynastyResponse) Reset()                    { *m = GetDynastyResponse{} }
+func (m *GetDynastyResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDynastyResponse) ProtoMessage()               {}
+func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
+
+func (m *GetDynastyResponse) GetDelegatees() []string {
+	if m != nil {
+		return m.Delegatees
+	}
+	return nil
+}
+
+// Response message of GetProposerSchedule rpc
+type GetProposerScheduleResponse struct {
+	// the dynasty of the block
+	Current *DynastySchedule `protobuf:"bytes,1,opt,name=current" json:"current,omitempty"`
+	Next    *DynastySchedule `protobuf:"bytes,2,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *GetProposerScheduleResponse) Reset()                    { *m = GetProposerScheduleResponse{} }
+func (m *GetProposerScheduleResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProposerScheduleResponse) ProtoMessage()               {}
+func (*GetProposerScheduleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
+
+func (m *GetProposerScheduleResponse) GetCurrent() *DynastySchedule {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *GetProposerScheduleResponse) GetNext() *DynastySchedule {
+	if m != nil {
+		return m.Next
+	}
+	return nil
+}
+
+type DynastySchedule struct {
+	Serial int64 `protobuf:"varint,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	// timestamps of the first and last slots
+	Start int64           `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64           `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Slots []*ProposerSlot `protobuf:"bytes,4,rep,name=slots" json:"slots,omitempty"`
+	// blocks minted and missed by the validators in the slots up to the block
+	Stats []*MintStat `protobuf:"bytes,5,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *DynastySchedule) Reset()                    { *m = DynastySchedule{} }
+func (m *DynastySchedule) String() string            { return proto.CompactTextString(m) }
+func (*DynastySchedule) ProtoMessage()               {}
+func (*DynastySchedule) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
+
+func (m *DynastySchedule) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *DynastySchedule) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *DynastySchedule) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *DynastySchedule) GetSlots() []*ProposerSlot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+func (m *DynastySchedule) GetStats() []*MintStat {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type ProposerSlot struct {
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// empty if no validator for the slot
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *ProposerSlot) Reset()                    { *m = ProposerSlot{} }
+func (m *ProposerSlot) String() string            { return proto.CompactTextString(m) }
+func (*ProposerSlot) ProtoMessage()               {}
+func (*ProposerSlot) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
+
+func (m *ProposerSlot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ProposerSlot) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+type MintStat struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Expected  int64  `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Minted    int64  `protobuf:"varint,3,opt,name=minted,proto3" json:"minted,omitempty"`
+	Missed    int64  `protobuf:"varint,4,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *MintStat) Reset()                    { *m = MintStat{} }
+func (m *MintStat) String() string            { return proto.CompactTextString(m) }
+func (*MintStat) ProtoMessage()               {}
+func (*MintStat) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
+
+func (m *MintStat) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MintStat) GetExpected() int64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *MintStat) GetMinted() int64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
+func (m *MintStat) GetMissed() int64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+// Response message of GetDelegateVoters rpc
+type GetDelegateVotersRequest struct {
+	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetDelegateVotersRequest) Reset()                    { *m = GetDelegateVotersRequest{} }
+func (m *GetDelegateVotersRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDelegateVotersRequest) ProtoMessage()               {}
+func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
+
+func (m *GetDelegateVotersRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *GetDelegateVotersRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetDelegateVoters rpc
+type GetDelegateVotersResponse struct {
+	Voters []string `protobuf:"bytes,1,rep,name=voters" json:"voters,omitempty"`
+}
+
+func (m *GetDelegateVotersResponse) Reset()                    { *m = GetDelegateVotersResponse{} }
+func (m *GetDelegateVotersResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDelegateVotersResponse) ProtoMessage()               {}
+func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
+
+func (m *GetDelegateVotersResponse) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+// Request message of GetClaimableReward rpc
+type GetClaimableRewardRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetClaimableRewardRequest) Reset()                    { *m = GetClaimableRewardRequest{} }
+func (m *GetClaimableRewardRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetClaimableRewardRequest) ProtoMessage()               {}
+func (*GetClaimableRewardRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
+
+func (m *GetClaimableRewardRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetClaimableRewardRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetClaimableReward rpc
+type GetClaimableRewardResponse struct {
+	// shared rewards the address can claim.
+	Reward string `protobuf:"bytes,1,opt,name=reward,proto3" json:"reward,omitempty"`
+	// commission percent of the address as a candidate.
+	Commission uint32 `protobuf:"varint,2,opt,name=commission,proto3" json:"commission,omitempty"`
+}
+
+func (m *GetClaimableRewardResponse) Reset()                    { *m = GetClaimableRewardResponse{} }
+func (m *GetClaimableRewardResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetClaimableRewardResponse) ProtoMessage()               {}
+func (*GetClaimableRewardResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
+
+func (m *GetClaimableRewardResponse) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+func (m *GetClaimableRewardResponse) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+// Request message of GetValidatorLiveness rpc
+type GetValidatorLivenessRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetValidatorLivenessRequest) Reset()                    { *m = GetValidatorLivenessRequest{} }
+func (m *GetValidatorLivenessRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetValidatorLivenessRequest) ProtoMessage()               {}
+func (*GetValidatorLivenessRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
+
+func (m *GetValidatorLivenessRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetValidatorLivenessRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetValidatorLiveness rpc
+type GetValidatorLivenessResponse struct {
+	// liveness of the dynasties the validator was in.
+	Records []*LivenessRecord `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+	// the validator is jailed and can unjail from the release dynasty.
+	Jailed  bool  `protobuf:"varint,2,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	Release int64 `protobuf:"varint,3,opt,name=release,proto3" json:"release,omitempty"`
+}
+
+func (m *GetValidatorLivenessResponse) Reset()         { *m = GetValidatorLivenessResponse{} }
+func (m *GetValidatorLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorLivenessResponse) ProtoMessage()    {}
+func (*GetValidatorLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{31}
+}
+
+func (m *GetValidatorLivenessResponse) GetRecords() []*LivenessRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *GetValidatorLivenessResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *GetValidatorLivenessResponse) GetRelease() int64 {
+	if m != nil {
+		return m.Release
+	}
+	return 0
+}
+
+type LivenessRecord struct {
+	Dynasty  int64 `protobuf:"varint,1,opt,name=dynasty,proto3" json:"dynasty,omitempty"`
+	Expected int64 `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Produced int64 `protobuf:"varint,3,opt,name=produced,proto3" json:"produced,omitempty"`
+}
+
+func (m *LivenessRecord) Reset()                    { *m = LivenessRecord{} }
+func (m *LivenessRecord) String() string            { return proto.CompactTextString(m) }
+func (*LivenessRecord) ProtoMessage()               {}
+func (*LivenessRecord) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
+
+func (m *LivenessRecord) GetDynasty() int64 {
+	if m != nil {
+		return m.Dynasty
+	}
+	return 0
+}
+
+func (m *LivenessRecord) GetExpected() int64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *LivenessRecord) GetProduced() int64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+// Response message of GetJailedValidators rpc
+type GetJailedValidatorsResponse struct {
+	Validators []*JailedValidator `protobuf:"bytes,1,rep,name=validators" json:"validators,omitempty"`
+}
+
+func (m *GetJailedValidatorsResponse) Reset()                    { *m = GetJailedValidatorsResponse{} }
+func (m *GetJailedValidatorsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetJailedValidatorsResponse) ProtoMessage()               {}
+func (*GetJailedValidatorsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+
+func (m *GetJailedValidatorsResponse) GetValidators() []*JailedValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type JailedValidator struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Release int64  `protobuf:"varint,2,opt,name=release,proto3" json:"release,omitempty"`
+}
+
+func (m *JailedValidator) Reset()                    { *m = JailedValidator{} }
+func (m *JailedValidator) String() string            { return proto.CompactTextString(m) }
+func (*JailedValidator) ProtoMessage()               {}
+func (*JailedValidator) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
+
+func (m *JailedValidator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *JailedValidator) GetRelease() int64 {
+	if m != nil {
+		return m.Release
+	}
+	return 0
+}
+
+// Request message of GetProposal rpc
+type GetProposalRequest struct {
+	// hex id of the proposal, the hash of the proposing transaction.
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetProposalRequest) Reset()                    { *m = GetProposalRequest{} }
+func (m *GetProposalRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProposalRequest) ProtoMessage()               {}
+func (*GetProposalRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
+
+func (m *GetProposalRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetProposalRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetProposal rpc
+type GetProposalResponse struct {
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer         string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Param            string `protobuf:"bytes,3,opt,name=param,proto3" json:"param,omitempty"`
+	Value            string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	EndHeight        uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	ActivationHeight uint64 `protobuf:"varint,6,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// status of the proposal, voting, passed, rejected or activated.
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// stake approving and rejecting the proposal, counted at the end of voting.
+	Yes string `protobuf:"bytes,8,opt,name=yes,proto3" json:"yes,omitempty"`
+	No  string `protobuf:"bytes,9,opt,name=no,proto3" json:"no,omitempty"`
+	// deposit locked by the proposer, returned if the votes reach the quorum.
+	Deposit string `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *GetProposalResponse) Reset()                    { *m = GetProposalResponse{} }
+func (m *GetProposalResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProposalResponse) ProtoMessage()               {}
+func (*GetProposalResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
+
+func (m *GetProposalResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetParam() string {
+	if m != nil {
+		return m.Param
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetProposalResponse) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *GetProposalResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetYes() string {
+	if m != nil {
+		return m.Yes
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetNo() string {
+	if m != nil {
+		return m.No
+	}
+	return ""
+}
+
+func (m *GetProposalResponse) GetDeposit() string {
+	if m != nil {
+		return m.Deposit
+	}
+	return ""
+}
+
+// Response message of GetGovernedParams rpc
+type GetGovernedParamsResponse struct {
+	// values of the governed params by names.
+	Params map[string]string `protobuf:"bytes,1,rep,name=params" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *GetGovernedParamsResponse) Reset()                    { *m = GetGovernedParamsResponse{} }
+func (m *GetGovernedParamsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetGovernedParamsResponse) ProtoMessage()               {}
+func (*GetGovernedParamsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
+
+func (m *GetGovernedParamsResponse) GetParams() map[string]string {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// Request message of GetNebulasRank rpc
+type GetNebulasRankRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Period  uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *GetNebulasRankRequest) Reset()                    { *m = GetNebulasRankRequest{} }
+func (m *GetNebulasRankRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetNebulasRankRequest) ProtoMessage()               {}
+func (*GetNebulasRankRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
+
+func (m *GetNebulasRankRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetNebulasRankRequest) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// Response message of GetNebulasRank rpc
+type GetNebulasRankResponse struct {
+	Period        uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Window        uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	StartHeight   uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight     uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	InValue       string `protobuf:"bytes,5,opt,name=in_value,json=inValue,proto3" json:"in_value,omitempty"`
+	OutValue      string `protobuf:"bytes,6,opt,name=out_value,json=outValue,proto3" json:"out_value,omitempty"`
+	InDegree      uint32 `protobuf:"varint,7,opt,name=in_degree,json=inDegree,proto3" json:"in_degree,omitempty"`
+	OutDegree     uint32 `protobuf:"varint,8,opt,name=out_degree,json=outDegree,proto3" json:"out_degree,omitempty"`
+	MedianBalance string `protobuf:"bytes,9,opt,name=median_balance,json=medianBalance,proto3" json:"median_balance,omitempty"`
+	Score         string `protobuf:"bytes,10,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *GetNebulasRankResponse) Reset()                    { *m = GetNebulasRankResponse{} }
+func (m *GetNebulasRankResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetNebulasRankResponse) ProtoMessage()               {}
+func (*GetNebulasRankResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{39} }
+
+func (m *GetNebulasRankResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetInValue() string {
+	if m != nil {
+		return m.InValue
+	}
+	return ""
+}
+
+func (m *GetNebulasRankResponse) GetOutValue() string {
+	if m != nil {
+		return m.OutValue
+	}
+	return ""
+}
+
+func (m *GetNebulasRankResponse) GetInDegree() uint32 {
+	if m != nil {
+		return m.InDegree
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetOutDegree() uint32 {
+	if m != nil {
+		return m.OutDegree
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetMedianBalance() string {
+	if m != nil {
+		return m.MedianBalance
+	}
+	return ""
+}
+
+func (m *GetNebulasRankResponse) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
+// Request message of GetDipReward rpc
+type GetDipRewardRequest struct {
+	// the developer address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Period  uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *GetDipRewardRequest) Reset()                    { *m = GetDipRewardRequest{} }
+func (m *GetDipRewardRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDipRewardRequest) ProtoMessage()               {}
+func (*GetDipRewardRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
+
+func (m *GetDipRewardRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetDipRewardRequest) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// Response message of GetDipReward rpc
+type GetDipRewardResponse struct {
+	Period      uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Window      uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// the period is not finished, the reward is estimated and not issued yet.
+	Pending bool `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	// total rewards of all developers in the period.
+	Total     string   `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	Contracts []string `protobuf:"bytes,7,rep,name=contracts" json:"contracts,omitempty"`
+	Weight    string   `protobuf:"bytes,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Reward    string   `protobuf:"bytes,9,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (m *GetDipRewardResponse) Reset()                    { *m = GetDipRewardResponse{} }
+func (m *GetDipRewardResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDipRewardResponse) ProtoMessage()               {}
+func (*GetDipRewardResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
+
+func (m *GetDipRewardResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *GetDipRewardResponse) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *GetDipRewardResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetDipRewardResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetDipRewardResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *GetDipRewardResponse) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *GetDipRewardResponse) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *GetDipRewardResponse) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+func (m *GetDipRewardResponse) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+// Request message of GetCommitCertificate rpc
+type GetCommitCertificateRequest struct {
+	// Hex string of the block hash, the latest finalized block if empty.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetCommitCertificateRequest) Reset()                    { *m = GetCommitCertificateRequest{} }
+func (m *GetCommitCertificateRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCommitCertificateRequest) ProtoMessage()               {}
+func (*GetCommitCertificateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
+
+func (m *GetCommitCertificateRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// Response message of GetCommitCertificate rpc
+type GetCommitCertificateResponse struct {
+	Height  uint64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash    string        `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Commits []*CommitVote `protobuf:"bytes,3,rep,name=commits" json:"commits,omitempty"`
+}
+
+func (m *GetCommitCertificateResponse) Reset()         { *m = GetCommitCertificateResponse{} }
+func (m *GetCommitCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCommitCertificateResponse) ProtoMessage()    {}
+func (*GetCommitCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{43}
+}
+
+func (m *GetCommitCertificateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-// Response message of GetDynastyRequest rpc
-type GetDynastyResponse struct {
-	Delegatees []string `protobuf:"bytes,1,rep,name=delegatees" json:"delegatees,omitempty"`
+func (m *GetCommitCertificateResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-func (m *GetDynastyResponse) Reset()                    { *m = GetDynastyResponse{} }
-func (m *GetDynastyResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetDynastyResponse) ProtoMessage()               {}
-func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{13} }
-
-func (m *GetDynastyResponse) GetDelegatees() []string {
+func (m *GetCommitCertificateResponse) GetCommits() []*CommitVote {
 	if m != nil {
-		return m.Delegatees
+		return m.Commits
 	}
 	return nil
 }
 
-// Response message of GetDelegateVoters rpc
-type GetDelegateVotersRequest struct {
-	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
-	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+type CommitVote struct {
+	Voter     string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Alg       uint32 `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *GetDelegateVotersRequest) Reset()                    { *m = GetDelegateVotersRequest{} }
-func (m *GetDelegateVotersRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetDelegateVotersRequest) ProtoMessage()               {}
-func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{14} }
+func (m *CommitVote) Reset()                    { *m = CommitVote{} }
+func (m *CommitVote) String() string            { return proto.CompactTextString(m) }
+func (*CommitVote) ProtoMessage()               {}
+func (*CommitVote) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
 
-func (m *GetDelegateVotersRequest) GetDelegatee() string {
+func (m *CommitVote) GetVoter() string {
 	if m != nil {
-		return m.Delegatee
+		return m.Voter
 	}
 	return ""
 }
 
-func (m *GetDelegateVotersRequest) GetHeight() uint64 {
+func (m *CommitVote) GetAlg() uint32 {
 	if m != nil {
-		return m.Height
+		return m.Alg
 	}
 	return 0
 }
 
-// Response message of GetDelegateVoters rpc
-type GetDelegateVotersResponse struct {
-	Voters []string `protobuf:"bytes,1,rep,name=voters" json:"voters,omitempty"`
-}
-
-func (m *GetDelegateVotersResponse) Reset()                    { *m = GetDelegateVotersResponse{} }
-func (m *GetDelegateVotersResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetDelegateVotersResponse) ProtoMessage()               {}
-func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{15} }
-
-func (m *GetDelegateVotersResponse) GetVoters() []string {
+func (m *CommitVote) GetSignature() string {
 	if m != nil {
-		return m.Voters
+		return m.Signature
 	}
-	return nil
+	return ""
 }
 
 // Request message of SendTransaction rpc.
@@ -598,12 +1726,26 @@ type TransactionRequest struct {
 	Delegate *DelegateRequest `protobuf:"bytes,9,opt,name=delegate" json:"delegate,omitempty"`
 	// binary data for transaction
 	Binary []byte `protobuf:"bytes,10,opt,name=binary,proto3" json:"binary,omitempty"`
+	// batch of transfers and contract calls sending with this transaction.
+	Batch *BatchRequest `protobuf:"bytes,11,opt,name=batch" json:"batch,omitempty"`
+	// the last block height the transaction can be packed in, 0 means unset.
+	ValidUntilHeight uint64 `protobuf:"varint,12,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	// the last block timestamp the transaction can be packed in, 0 means unset.
+	ValidUntilTimestamp int64 `protobuf:"varint,13,opt,name=valid_until_timestamp,json=validUntilTimestamp,proto3" json:"valid_until_timestamp,omitempty"`
+	// double sign evidence, the data of chain.doubleSign event.
+	Evidence string `protobuf:"bytes,14,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// claim the shared rewards of the sender.
+	Claim bool `protobuf:"varint,15,opt,name=claim,proto3" json:"claim,omitempty"`
+	// return the jailed sender to elections.
+	Unjail bool `protobuf:"varint,16,opt,name=unjail,proto3" json:"unjail,omitempty"`
+	// governance proposal or vote sending with this transaction.
+	Proposal *ProposalRequest `protobuf:"bytes,17,opt,name=proposal" json:"proposal,omitempty"`
 }
 
 func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
 func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*TransactionRequest) ProtoMessage()               {}
-func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
 
 func (m *TransactionRequest) GetFrom() string {
 	if m != nil {
@@ -675,6 +1817,55 @@ func (m *TransactionRequest) GetBinary() []byte {
 	return nil
 }
 
+func (m *TransactionRequest) GetBatch() *BatchRequest {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetValidUntilHeight() uint64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetValidUntilTimestamp() int64 {
+	if m != nil {
+		return m.ValidUntilTimestamp
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetClaim() bool {
+	if m != nil {
+		return m.Claim
+	}
+	return false
+}
+
+func (m *TransactionRequest) GetUnjail() bool {
+	if m != nil {
+		return m.Unjail
+	}
+	return false
+}
+
+func (m *TransactionRequest) GetProposal() *ProposalRequest {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
 type ContractRequest struct {
 	// contract source code.
 	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
@@ -689,7 +1880,7 @@ type ContractRequest struct {
 func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
 func (m *ContractRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContractRequest) ProtoMessage()               {}
-func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
+func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
 
 func (m *ContractRequest) GetSource() string {
 	if m != nil {
@@ -722,12 +1913,18 @@ func (m *ContractRequest) GetArgs() string {
 type CandidateRequest struct {
 	// candidate action.
 	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// percent of rewards kept before sharing with delegators.
+	Commission uint32 `protobuf:"varint,2,opt,name=commission,proto3" json:"commission,omitempty"`
+	// candidate metadata.
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Url      string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Endpoint string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 }
 
 func (m *CandidateRequest) Reset()                    { *m = CandidateRequest{} }
 func (m *CandidateRequest) String() string            { return proto.CompactTextString(m) }
 func (*CandidateRequest) ProtoMessage()               {}
-func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
+func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
 
 func (m *CandidateRequest) GetAction() string {
 	if m != nil {
@@ -736,17 +1933,106 @@ func (m *CandidateRequest) GetAction() string {
 	return ""
 }
 
+func (m *CandidateRequest) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *CandidateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CandidateRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CandidateRequest) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+type ProposalRequest struct {
+	// proposal action, propose or vote.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// governed param change of propose action and its activation height.
+	Param  string `protobuf:"bytes,2,opt,name=param,proto3" json:"param,omitempty"`
+	Value  string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// hex id of the proposal of vote action.
+	Proposal string `protobuf:"bytes,5,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	Approve  bool   `protobuf:"varint,6,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (m *ProposalRequest) Reset()                    { *m = ProposalRequest{} }
+func (m *ProposalRequest) String() string            { return proto.CompactTextString(m) }
+func (*ProposalRequest) ProtoMessage()               {}
+func (*ProposalRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+
+func (m *ProposalRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ProposalRequest) GetParam() string {
+	if m != nil {
+		return m.Param
+	}
+	return ""
+}
+
+func (m *ProposalRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ProposalRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProposalRequest) GetProposal() string {
+	if m != nil {
+		return m.Proposal
+	}
+	return ""
+}
+
+func (m *ProposalRequest) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
 type DelegateRequest struct {
 	// delegate action.
 	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
 	// delegatee.
 	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// stake bonded by the delegation since the staking fork.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (m *DelegateRequest) Reset()                    { *m = DelegateRequest{} }
 func (m *DelegateRequest) String() string            { return proto.CompactTextString(m) }
 func (*DelegateRequest) ProtoMessage()               {}
-func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
+func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
 
 func (m *DelegateRequest) GetAction() string {
 	if m != nil {
@@ -762,6 +2048,74 @@ func (m *DelegateRequest) GetDelegatee() string {
 	return ""
 }
 
+func (m *DelegateRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type BatchRequest struct {
+	// items executed atomically in order.
+	Items []*BatchItemRequest `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+}
+
+func (m *BatchRequest) Reset()                    { *m = BatchRequest{} }
+func (m *BatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()               {}
+func (*BatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
+
+func (m *BatchRequest) GetItems() []*BatchItemRequest {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type BatchItemRequest struct {
+	// Hex string of the receiver account addresss.
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// Amount of value sending with this item.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// call contract function name, transfer only if empty.
+	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+	// the params of contract.
+	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *BatchItemRequest) Reset()                    { *m = BatchItemRequest{} }
+func (m *BatchItemRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchItemRequest) ProtoMessage()               {}
+func (*BatchItemRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
+
+func (m *BatchItemRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BatchItemRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *BatchItemRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *BatchItemRequest) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
 // Request message of SendRawTransactionRequest rpc.
 type SendRawTransactionRequest struct {
 	// Signed data of transaction
@@ -771,7 +2125,7 @@ type SendRawTransactionRequest struct {
 func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
 func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendRawTransactionRequest) ProtoMessage()               {}
-func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
 
 func (m *SendRawTransactionRequest) GetData() []byte {
 	if m != nil {
@@ -791,7 +2145,7 @@ type SendTransactionResponse struct {
 func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
 func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendTransactionResponse) ProtoMessage()               {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
 
 func (m *SendTransactionResponse) GetTxhash() string {
 	if m != nil {
@@ -818,7 +2172,7 @@ type GetBlockByHashRequest struct {
 func (m *GetBlockByHashRequest) Reset()                    { *m = GetBlockByHashRequest{} }
 func (m *GetBlockByHashRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetBlockByHashRequest) ProtoMessage()               {}
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
 
 func (m *GetBlockByHashRequest) GetHash() string {
 	if m != nil {
@@ -845,7 +2199,7 @@ type GetBlockByHeightRequest struct {
 func (m *GetBlockByHeightRequest) Reset()                    { *m = GetBlockByHeightRequest{} }
 func (m *GetBlockByHeightRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetBlockByHeightRequest) ProtoMessage()               {}
-func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
+func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
 
 func (m *GetBlockByHeightRequest) GetHeight() uint64 {
 	if m != nil {
@@ -870,7 +2224,7 @@ type GetTransactionByHashRequest struct {
 func (m *GetTransactionByHashRequest) Reset()                    { *m = GetTransactionByHashRequest{} }
 func (m *GetTransactionByHashRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionByHashRequest) ProtoMessage()               {}
-func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
+func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
 
 func (m *GetTransactionByHashRequest) GetHash() string {
 	if m != nil {
@@ -888,7 +2242,7 @@ type BlockDumpRequest struct {
 func (m *BlockDumpRequest) Reset()                    { *m = BlockDumpRequest{} }
 func (m *BlockDumpRequest) String() string            { return proto.CompactTextString(m) }
 func (*BlockDumpRequest) ProtoMessage()               {}
-func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
+func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
 
 func (m *BlockDumpRequest) GetCount() int32 {
 	if m != nil {
@@ -906,7 +2260,7 @@ type BlockDumpResponse struct {
 func (m *BlockDumpResponse) Reset()                    { *m = BlockDumpResponse{} }
 func (m *BlockDumpResponse) String() string            { return proto.CompactTextString(m) }
 func (*BlockDumpResponse) ProtoMessage()               {}
-func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
+func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
 
 func (m *BlockDumpResponse) GetData() string {
 	if m != nil {
@@ -941,6 +2295,8 @@ type BlockResponse struct {
 	EventsRoot string `protobuf:"bytes,13,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
 	// dpos context
 	DposContext *DposContext `protobuf:"bytes,14,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
+	// gas used by the transactions in the block, 0 before the block limits fork.
+	GasUsed string `protobuf:"bytes,15,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	// transaction slice
 	Transactions []*TransactionResponse `protobuf:"bytes,100,rep,name=transactions" json:"transactions,omitempty"`
 }
@@ -948,7 +2304,7 @@ type BlockResponse struct {
 func (m *BlockResponse) Reset()                    { *m = BlockResponse{} }
 func (m *BlockResponse) String() string            { return proto.CompactTextString(m) }
 func (*BlockResponse) ProtoMessage()               {}
-func (*BlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
+func (*BlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
 
 func (m *BlockResponse) GetHash() string {
 	if m != nil {
@@ -1034,6 +2390,13 @@ func (m *BlockResponse) GetDposContext() *DposContext {
 	return nil
 }
 
+func (m *BlockResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
 func (m *BlockResponse) GetTransactions() []*TransactionResponse {
 	if m != nil {
 		return m.Transactions
@@ -1060,7 +2423,7 @@ type DposContext struct {
 func (m *DposContext) Reset()                    { *m = DposContext{} }
 func (m *DposContext) String() string            { return proto.CompactTextString(m) }
 func (*DposContext) ProtoMessage()               {}
-func (*DposContext) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
+func (*DposContext) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
 
 func (m *DposContext) GetDynastyRoot() string {
 	if m != nil {
@@ -1123,103 +2486,238 @@ type TransactionResponse struct {
 	GasLimit        string `protobuf:"bytes,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	ContractAddress string `protobuf:"bytes,12,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// transaction status 0 failed, 1 success, 2 pending
-	Status int32 `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	Status              int32  `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	ValidUntilHeight    uint64 `protobuf:"varint,14,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	ValidUntilTimestamp int64  `protobuf:"varint,15,opt,name=valid_until_timestamp,json=validUntilTimestamp,proto3" json:"valid_until_timestamp,omitempty"`
+	// receipt of the transaction executed since the receipts fork, only returned by GetTransactionReceipt.
+	Receipt *TransactionReceipt `protobuf:"bytes,16,opt,name=receipt" json:"receipt,omitempty"`
 }
 
 func (m *TransactionResponse) Reset()                    { *m = TransactionResponse{} }
 func (m *TransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*TransactionResponse) ProtoMessage()               {}
-func (*TransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
+func (*TransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
+
+func (m *TransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TransactionResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
 
-func (m *TransactionResponse) GetHash() string {
+func (m *TransactionResponse) GetContractAddress() string {
 	if m != nil {
-		return m.Hash
+		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetChainId() uint32 {
+func (m *TransactionResponse) GetStatus() int32 {
 	if m != nil {
-		return m.ChainId
+		return m.Status
 	}
 	return 0
 }
 
-func (m *TransactionResponse) GetFrom() string {
+func (m *TransactionResponse) GetValidUntilHeight() uint64 {
 	if m != nil {
-		return m.From
+		return m.ValidUntilHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *TransactionResponse) GetTo() string {
+func (m *TransactionResponse) GetValidUntilTimestamp() int64 {
 	if m != nil {
-		return m.To
+		return m.ValidUntilTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *TransactionResponse) GetValue() string {
+func (m *TransactionResponse) GetReceipt() *TransactionReceipt {
 	if m != nil {
-		return m.Value
+		return m.Receipt
 	}
-	return ""
+	return nil
 }
 
-func (m *TransactionResponse) GetNonce() uint64 {
+// Receipt of an executed transaction, with its merkle proof under the receipts root of the tail block.
+type TransactionReceipt struct {
+	// transaction status 0 failed, 1 success
+	Status  int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	GasUsed string `protobuf:"bytes,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas used by the transactions in the block up to this one.
+	CumulativeGasUsed string `protobuf:"bytes,3,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// Hex string of the deployed contract address, empty if the transaction deploys no contract.
+	ContractAddress string   `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Events          []*Event `protobuf:"bytes,5,rep,name=events" json:"events,omitempty"`
+	Error           string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Hex string of the tail block hash and its receipts root.
+	BlockHash    string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	ReceiptsRoot string `protobuf:"bytes,8,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// receipt bytes and the merkle proof from the receipts root to them.
+	Data  []byte       `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	Proof []*ProofNode `protobuf:"bytes,10,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *TransactionReceipt) Reset()                    { *m = TransactionReceipt{} }
+func (m *TransactionReceipt) String() string            { return proto.CompactTextString(m) }
+func (*TransactionReceipt) ProtoMessage()               {}
+func (*TransactionReceipt) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
+
+func (m *TransactionReceipt) GetStatus() int32 {
 	if m != nil {
-		return m.Nonce
+		return m.Status
 	}
 	return 0
 }
 
-func (m *TransactionResponse) GetTimestamp() int64 {
+func (m *TransactionReceipt) GetGasUsed() string {
 	if m != nil {
-		return m.Timestamp
+		return m.GasUsed
 	}
-	return 0
+	return ""
 }
 
-func (m *TransactionResponse) GetType() string {
+func (m *TransactionReceipt) GetCumulativeGasUsed() string {
 	if m != nil {
-		return m.Type
+		return m.CumulativeGasUsed
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetData() []byte {
+func (m *TransactionReceipt) GetContractAddress() string {
 	if m != nil {
-		return m.Data
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TransactionReceipt) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
 	}
 	return nil
 }
 
-func (m *TransactionResponse) GetGasPrice() string {
+func (m *TransactionReceipt) GetError() string {
 	if m != nil {
-		return m.GasPrice
+		return m.Error
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetGasLimit() string {
+func (m *TransactionReceipt) GetBlockHash() string {
 	if m != nil {
-		return m.GasLimit
+		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetContractAddress() string {
+func (m *TransactionReceipt) GetReceiptsRoot() string {
 	if m != nil {
-		return m.ContractAddress
+		return m.ReceiptsRoot
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetStatus() int32 {
+func (m *TransactionReceipt) GetData() []byte {
 	if m != nil {
-		return m.Status
+		return m.Data
 	}
-	return 0
+	return nil
+}
+
+func (m *TransactionReceipt) GetProof() []*ProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type ProofNode struct {
+	Val [][]byte `protobuf:"bytes,1,rep,name=val" json:"val,omitempty"`
+}
+
+func (m *ProofNode) Reset()                    { *m = ProofNode{} }
+func (m *ProofNode) String() string            { return proto.CompactTextString(m) }
+func (*ProofNode) ProtoMessage()               {}
+func (*ProofNode) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{63} }
+
+func (m *ProofNode) GetVal() [][]byte {
+	if m != nil {
+		return m.Val
+	}
+	return nil
 }
 
 type NewAccountRequest struct {
@@ -1229,7 +2727,7 @@ type NewAccountRequest struct {
 func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
 func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewAccountRequest) ProtoMessage()               {}
-func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
+func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
 
 func (m *NewAccountRequest) GetPassphrase() string {
 	if m != nil {
@@ -1245,7 +2743,7 @@ type NewAccountResponse struct {
 func (m *NewAccountResponse) Reset()                    { *m = NewAccountResponse{} }
 func (m *NewAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewAccountResponse) ProtoMessage()               {}
-func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
+func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
 
 func (m *NewAccountResponse) GetAddress() string {
 	if m != nil {
@@ -1263,7 +2761,7 @@ type UnlockAccountRequest struct {
 func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
 func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*UnlockAccountRequest) ProtoMessage()               {}
-func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
 
 func (m *UnlockAccountRequest) GetAddress() string {
 	if m != nil {
@@ -1293,7 +2791,7 @@ type UnlockAccountResponse struct {
 func (m *UnlockAccountResponse) Reset()                    { *m = UnlockAccountResponse{} }
 func (m *UnlockAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*UnlockAccountResponse) ProtoMessage()               {}
-func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{67} }
 
 func (m *UnlockAccountResponse) GetResult() bool {
 	if m != nil {
@@ -1309,7 +2807,7 @@ type LockAccountRequest struct {
 func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
 func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*LockAccountRequest) ProtoMessage()               {}
-func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
+func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
 
 func (m *LockAccountRequest) GetAddress() string {
 	if m != nil {
@@ -1325,7 +2823,7 @@ type LockAccountResponse struct {
 func (m *LockAccountResponse) Reset()                    { *m = LockAccountResponse{} }
 func (m *LockAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*LockAccountResponse) ProtoMessage()               {}
-func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
+func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
 
 func (m *LockAccountResponse) GetResult() bool {
 	if m != nil {
@@ -1341,7 +2839,7 @@ type SignTransactionResponse struct {
 func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
 func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignTransactionResponse) ProtoMessage()               {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
 
 func (m *SignTransactionResponse) GetData() []byte {
 	if m != nil {
@@ -1361,7 +2859,7 @@ func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactio
 func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
 func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{37}
+	return fileDescriptorRpc, []int{71}
 }
 
 func (m *SendTransactionPassphraseRequest) GetTransaction() *TransactionRequest {
@@ -1385,7 +2883,7 @@ type GasPriceResponse struct {
 func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
 func (m *GasPriceResponse) String() string            { return proto.CompactTextString(m) }
 func (*GasPriceResponse) ProtoMessage()               {}
-func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
+func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{72} }
 
 func (m *GasPriceResponse) GetGasPrice() string {
 	if m != nil {
@@ -1394,6 +2892,41 @@ func (m *GasPriceResponse) GetGasPrice() string {
 	return ""
 }
 
+type GasPriceSuggestionResponse struct {
+	// gas price likely to be included in the next few blocks.
+	Slow string `protobuf:"bytes,1,opt,name=slow,proto3" json:"slow,omitempty"`
+	// gas price likely to be included in the next block or two.
+	Standard string `protobuf:"bytes,2,opt,name=standard,proto3" json:"standard,omitempty"`
+	// gas price likely to be included in the next block.
+	Fast string `protobuf:"bytes,3,opt,name=fast,proto3" json:"fast,omitempty"`
+}
+
+func (m *GasPriceSuggestionResponse) Reset()                    { *m = GasPriceSuggestionResponse{} }
+func (m *GasPriceSuggestionResponse) String() string            { return proto.CompactTextString(m) }
+func (*GasPriceSuggestionResponse) ProtoMessage()               {}
+func (*GasPriceSuggestionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
+
+func (m *GasPriceSuggestionResponse) GetSlow() string {
+	if m != nil {
+		return m.Slow
+	}
+	return ""
+}
+
+func (m *GasPriceSuggestionResponse) GetStandard() string {
+	if m != nil {
+		return m.Standard
+	}
+	return ""
+}
+
+func (m *GasPriceSuggestionResponse) GetFast() string {
+	if m != nil {
+		return m.Fast
+	}
+	return ""
+}
+
 // Request message of GetTransactionByHash rpc.
 type HashRequest struct {
 	// Hex string of block/transaction hash.
@@ -1403,7 +2936,7 @@ type HashRequest struct {
 func (m *HashRequest) Reset()                    { *m = HashRequest{} }
 func (m *HashRequest) String() string            { return proto.CompactTextString(m) }
 func (*HashRequest) ProtoMessage()               {}
-func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{39} }
+func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
 
 func (m *HashRequest) GetHash() string {
 	if m != nil {
@@ -1419,7 +2952,7 @@ type GasResponse struct {
 func (m *GasResponse) Reset()                    { *m = GasResponse{} }
 func (m *GasResponse) String() string            { return proto.CompactTextString(m) }
 func (*GasResponse) ProtoMessage()               {}
-func (*GasResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
+func (*GasResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{75} }
 
 func (m *GasResponse) GetGas() string {
 	if m != nil {
@@ -1435,7 +2968,7 @@ type EventsResponse struct {
 func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
 func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
 func (*EventsResponse) ProtoMessage()               {}
-func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
+func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{76} }
 
 func (m *EventsResponse) GetEvents() []*Event {
 	if m != nil {
@@ -1452,7 +2985,7 @@ type Event struct {
 func (m *Event) Reset()                    { *m = Event{} }
 func (m *Event) String() string            { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()               {}
-func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{77} }
 
 func (m *Event) GetTopic() string {
 	if m != nil {
@@ -1468,6 +3001,143 @@ func (m *Event) GetData() string {
 	return ""
 }
 
+// Request message of GetLogs rpc.
+type GetLogsRequest struct {
+	// block height range, from_height 0 means the genesis, to_height 0 or above the tail means the tail.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// Hex string of the emitting contract, empty for any.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// full event topics matched by any, e.g. chain.contract.transfer, empty for any but the
+	// execution results of txs, e.g. chain.transactionResult, which are only matched if listed.
+	Topics []string `protobuf:"bytes,4,rep,name=topics" json:"topics,omitempty"`
+	// max number of logs, the logs of the last block are not split. 0 for the max 10000.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
+func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLogsRequest) ProtoMessage()               {}
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{78} }
+
+func (m *GetLogsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Response message of GetLogs rpc.
+type GetLogsResponse struct {
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+	// from_height to continue the query with, 0 if the range is done.
+	NextHeight uint64 `protobuf:"varint,2,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *GetLogsResponse) Reset()                    { *m = GetLogsResponse{} }
+func (m *GetLogsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetLogsResponse) ProtoMessage()               {}
+func (*GetLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{79} }
+
+func (m *GetLogsResponse) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *GetLogsResponse) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+type Log struct {
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash   string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxHash      string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Hex string of the contract the tx deployed or called, empty for other txs.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Topic   string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data    string `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Log) Reset()                    { *m = Log{} }
+func (m *Log) String() string            { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()               {}
+func (*Log) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{80} }
+
+func (m *Log) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Log) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Log) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Log) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Log) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Log) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
 // Request message of change networkID.
 type ChangeNetworkIDRequest struct {
 	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
@@ -1476,7 +3146,7 @@ type ChangeNetworkIDRequest struct {
 func (m *ChangeNetworkIDRequest) Reset()                    { *m = ChangeNetworkIDRequest{} }
 func (m *ChangeNetworkIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChangeNetworkIDRequest) ProtoMessage()               {}
-func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
+func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{81} }
 
 func (m *ChangeNetworkIDRequest) GetNetworkId() uint32 {
 	if m != nil {
@@ -1493,7 +3163,7 @@ type ChangeNetworkIDResponse struct {
 func (m *ChangeNetworkIDResponse) Reset()                    { *m = ChangeNetworkIDResponse{} }
 func (m *ChangeNetworkIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChangeNetworkIDResponse) ProtoMessage()               {}
-func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
+func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{82} }
 
 func (m *ChangeNetworkIDResponse) GetResult() bool {
 	if m != nil {
@@ -1510,7 +3180,7 @@ type StartMiningRequest struct {
 func (m *StartMiningRequest) Reset()                    { *m = StartMiningRequest{} }
 func (m *StartMiningRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartMiningRequest) ProtoMessage()               {}
-func (*StartMiningRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
+func (*StartMiningRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{83} }
 
 func (m *StartMiningRequest) GetPassphrase() string {
 	if m != nil {
@@ -1526,7 +3196,7 @@ type MiningResponse struct {
 func (m *MiningResponse) Reset()                    { *m = MiningResponse{} }
 func (m *MiningResponse) String() string            { return proto.CompactTextString(m) }
 func (*MiningResponse) ProtoMessage()               {}
-func (*MiningResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+func (*MiningResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{84} }
 
 func (m *MiningResponse) GetResult() bool {
 	if m != nil {
@@ -1542,7 +3212,7 @@ type PprofRequest struct {
 func (m *PprofRequest) Reset()                    { *m = PprofRequest{} }
 func (m *PprofRequest) String() string            { return proto.CompactTextString(m) }
 func (*PprofRequest) ProtoMessage()               {}
-func (*PprofRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
+func (*PprofRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{85} }
 
 func (m *PprofRequest) GetListen() string {
 	if m != nil {
@@ -1558,7 +3228,7 @@ type PprofResponse struct {
 func (m *PprofResponse) Reset()                    { *m = PprofResponse{} }
 func (m *PprofResponse) String() string            { return proto.CompactTextString(m) }
 func (*PprofResponse) ProtoMessage()               {}
-func (*PprofResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+func (*PprofResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{86} }
 
 func (m *PprofResponse) GetResult() bool {
 	if m != nil {
@@ -1573,21 +3243,53 @@ func init() {
 	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
 	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
 	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
+	proto.RegisterType((*ConsensusStateResponse)(nil), "rpcpb.ConsensusStateResponse")
+	proto.RegisterType((*ConsensusTransition)(nil), "rpcpb.ConsensusTransition")
+	proto.RegisterType((*ForkTreeResponse)(nil), "rpcpb.ForkTreeResponse")
+	proto.RegisterType((*ForkBlock)(nil), "rpcpb.ForkBlock")
+	proto.RegisterType((*ForkTail)(nil), "rpcpb.ForkTail")
 	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
 	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
 	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
 	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
 	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
+	proto.RegisterType((*GetAccountStorageRequest)(nil), "rpcpb.GetAccountStorageRequest")
+	proto.RegisterType((*GetAccountStorageResponse)(nil), "rpcpb.GetAccountStorageResponse")
 	proto.RegisterType((*CallResponse)(nil), "rpcpb.CallResponse")
 	proto.RegisterType((*ByBlockHeightRequest)(nil), "rpcpb.ByBlockHeightRequest")
 	proto.RegisterType((*GetCandidatesResponse)(nil), "rpcpb.GetCandidatesResponse")
+	proto.RegisterType((*CandidateInfo)(nil), "rpcpb.CandidateInfo")
 	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
+	proto.RegisterType((*GetProposerScheduleResponse)(nil), "rpcpb.GetProposerScheduleResponse")
+	proto.RegisterType((*DynastySchedule)(nil), "rpcpb.DynastySchedule")
+	proto.RegisterType((*ProposerSlot)(nil), "rpcpb.ProposerSlot")
+	proto.RegisterType((*MintStat)(nil), "rpcpb.MintStat")
 	proto.RegisterType((*GetDelegateVotersRequest)(nil), "rpcpb.GetDelegateVotersRequest")
 	proto.RegisterType((*GetDelegateVotersResponse)(nil), "rpcpb.GetDelegateVotersResponse")
+	proto.RegisterType((*GetClaimableRewardRequest)(nil), "rpcpb.GetClaimableRewardRequest")
+	proto.RegisterType((*GetClaimableRewardResponse)(nil), "rpcpb.GetClaimableRewardResponse")
+	proto.RegisterType((*GetValidatorLivenessRequest)(nil), "rpcpb.GetValidatorLivenessRequest")
+	proto.RegisterType((*GetValidatorLivenessResponse)(nil), "rpcpb.GetValidatorLivenessResponse")
+	proto.RegisterType((*LivenessRecord)(nil), "rpcpb.LivenessRecord")
+	proto.RegisterType((*GetJailedValidatorsResponse)(nil), "rpcpb.GetJailedValidatorsResponse")
+	proto.RegisterType((*JailedValidator)(nil), "rpcpb.JailedValidator")
+	proto.RegisterType((*GetProposalRequest)(nil), "rpcpb.GetProposalRequest")
+	proto.RegisterType((*GetProposalResponse)(nil), "rpcpb.GetProposalResponse")
+	proto.RegisterType((*GetGovernedParamsResponse)(nil), "rpcpb.GetGovernedParamsResponse")
+	proto.RegisterType((*GetNebulasRankRequest)(nil), "rpcpb.GetNebulasRankRequest")
+	proto.RegisterType((*GetNebulasRankResponse)(nil), "rpcpb.GetNebulasRankResponse")
+	proto.RegisterType((*GetDipRewardRequest)(nil), "rpcpb.GetDipRewardRequest")
+	proto.RegisterType((*GetDipRewardResponse)(nil), "rpcpb.GetDipRewardResponse")
+	proto.RegisterType((*GetCommitCertificateRequest)(nil), "rpcpb.GetCommitCertificateRequest")
+	proto.RegisterType((*GetCommitCertificateResponse)(nil), "rpcpb.GetCommitCertificateResponse")
+	proto.RegisterType((*CommitVote)(nil), "rpcpb.CommitVote")
 	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
 	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
 	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
+	proto.RegisterType((*ProposalRequest)(nil), "rpcpb.ProposalRequest")
 	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
+	proto.RegisterType((*BatchRequest)(nil), "rpcpb.BatchRequest")
+	proto.RegisterType((*BatchItemRequest)(nil), "rpcpb.BatchItemRequest")
 	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
 	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
@@ -1598,6 +3300,8 @@ func init() {
 	proto.RegisterType((*BlockResponse)(nil), "rpcpb.BlockResponse")
 	proto.RegisterType((*DposContext)(nil), "rpcpb.DposContext")
 	proto.RegisterType((*TransactionResponse)(nil), "rpcpb.TransactionResponse")
+	proto.RegisterType((*TransactionReceipt)(nil), "rpcpb.TransactionReceipt")
+	proto.RegisterType((*ProofNode)(nil), "rpcpb.ProofNode")
 	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
 	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
 	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
@@ -1607,10 +3311,14 @@ func init() {
 	proto.RegisterType((*SignTransactionResponse)(nil), "rpcpb.SignTransactionResponse")
 	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
 	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
+	proto.RegisterType((*GasPriceSuggestionResponse)(nil), "rpcpb.GasPriceSuggestionResponse")
 	proto.RegisterType((*HashRequest)(nil), "rpcpb.HashRequest")
 	proto.RegisterType((*GasResponse)(nil), "rpcpb.GasResponse")
 	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
 	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*GetLogsRequest)(nil), "rpcpb.GetLogsRequest")
+	proto.RegisterType((*GetLogsResponse)(nil), "rpcpb.GetLogsResponse")
+	proto.RegisterType((*Log)(nil), "rpcpb.Log")
 	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
 	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
 	proto.RegisterType((*StartMiningRequest)(nil), "rpcpb.StartMiningRequest")
@@ -1642,6 +3350,8 @@ type ApiServiceClient interface {
 	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// Return the state of the account.
 	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
+	// Return the value of the key in the account's storage.
+	GetAccountStorage(ctx context.Context, in *GetAccountStorageRequest, opts ...grpc.CallOption) (*GetAccountStorageResponse, error)
 	// Verify, sign, and send the transaction.
 	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	// Call smart contract.
@@ -1658,14 +3368,26 @@ type ApiServiceClient interface {
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
 	// Get GasPrice
 	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// Get slow, standard and fast GasPrice suggestions
+	GetGasPriceSuggestion(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceSuggestionResponse, error)
 	// EstimateGas
 	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error)
 	// Get GasUsed
 	GetGasUsed(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*GasResponse, error)
 	GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
 	GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
+	GetProposerSchedule(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetProposerScheduleResponse, error)
 	GetCandidates(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error)
 	GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error)
+	GetClaimableReward(ctx context.Context, in *GetClaimableRewardRequest, opts ...grpc.CallOption) (*GetClaimableRewardResponse, error)
+	GetValidatorLiveness(ctx context.Context, in *GetValidatorLivenessRequest, opts ...grpc.CallOption) (*GetValidatorLivenessResponse, error)
+	GetJailedValidators(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetJailedValidatorsResponse, error)
+	GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error)
+	GetGovernedParams(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetGovernedParamsResponse, error)
+	GetNebulasRank(ctx context.Context, in *GetNebulasRankRequest, opts ...grpc.CallOption) (*GetNebulasRankResponse, error)
+	GetDipReward(ctx context.Context, in *GetDipRewardRequest, opts ...grpc.CallOption) (*GetDipRewardResponse, error)
+	GetCommitCertificate(ctx context.Context, in *GetCommitCertificateRequest, opts ...grpc.CallOption) (*GetCommitCertificateResponse, error)
 }
 
 type apiServiceClient struct {
@@ -1730,6 +3452,15 @@ func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountSt
 	return out, nil
 }
 
+func (c *apiServiceClient) GetAccountStorage(ctx context.Context, in *GetAccountStorageRequest, opts ...grpc.CallOption) (*GetAccountStorageResponse, error) {
+	out := new(GetAccountStorageResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountStorage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
 	out := new(SendTransactionResponse)
 	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, c.cc, opts...)
@@ -1808,71 +3539,170 @@ type apiServiceSubscribeClient struct {
 	grpc.ClientStream
 }
 
-func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
-	m := new(SubscribeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetGasPriceSuggestion(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceSuggestionResponse, error) {
+	out := new(GasPriceSuggestionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPriceSuggestion", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error) {
+	out := new(GasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetGasUsed(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*GasResponse, error) {
+	out := new(GasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasUsed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	out := new(GetLogsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
+	out := new(GetDynastyResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDynasty", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProposerSchedule(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetProposerScheduleResponse, error) {
+	out := new(GetProposerScheduleResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetProposerSchedule", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetCandidates(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error) {
+	out := new(GetCandidatesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetCandidates", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error) {
+	out := new(GetDelegateVotersResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDelegateVoters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetClaimableReward(ctx context.Context, in *GetClaimableRewardRequest, opts ...grpc.CallOption) (*GetClaimableRewardResponse, error) {
+	out := new(GetClaimableRewardResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetClaimableReward", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetValidatorLiveness(ctx context.Context, in *GetValidatorLivenessRequest, opts ...grpc.CallOption) (*GetValidatorLivenessResponse, error) {
+	out := new(GetValidatorLivenessResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetValidatorLiveness", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error) {
-	out := new(GasResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetJailedValidators(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetJailedValidatorsResponse, error) {
+	out := new(GetJailedValidatorsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetJailedValidators", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetGasUsed(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*GasResponse, error) {
-	out := new(GasResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasUsed", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error) {
+	out := new(GetProposalResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetProposal", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
-	out := new(EventsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetGovernedParams(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetGovernedParamsResponse, error) {
+	out := new(GetGovernedParamsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGovernedParams", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
-	out := new(GetDynastyResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDynasty", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetNebulasRank(ctx context.Context, in *GetNebulasRankRequest, opts ...grpc.CallOption) (*GetNebulasRankResponse, error) {
+	out := new(GetNebulasRankResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebulasRank", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetCandidates(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error) {
-	out := new(GetCandidatesResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetCandidates", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetDipReward(ctx context.Context, in *GetDipRewardRequest, opts ...grpc.CallOption) (*GetDipRewardResponse, error) {
+	out := new(GetDipRewardResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDipReward", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error) {
-	out := new(GetDelegateVotersResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDelegateVoters", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetCommitCertificate(ctx context.Context, in *GetCommitCertificateRequest, opts ...grpc.CallOption) (*GetCommitCertificateResponse, error) {
+	out := new(GetCommitCertificateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetCommitCertificate", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1894,6 +3724,8 @@ type ApiServiceServer interface {
 	Accounts(context.Context, *NonParamsRequest) (*AccountsResponse, error)
 	// Return the state of the account.
 	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
+	// Return the value of the key in the account's storage.
+	GetAccountStorage(context.Context, *GetAccountStorageRequest) (*GetAccountStorageResponse, error)
 	// Verify, sign, and send the transaction.
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
 	// Call smart contract.
@@ -1910,14 +3742,26 @@ type ApiServiceServer interface {
 	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
 	// Get GasPrice
 	GetGasPrice(context.Context, *NonParamsRequest) (*GasPriceResponse, error)
+	// Get slow, standard and fast GasPrice suggestions
+	GetGasPriceSuggestion(context.Context, *NonParamsRequest) (*GasPriceSuggestionResponse, error)
 	// EstimateGas
 	EstimateGas(context.Context, *TransactionRequest) (*GasResponse, error)
 	// Get GasUsed
 	GetGasUsed(context.Context, *HashRequest) (*GasResponse, error)
 	GetEventsByHash(context.Context, *HashRequest) (*EventsResponse, error)
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
 	GetDynasty(context.Context, *ByBlockHeightRequest) (*GetDynastyResponse, error)
+	GetProposerSchedule(context.Context, *ByBlockHeightRequest) (*GetProposerScheduleResponse, error)
 	GetCandidates(context.Context, *ByBlockHeightRequest) (*GetCandidatesResponse, error)
 	GetDelegateVoters(context.Context, *GetDelegateVotersRequest) (*GetDelegateVotersResponse, error)
+	GetClaimableReward(context.Context, *GetClaimableRewardRequest) (*GetClaimableRewardResponse, error)
+	GetValidatorLiveness(context.Context, *GetValidatorLivenessRequest) (*GetValidatorLivenessResponse, error)
+	GetJailedValidators(context.Context, *ByBlockHeightRequest) (*GetJailedValidatorsResponse, error)
+	GetProposal(context.Context, *GetProposalRequest) (*GetProposalResponse, error)
+	GetGovernedParams(context.Context, *ByBlockHeightRequest) (*GetGovernedParamsResponse, error)
+	GetNebulasRank(context.Context, *GetNebulasRankRequest) (*GetNebulasRankResponse, error)
+	GetDipReward(context.Context, *GetDipRewardRequest) (*GetDipRewardResponse, error)
+	GetCommitCertificate(context.Context, *GetCommitCertificateRequest) (*GetCommitCertificateResponse, error)
 }
 
 func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
@@ -2032,6 +3876,24 @@ func _ApiService_GetAccountState_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetAccountStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountStorage(ctx, req.(*GetAccountStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
@@ -2179,6 +4041,24 @@ func _ApiService_GetGasPrice_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetGasPriceSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetGasPriceSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetGasPriceSuggestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetGasPriceSuggestion(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
@@ -2233,6 +4113,24 @@ func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetLogs(ctx, req.(*GetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetDynasty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ByBlockHeightRequest)
 	if err := dec(in); err != nil {
@@ -2251,6 +4149,24 @@ func _ApiService_GetDynasty_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetProposerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProposerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetProposerSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProposerSchedule(ctx, req.(*ByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ByBlockHeightRequest)
 	if err := dec(in); err != nil {
@@ -2287,6 +4203,150 @@ func _ApiService_GetDelegateVoters_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetClaimableReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimableRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetClaimableReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetClaimableReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetClaimableReward(ctx, req.(*GetClaimableRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetValidatorLiveness(ctx, req.(*GetValidatorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetJailedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetJailedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetJailedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetJailedValidators(ctx, req.(*ByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProposal(ctx, req.(*GetProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetGovernedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetGovernedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetGovernedParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetGovernedParams(ctx, req.(*ByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetNebulasRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNebulasRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNebulasRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNebulasRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNebulasRank(ctx, req.(*GetNebulasRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetDipReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDipRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetDipReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetDipReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetDipReward(ctx, req.(*GetDipRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetCommitCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetCommitCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetCommitCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetCommitCertificate(ctx, req.(*GetCommitCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -2315,6 +4375,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountState",
 			Handler:    _ApiService_GetAccountState_Handler,
 		},
+		{
+			MethodName: "GetAccountStorage",
+			Handler:    _ApiService_GetAccountStorage_Handler,
+		},
 		{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
@@ -2343,6 +4407,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetGasPrice",
 			Handler:    _ApiService_GetGasPrice_Handler,
 		},
+		{
+			MethodName: "GetGasPriceSuggestion",
+			Handler:    _ApiService_GetGasPriceSuggestion_Handler,
+		},
 		{
 			MethodName: "EstimateGas",
 			Handler:    _ApiService_EstimateGas_Handler,
@@ -2355,10 +4423,18 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventsByHash",
 			Handler:    _ApiService_GetEventsByHash_Handler,
 		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _ApiService_GetLogs_Handler,
+		},
 		{
 			MethodName: "GetDynasty",
 			Handler:    _ApiService_GetDynasty_Handler,
 		},
+		{
+			MethodName: "GetProposerSchedule",
+			Handler:    _ApiService_GetProposerSchedule_Handler,
+		},
 		{
 			MethodName: "GetCandidates",
 			Handler:    _ApiService_GetCandidates_Handler,
@@ -2367,6 +4443,38 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDelegateVoters",
 			Handler:    _ApiService_GetDelegateVoters_Handler,
 		},
+		{
+			MethodName: "GetClaimableReward",
+			Handler:    _ApiService_GetClaimableReward_Handler,
+		},
+		{
+			MethodName: "GetValidatorLiveness",
+			Handler:    _ApiService_GetValidatorLiveness_Handler,
+		},
+		{
+			MethodName: "GetJailedValidators",
+			Handler:    _ApiService_GetJailedValidators_Handler,
+		},
+		{
+			MethodName: "GetProposal",
+			Handler:    _ApiService_GetProposal_Handler,
+		},
+		{
+			MethodName: "GetGovernedParams",
+			Handler:    _ApiService_GetGovernedParams_Handler,
+		},
+		{
+			MethodName: "GetNebulasRank",
+			Handler:    _ApiService_GetNebulasRank_Handler,
+		},
+		{
+			MethodName: "GetDipReward",
+			Handler:    _ApiService_GetDipReward_Handler,
+		},
+		{
+			MethodName: "GetCommitCertificate",
+			Handler:    _ApiService_GetCommitCertificate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2392,6 +4500,8 @@ type AdminServiceClient interface {
 	// SendTransactionWithPassphrase send transaction with passphrase
 	SendTransactionWithPassphrase(ctx context.Context, in *SendTransactionPassphraseRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	StatisticsNodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*StatisticsNodeInfoResponse, error)
+	ConsensusState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ConsensusStateResponse, error)
+	ForkTree(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ForkTreeResponse, error)
 	ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error)
 	StartMining(ctx context.Context, in *StartMiningRequest, opts ...grpc.CallOption) (*MiningResponse, error)
 	StopMining(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MiningResponse, error)
@@ -2460,6 +4570,24 @@ func (c *adminServiceClient) StatisticsNodeInfo(ctx context.Context, in *NonPara
 	return out, nil
 }
 
+func (c *adminServiceClient) ConsensusState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ConsensusStateResponse, error) {
+	out := new(ConsensusStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ConsensusState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForkTree(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ForkTreeResponse, error) {
+	out := new(ForkTreeResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ForkTree", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error) {
 	out := new(ChangeNetworkIDResponse)
 	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ChangeNetworkID", in, out, c.cc, opts...)
@@ -2510,6 +4638,8 @@ type AdminServiceServer interface {
 	// SendTransactionWithPassphrase send transaction with passphrase
 	SendTransactionWithPassphrase(context.Context, *SendTransactionPassphraseRequest) (*SendTransactionResponse, error)
 	StatisticsNodeInfo(context.Context, *NonParamsRequest) (*StatisticsNodeInfoResponse, error)
+	ConsensusState(context.Context, *NonParamsRequest) (*ConsensusStateResponse, error)
+	ForkTree(context.Context, *NonParamsRequest) (*ForkTreeResponse, error)
 	ChangeNetworkID(context.Context, *ChangeNetworkIDRequest) (*ChangeNetworkIDResponse, error)
 	StartMining(context.Context, *StartMiningRequest) (*MiningResponse, error)
 	StopMining(context.Context, *NonParamsRequest) (*MiningResponse, error)
@@ -2628,6 +4758,42 @@ func _AdminService_StatisticsNodeInfo_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/ConsensusState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ConsensusState(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForkTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForkTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/ForkTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForkTree(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ChangeNetworkID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangeNetworkIDRequest)
 	if err := dec(in); err != nil {
@@ -2728,6 +4894,14 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StatisticsNodeInfo",
 			Handler:    _AdminService_StatisticsNodeInfo_Handler,
 		},
+		{
+			MethodName: "ConsensusState",
+			Handler:    _AdminService_ConsensusState_Handler,
+		},
+		{
+			MethodName: "ForkTree",
+			Handler:    _AdminService_ForkTree_Handler,
+		},
 		{
 			MethodName: "ChangeNetworkID",
 			Handler:    _AdminService_ChangeNetworkID_Handler,
@@ -2752,163 +4926,287 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 2520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x19, 0xcb, 0x6e, 0x1b, 0xc9,
-	0x11, 0xa4, 0x5e, 0x64, 0x91, 0x7a, 0xb5, 0x5e, 0x14, 0xf5, 0x74, 0x7b, 0x77, 0xad, 0x15, 0xb0,
-	0xd2, 0x5a, 0xde, 0x5d, 0x03, 0x0e, 0x12, 0xc0, 0x96, 0x0d, 0xad, 0x02, 0xc7, 0x10, 0x46, 0x5e,
-	0x2f, 0x10, 0xc4, 0x21, 0x9a, 0x33, 0x6d, 0x6a, 0xe2, 0xe1, 0xcc, 0x64, 0xa6, 0xa9, 0x87, 0x0f,
-	0x09, 0xb0, 0x3f, 0x90, 0x43, 0x2e, 0xb9, 0xe4, 0x03, 0x72, 0xca, 0xc7, 0x04, 0xd9, 0x2f, 0xc8,
-	0x25, 0x7f, 0xb1, 0xe8, 0xea, 0xee, 0x79, 0x53, 0xb2, 0x6f, 0xac, 0xea, 0xea, 0xaa, 0x9a, 0x7a,
-	0x57, 0x13, 0x9a, 0x51, 0x68, 0x1f, 0x84, 0x51, 0x20, 0x02, 0x32, 0x15, 0x85, 0x76, 0xd8, 0xef,
-	0x6e, 0x0e, 0x82, 0x60, 0xe0, 0xf1, 0x43, 0x16, 0xba, 0x87, 0xcc, 0xf7, 0x03, 0xc1, 0x84, 0x1b,
-	0xf8, 0xb1, 0x22, 0xa2, 0xfb, 0xb0, 0x70, 0x3e, 0xea, 0xc7, 0x76, 0xe4, 0xf6, 0xb9, 0xc5, 0xff,
-	0x3c, 0xe2, 0xb1, 0x20, 0xab, 0x30, 0x2d, 0x82, 0xd0, 0xb5, 0xe3, 0x4e, 0x6d, 0x77, 0x62, 0xaf,
-	0x69, 0x69, 0x88, 0xfe, 0x1a, 0x16, 0x33, 0xb4, 0x71, 0x18, 0xf8, 0x31, 0x27, 0xcb, 0x30, 0x85,
-	0xc7, 0x9d, 0xda, 0x6e, 0x6d, 0xaf, 0x69, 0x29, 0x80, 0x10, 0x98, 0x74, 0x98, 0x60, 0x9d, 0x3a,
-	0x22, 0xf1, 0x37, 0x25, 0xb0, 0xf0, 0x2a, 0xf0, 0xcf, 0x58, 0xc4, 0x86, 0xb1, 0x16, 0x45, 0xff,
-	0x35, 0x21, 0x91, 0x0e, 0x3f, 0xf5, 0xdf, 0x05, 0x09, 0xcb, 0x39, 0xa8, 0xbb, 0x8e, 0xe6, 0x57,
-	0x77, 0x1d, 0xb2, 0x0e, 0x0d, 0xfb, 0x82, 0xb9, 0x7e, 0xcf, 0x75, 0x90, 0xe1, 0xac, 0x35, 0x83,
-	0xf0, 0xa9, 0x43, 0x3a, 0x30, 0x73, 0xc9, 0xa3, 0xd8, 0x0d, 0xfc, 0xce, 0x84, 0x3a, 0xd1, 0x20,
-	0xd9, 0x02, 0x08, 0x39, 0x8f, 0x7a, 0x76, 0x30, 0xf2, 0x45, 0x67, 0x12, 0x0f, 0x9b, 0x12, 0x73,
-	0x2c, 0x11, 0x84, 0x42, 0x3b, 0xbe, 0xf1, 0xed, 0x8b, 0x28, 0xf0, 0xdd, 0x0f, 0xdc, 0xe9, 0x4c,
-	0xed, 0xd6, 0xf6, 0x1a, 0x56, 0x0e, 0x47, 0x76, 0xa0, 0xd5, 0x1f, 0xd9, 0xef, 0xb9, 0xe8, 0xc5,
-	0xee, 0x07, 0xde, 0x99, 0xde, 0xad, 0xed, 0x4d, 0x59, 0xa0, 0x50, 0xe7, 0xee, 0x07, 0x4e, 0xf6,
-	0x60, 0x21, 0xe2, 0x1e, 0xbb, 0xe9, 0xd9, 0xcc, 0xbe, 0xe0, 0x8a, 0x6a, 0x06, 0xa9, 0xe6, 0x10,
-	0x7f, 0x2c, 0xd1, 0x48, 0xb9, 0x0f, 0x8b, 0xb1, 0x88, 0x38, 0x1b, 0xf6, 0x62, 0x11, 0x44, 0x9a,
-	0xb4, 0x81, 0xa4, 0xf3, 0xea, 0xe0, 0x5c, 0xe2, 0x91, 0xf6, 0x31, 0x74, 0x72, 0xb4, 0xfc, 0x5a,
-	0x70, 0xdf, 0x51, 0x57, 0x9a, 0x78, 0x65, 0x25, 0x73, 0xe5, 0x05, 0x9e, 0xe2, 0xc5, 0x2f, 0x61,
-	0x01, 0x9d, 0x6a, 0x07, 0x5e, 0xcf, 0x58, 0x05, 0xd0, 0x8a, 0xf3, 0x06, 0xff, 0x46, 0x5b, 0xe7,
-	0x08, 0x5a, 0x51, 0x30, 0x12, 0xbc, 0x27, 0x58, 0xdf, 0xe3, 0x9d, 0xd6, 0xee, 0xc4, 0x5e, 0xeb,
-	0x68, 0xf1, 0x00, 0x23, 0xe6, 0xc0, 0x92, 0x27, 0xaf, 0xe5, 0x81, 0x05, 0x51, 0xf2, 0x9b, 0xfe,
-	0x05, 0xba, 0xe7, 0x32, 0x78, 0x62, 0xe1, 0xda, 0x71, 0xc9, 0x69, 0xab, 0x30, 0x8d, 0xb8, 0xe7,
-	0xda, 0x71, 0x1a, 0x92, 0xf8, 0xef, 0xb9, 0x3b, 0xb8, 0x10, 0xe8, 0xba, 0x49, 0x4b, 0x43, 0x32,
-	0x42, 0xbe, 0x67, 0xf1, 0x05, 0xba, 0xad, 0x69, 0xe1, 0x6f, 0xb2, 0x09, 0xcd, 0x33, 0xe3, 0x21,
-	0xe3, 0xb2, 0x04, 0x41, 0xbf, 0x03, 0x48, 0x35, 0x2b, 0x05, 0x49, 0x07, 0x66, 0x98, 0xe3, 0x44,
-	0x3c, 0x8e, 0x3b, 0x75, 0x8c, 0x5a, 0x03, 0xd2, 0x7f, 0xd6, 0x61, 0xe9, 0x84, 0x8b, 0x57, 0xbc,
-	0x2f, 0xd5, 0x4f, 0x23, 0x37, 0x1b, 0x56, 0xb5, 0x7c, 0x58, 0x11, 0x98, 0x14, 0xcc, 0xf5, 0x4c,
-	0xf8, 0xca, 0xdf, 0xf2, 0x43, 0x2e, 0xd4, 0x87, 0x4c, 0xa8, 0x0f, 0x51, 0x10, 0xe9, 0x42, 0xc3,
-	0x0e, 0x5c, 0xbf, 0xcf, 0x62, 0x8e, 0x3a, 0x37, 0xad, 0x04, 0x2e, 0x04, 0xe1, 0x54, 0x31, 0x08,
-	0x37, 0xa0, 0xe9, 0xc6, 0xbd, 0xa1, 0xeb, 0xbb, 0xfe, 0x00, 0xc3, 0xab, 0x61, 0x35, 0xdc, 0xf8,
-	0x77, 0x08, 0x57, 0x7a, 0x73, 0xa6, 0xda, 0x9b, 0xc5, 0x60, 0x6e, 0x54, 0x04, 0x73, 0x26, 0x53,
-	0x9a, 0xc8, 0xc5, 0x80, 0xf4, 0x6b, 0x58, 0x78, 0x6a, 0xa3, 0x86, 0x71, 0x62, 0x9b, 0x4d, 0x68,
-	0x6a, 0xf3, 0x71, 0x53, 0x05, 0x52, 0x04, 0xfd, 0x2d, 0xac, 0x9e, 0x70, 0xa1, 0x2f, 0x69, 0xa3,
-	0xaa, 0xd2, 0x91, 0xf1, 0x82, 0x72, 0x8d, 0x01, 0x33, 0xe6, 0xab, 0x67, 0xcd, 0x47, 0x4f, 0x61,
-	0xad, 0xc4, 0x4b, 0x2b, 0xd1, 0x81, 0x99, 0x3e, 0xf3, 0x98, 0x6f, 0x73, 0xc3, 0x4c, 0x83, 0xb2,
-	0xe8, 0xf8, 0x81, 0xc4, 0x2b, 0x07, 0x29, 0x80, 0x7e, 0x01, 0xed, 0x63, 0xe6, 0x79, 0xd9, 0x90,
-	0x8c, 0x78, 0x3c, 0xf2, 0x84, 0x09, 0x49, 0x05, 0xd1, 0x03, 0x58, 0x7e, 0x76, 0xf3, 0xcc, 0x0b,
-	0xec, 0xf7, 0x2a, 0x16, 0x33, 0x75, 0x4f, 0xab, 0x58, 0xcb, 0xa9, 0xf8, 0x18, 0x56, 0x4e, 0xb8,
-	0x38, 0x66, 0xbe, 0xe3, 0x3a, 0x4c, 0xf0, 0xd4, 0x4a, 0xdb, 0x00, 0x76, 0x82, 0xd5, 0x66, 0xca,
-	0x60, 0xe8, 0x37, 0x40, 0x4e, 0xb8, 0x78, 0x7e, 0xe3, 0xb3, 0x58, 0xdc, 0x64, 0x6f, 0x39, 0xdc,
-	0xe3, 0x03, 0x26, 0x78, 0x7a, 0x2b, 0xc5, 0xd0, 0x33, 0xe8, 0xc8, 0x5b, 0x1a, 0xf1, 0x26, 0x10,
-	0x3c, 0x32, 0xf5, 0x52, 0xfa, 0x25, 0xa1, 0xd4, 0x5f, 0x95, 0x22, 0xc6, 0xda, 0xf8, 0x11, 0xac,
-	0x57, 0x70, 0x4c, 0xad, 0x74, 0x89, 0x18, 0x53, 0xed, 0x15, 0x44, 0xff, 0x5b, 0x07, 0xf2, 0x3a,
-	0x62, 0x7e, 0xcc, 0x6c, 0xd9, 0x30, 0x8c, 0x06, 0x04, 0x26, 0xdf, 0x45, 0xc1, 0x50, 0x0b, 0xc7,
-	0xdf, 0x32, 0x17, 0x45, 0xa0, 0x7d, 0x51, 0x17, 0x81, 0x74, 0xcf, 0x25, 0xf3, 0x46, 0x5c, 0x27,
-	0xb7, 0x02, 0x52, 0xa7, 0x4d, 0xa2, 0x72, 0x0a, 0x90, 0x39, 0x30, 0x60, 0x71, 0x2f, 0x8c, 0x5c,
-	0x9b, 0x63, 0x86, 0x34, 0xad, 0xc6, 0x80, 0xc5, 0x67, 0x12, 0x36, 0x87, 0x9e, 0x3b, 0x74, 0x05,
-	0x26, 0x88, 0x3a, 0x7c, 0x29, 0x61, 0x72, 0x24, 0x13, 0xcf, 0x17, 0x11, 0xb3, 0x05, 0x26, 0x46,
-	0xeb, 0x68, 0x55, 0x17, 0xb0, 0x63, 0x8d, 0xd6, 0x3a, 0x5b, 0x09, 0x1d, 0xf9, 0x16, 0x9a, 0x89,
-	0x7f, 0x30, 0x4d, 0x5a, 0x47, 0x6b, 0xe6, 0x92, 0xc1, 0x9b, 0x5b, 0x29, 0xa5, 0x14, 0x65, 0xac,
-	0x8c, 0xd9, 0x93, 0x8a, 0x32, 0x46, 0x4d, 0x44, 0x19, 0x3a, 0x69, 0xd7, 0xbe, 0xeb, 0xb3, 0xe8,
-	0x06, 0x6b, 0x70, 0xdb, 0xd2, 0x10, 0xfd, 0x00, 0xf3, 0x05, 0xfd, 0x24, 0x69, 0x1c, 0x8c, 0xa2,
-	0x24, 0xce, 0x35, 0x24, 0x1b, 0x90, 0xfa, 0xd5, 0x13, 0x37, 0xa1, 0x09, 0x76, 0x50, 0xa8, 0xd7,
-	0x37, 0x21, 0x97, 0xb5, 0xe7, 0xdd, 0xc8, 0x47, 0xff, 0x68, 0x5b, 0x27, 0xb0, 0x74, 0x14, 0x8b,
-	0x06, 0xb1, 0xae, 0x49, 0xf8, 0x5b, 0x76, 0xfb, 0xe2, 0x67, 0x4a, 0xe1, 0xca, 0xc3, 0x46, 0xb8,
-	0x82, 0xe8, 0x09, 0xcc, 0x17, 0x3e, 0x6e, 0x1c, 0x69, 0x3e, 0x2a, 0xeb, 0x85, 0xa8, 0xa4, 0x87,
-	0xb0, 0x7e, 0xce, 0x7d, 0xc7, 0x62, 0x57, 0xd5, 0xe1, 0x84, 0x83, 0x42, 0x0d, 0x6d, 0xa4, 0x06,
-	0x85, 0x3f, 0xc0, 0x9a, 0xbc, 0x90, 0xa3, 0x4e, 0x83, 0x55, 0x5c, 0x5f, 0xc8, 0xbe, 0xa1, 0x35,
-	0x50, 0x90, 0x2c, 0x96, 0xc6, 0xc7, 0xbd, 0xb4, 0x0d, 0x60, 0xb1, 0x34, 0xf8, 0xa7, 0xba, 0x1d,
-	0xbc, 0xc1, 0x6c, 0xc6, 0xf4, 0x7f, 0x76, 0x23, 0xdb, 0x4e, 0x46, 0x95, 0x0c, 0xe7, 0x49, 0xc3,
-	0xf7, 0xdd, 0xc8, 0xf3, 0x7a, 0x22, 0xd5, 0x05, 0xf9, 0x36, 0xac, 0x79, 0x89, 0xcf, 0xa8, 0x28,
-	0xb5, 0xce, 0xf0, 0xfd, 0x98, 0xc2, 0xf2, 0x29, 0xdc, 0x1f, 0xc2, 0xc6, 0x09, 0x17, 0x19, 0xcc,
-	0x9d, 0xba, 0xd3, 0x3d, 0x58, 0x40, 0x6d, 0x9e, 0x8f, 0x86, 0xa1, 0xa1, 0x5b, 0x86, 0x29, 0xd5,
-	0x8b, 0x6a, 0x38, 0x48, 0x28, 0x80, 0x3e, 0x80, 0xc5, 0x0c, 0xa5, 0x36, 0x75, 0xd6, 0x33, 0x66,
-	0x84, 0xfb, 0xf7, 0x04, 0xcc, 0x22, 0x65, 0x96, 0xaa, 0x64, 0xb4, 0x1d, 0x68, 0x85, 0x2c, 0xe2,
-	0xbe, 0xe8, 0xe1, 0x91, 0x0e, 0x5b, 0x85, 0xc2, 0x3e, 0x3f, 0xae, 0x95, 0x56, 0x57, 0x88, 0x6c,
-	0x83, 0x9d, 0x2a, 0x34, 0xd8, 0x65, 0x98, 0x1a, 0xba, 0x3e, 0x8f, 0x74, 0x71, 0x50, 0x80, 0x8c,
-	0x47, 0xe1, 0x0e, 0x79, 0x2c, 0xd8, 0x30, 0xc4, 0xd2, 0x30, 0x61, 0xa5, 0x88, 0x5c, 0xdf, 0x6f,
-	0xe4, 0xfb, 0xfe, 0x16, 0x40, 0x2c, 0x5b, 0x50, 0x2f, 0x0a, 0x02, 0xd1, 0x69, 0xa9, 0x48, 0x46,
-	0x8c, 0x15, 0x04, 0x42, 0xde, 0x14, 0xd7, 0xb1, 0x3a, 0x6c, 0xab, 0x8e, 0x24, 0xae, 0x63, 0x3c,
-	0xda, 0x81, 0x16, 0xbf, 0xe4, 0xbe, 0xd0, 0xa7, 0xb3, 0xea, 0x9b, 0x15, 0x0a, 0x09, 0xbe, 0x85,
-	0xb6, 0x13, 0x06, 0x71, 0x4f, 0x86, 0x23, 0xbf, 0x16, 0x9d, 0x39, 0x2c, 0x23, 0xc4, 0x94, 0x91,
-	0x30, 0x88, 0x8f, 0xd5, 0x89, 0xd5, 0x72, 0x52, 0x80, 0xfc, 0x06, 0xda, 0x99, 0xe8, 0x88, 0x3b,
-	0x0e, 0x4e, 0x6a, 0x5d, 0x7d, 0xad, 0x22, 0x45, 0xac, 0x1c, 0x3d, 0xfd, 0x7f, 0x0d, 0x5a, 0x19,
-	0xe6, 0xe4, 0x1e, 0xb4, 0x1d, 0xd5, 0x8f, 0x94, 0xa2, 0xca, 0x6f, 0x2d, 0x8d, 0x43, 0x4d, 0xf7,
-	0x61, 0xd1, 0xe7, 0xd7, 0xa2, 0x97, 0xa3, 0xd3, 0xc9, 0x24, 0x0f, 0x9e, 0x67, 0x68, 0xef, 0xc3,
-	0xac, 0x49, 0x74, 0x45, 0xa7, 0xaa, 0x50, 0xdb, 0x20, 0x91, 0xe8, 0x73, 0x98, 0x4b, 0x4a, 0xa9,
-	0xa2, 0x52, 0x35, 0x69, 0x36, 0xc1, 0x22, 0xd9, 0x06, 0x34, 0x65, 0xeb, 0x51, 0x14, 0xda, 0xd1,
-	0x12, 0x81, 0x87, 0x14, 0x66, 0x87, 0xae, 0x2f, 0x7a, 0xb6, 0x2f, 0x14, 0x81, 0x72, 0x78, 0x4b,
-	0x22, 0x8f, 0x7d, 0x21, 0x69, 0xe8, 0xcf, 0x75, 0x58, 0xaa, 0x2a, 0x1a, 0x55, 0x31, 0xda, 0x01,
-	0xe3, 0xf4, 0xe2, 0x4a, 0x61, 0x1a, 0xdc, 0x44, 0xa9, 0xc1, 0x4d, 0x96, 0x1b, 0xdc, 0x54, 0x65,
-	0x83, 0x9b, 0xce, 0x86, 0xef, 0xed, 0xc1, 0x28, 0x27, 0x4d, 0x59, 0xdb, 0x1b, 0x7a, 0xd2, 0x94,
-	0x55, 0xdd, 0x64, 0x5e, 0x33, 0xad, 0x89, 0xf9, 0x36, 0x09, 0xb7, 0xb5, 0xc9, 0x56, 0xa1, 0x4d,
-	0x56, 0x95, 0xc6, 0x76, 0x65, 0x69, 0xc4, 0x3e, 0x24, 0x98, 0x18, 0xc5, 0x18, 0xbf, 0x53, 0x96,
-	0x86, 0xe8, 0x23, 0x58, 0x7c, 0xc5, 0xaf, 0xf4, 0x8c, 0x66, 0x4a, 0xc9, 0x36, 0x40, 0xc8, 0xe2,
-	0x38, 0xbc, 0x88, 0x64, 0x62, 0xd6, 0x4c, 0x92, 0x1b, 0x0c, 0x3d, 0x00, 0x92, 0xbd, 0x94, 0xce,
-	0x74, 0xd5, 0x03, 0x22, 0xf5, 0x60, 0xf9, 0x07, 0x5f, 0xd6, 0x96, 0x82, 0x9c, 0xf1, 0x23, 0x65,
-	0x5e, 0x83, 0x7a, 0x51, 0x03, 0x59, 0x38, 0x9c, 0x51, 0xc4, 0x92, 0xee, 0x38, 0x69, 0x25, 0x30,
-	0x3d, 0x84, 0x95, 0x82, 0xb4, 0xca, 0xa1, 0xb1, 0x91, 0x19, 0x1a, 0xc9, 0xcb, 0x4f, 0x50, 0x8e,
-	0x7e, 0x05, 0x4b, 0x2f, 0x3f, 0x81, 0xfd, 0x57, 0xb0, 0x76, 0xee, 0x0e, 0xfc, 0x31, 0xe1, 0x5b,
-	0x6a, 0x91, 0x7f, 0x85, 0xdd, 0x42, 0x8b, 0x3c, 0x4b, 0xbe, 0xdb, 0xe8, 0xf6, 0x2b, 0x68, 0x65,
-	0x1b, 0x4b, 0x0d, 0x0b, 0xce, 0x7a, 0x55, 0xe5, 0x50, 0xa3, 0x4b, 0x96, 0xfa, 0x2e, 0xdb, 0xd2,
-	0x43, 0x58, 0x38, 0xd1, 0xe1, 0x97, 0x28, 0x9a, 0x8b, 0xd1, 0x5a, 0x3e, 0x46, 0xe9, 0x3d, 0x68,
-	0xdd, 0xd5, 0xb0, 0x76, 0xa0, 0x75, 0xc2, 0xd2, 0xc1, 0x74, 0x01, 0x26, 0x06, 0xcc, 0xd8, 0x55,
-	0xfe, 0xa4, 0xdf, 0xc1, 0xdc, 0x0b, 0x55, 0x51, 0x0d, 0xcd, 0x67, 0x30, 0xad, 0x6a, 0x2c, 0x0e,
-	0xaf, 0xad, 0xa3, 0xb6, 0xfe, 0x3c, 0x24, 0xb3, 0xf4, 0x19, 0x7d, 0x08, 0x53, 0x88, 0xf8, 0x84,
-	0xc7, 0x8a, 0xc7, 0xb0, 0x7a, 0x7c, 0xc1, 0xfc, 0x01, 0x7f, 0xc5, 0xc5, 0x55, 0x10, 0xbd, 0x3f,
-	0x7d, 0x6e, 0x34, 0xdf, 0x02, 0xf0, 0x15, 0x2e, 0x5d, 0x1c, 0x9b, 0x1a, 0x73, 0xea, 0xd0, 0x87,
-	0xb0, 0x56, 0xba, 0x78, 0x87, 0xef, 0xbf, 0x01, 0x72, 0x2e, 0x58, 0x24, 0xd4, 0xe2, 0xf7, 0xb1,
-	0xf9, 0xb5, 0x07, 0x73, 0xe6, 0xc2, 0x1d, 0xfc, 0xbf, 0x80, 0xf6, 0x59, 0x18, 0x05, 0xef, 0x32,
-	0xe3, 0x88, 0xe7, 0xc6, 0x82, 0x27, 0x63, 0x9c, 0x82, 0xe8, 0x03, 0x98, 0xd5, 0x74, 0xb7, 0x33,
-	0x3c, 0xfa, 0xdb, 0x02, 0xc0, 0xd3, 0xd0, 0x3d, 0xe7, 0xd1, 0xa5, 0x2c, 0x3f, 0x6f, 0xa1, 0x95,
-	0xd9, 0xaf, 0x89, 0x19, 0xa8, 0x8b, 0x8f, 0x3d, 0x5d, 0xd3, 0xb5, 0x2a, 0x96, 0x71, 0xba, 0xfe,
-	0xd3, 0x7f, 0xfe, 0xf7, 0xf7, 0xfa, 0x12, 0x59, 0x3c, 0xbc, 0x7c, 0x78, 0x38, 0x8a, 0x79, 0x74,
-	0xe8, 0xf3, 0x3e, 0x76, 0x5e, 0xf2, 0x23, 0x34, 0xcc, 0x6b, 0xc3, 0x78, 0xde, 0xe9, 0x41, 0xfe,
-	0x5d, 0xa2, 0x8a, 0x71, 0xe0, 0x70, 0x57, 0x32, 0x7b, 0x0b, 0xcd, 0x64, 0xec, 0x49, 0x38, 0x17,
-	0x47, 0xa6, 0x6e, 0xa7, 0x7c, 0xa0, 0x59, 0x6f, 0x21, 0xeb, 0x35, 0x4a, 0x12, 0xd6, 0x7d, 0x49,
-	0xe3, 0x8c, 0x86, 0xe1, 0x93, 0xda, 0x3e, 0xf9, 0x23, 0xac, 0xbd, 0x94, 0x6b, 0xa0, 0x38, 0x8d,
-	0x22, 0x8e, 0xcb, 0x76, 0xdf, 0xe3, 0xc8, 0x65, 0xfc, 0x67, 0x2c, 0x67, 0x85, 0x25, 0x82, 0x96,
-	0x51, 0xd0, 0x1c, 0x69, 0x27, 0x82, 0x3c, 0xb7, 0x2f, 0xed, 0x62, 0xf6, 0xf6, 0xbb, 0xed, 0x52,
-	0xdc, 0xf0, 0x2b, 0xec, 0xc2, 0x0c, 0xb3, 0x08, 0xe6, 0x0b, 0x2b, 0x39, 0xd9, 0x4a, 0x5d, 0x57,
-	0xb1, 0xf6, 0x77, 0xb7, 0xc7, 0x1d, 0x6b, 0x61, 0xbb, 0x28, 0xac, 0x4b, 0x57, 0x4a, 0xc2, 0x24,
-	0x99, 0x34, 0xd6, 0x10, 0xe6, 0x0b, 0x05, 0x8d, 0x8c, 0x2f, 0x55, 0x89, 0xbc, 0x31, 0x6b, 0x02,
-	0xdd, 0x41, 0x79, 0xeb, 0x74, 0x39, 0x91, 0x97, 0xa9, 0x6d, 0x52, 0xdc, 0x19, 0x4c, 0x1e, 0x33,
-	0xcf, 0xbb, 0x4d, 0xc6, 0x52, 0xb2, 0x17, 0xa6, 0x4f, 0x0a, 0xb4, 0x83, 0x8c, 0x09, 0x9d, 0x4d,
-	0x18, 0xdb, 0xcc, 0xf3, 0x24, 0xc7, 0x0f, 0x40, 0xca, 0x5b, 0x0e, 0xd9, 0xcd, 0x28, 0x5a, 0xb9,
-	0x00, 0xdd, 0xf9, 0x29, 0x14, 0x25, 0x6e, 0xd2, 0xb5, 0x44, 0x62, 0xc4, 0xae, 0x0a, 0x5f, 0x73,
-	0x01, 0x73, 0xf9, 0x95, 0x86, 0x6c, 0xa6, 0x0e, 0x29, 0x6f, 0x3a, 0x63, 0xa2, 0xac, 0x2c, 0x69,
-	0x90, 0xbb, 0x2d, 0x25, 0xf9, 0xb0, 0x50, 0x5c, 0x72, 0xc8, 0x76, 0x59, 0x56, 0x76, 0xfb, 0x19,
-	0x23, 0xed, 0x33, 0x94, 0xb6, 0x4d, 0xd7, 0xab, 0xa4, 0xe1, 0x7d, 0x29, 0xef, 0xa7, 0x1a, 0x6e,
-	0x6b, 0x39, 0xc3, 0xd8, 0xdc, 0x0d, 0x05, 0xa1, 0xa9, 0xd4, 0x71, 0x5b, 0x51, 0xf7, 0x96, 0x31,
-	0x99, 0x7e, 0x89, 0xf2, 0xef, 0xd3, 0xed, 0xac, 0xfc, 0xb2, 0x1c, 0xa9, 0x44, 0x0f, 0x9a, 0xc9,
-	0xbb, 0x77, 0x92, 0x69, 0xc5, 0x57, 0xf3, 0xa4, 0x4e, 0x94, 0x9e, 0xc8, 0x2b, 0xea, 0x44, 0x6c,
-	0x68, 0x9e, 0xd4, 0xf6, 0xbf, 0xae, 0xe9, 0x02, 0x6a, 0xfa, 0xe9, 0xdd, 0xc9, 0x5c, 0xec, 0xbc,
-	0x74, 0x13, 0x25, 0xac, 0x92, 0xe5, 0xec, 0xc7, 0x24, 0xfc, 0xde, 0x42, 0xeb, 0x45, 0x2c, 0xdc,
-	0x21, 0x13, 0xfc, 0x84, 0xc5, 0xb7, 0xc5, 0x3c, 0x49, 0x05, 0xdc, 0x92, 0x4b, 0x3c, 0x65, 0x26,
-	0xcd, 0xf3, 0x1a, 0x40, 0x69, 0xff, 0x43, 0xcc, 0x1d, 0x62, 0x58, 0x64, 0xfd, 0x50, 0xc5, 0x76,
-	0x1b, 0xd9, 0x76, 0xe8, 0x52, 0x41, 0x65, 0xc9, 0x44, 0x72, 0x65, 0x58, 0x84, 0x54, 0xbb, 0xd7,
-	0x41, 0x5d, 0xc5, 0x7a, 0x25, 0xdb, 0xf0, 0x53, 0xee, 0xf7, 0x91, 0xfb, 0x16, 0xed, 0x64, 0xb9,
-	0x67, 0x99, 0x29, 0x11, 0x90, 0x3e, 0xcf, 0x91, 0x0d, 0x13, 0xa6, 0x15, 0x4f, 0x83, 0xdd, 0xf5,
-	0x34, 0xda, 0x0a, 0xcf, 0x79, 0x74, 0x03, 0x45, 0xad, 0xd0, 0x85, 0x44, 0x94, 0x5e, 0x9c, 0xa4,
-	0x88, 0x3f, 0xc1, 0x6c, 0xee, 0xe9, 0xf0, 0x76, 0x29, 0x99, 0xac, 0x2d, 0xbf, 0x36, 0x56, 0x58,
-	0x2c, 0x7d, 0x6a, 0x54, 0x15, 0x68, 0xb1, 0xf4, 0xca, 0x47, 0x76, 0x32, 0x8a, 0x57, 0xbd, 0x28,
-	0x76, 0x77, 0xc7, 0x13, 0x8c, 0xad, 0x0b, 0x4e, 0x8e, 0xf0, 0x49, 0x6d, 0xff, 0xe8, 0xe7, 0x06,
-	0xb4, 0x9f, 0x3a, 0x43, 0xd7, 0x37, 0x33, 0x81, 0x0d, 0x90, 0x4e, 0xff, 0xc4, 0xe4, 0x46, 0x69,
-	0x8b, 0x48, 0x0c, 0x5b, 0x5e, 0x15, 0xf2, 0x4d, 0x83, 0x49, 0xe6, 0xa6, 0x6b, 0x1c, 0xfa, 0xfc,
-	0x4a, 0x7e, 0x71, 0x00, 0xb3, 0xb9, 0x21, 0x3e, 0xb1, 0x6e, 0xd5, 0x22, 0x91, 0x58, 0xb7, 0x72,
-	0xee, 0xcf, 0x47, 0x4c, 0x5e, 0xda, 0x08, 0x2f, 0x48, 0x81, 0x03, 0x68, 0x65, 0x86, 0xfa, 0x24,
-	0x93, 0xca, 0x8b, 0x41, 0x52, 0x7a, 0x2a, 0x76, 0x00, 0x7a, 0x0f, 0x45, 0x6d, 0xd0, 0xd5, 0xb2,
-	0xa8, 0x54, 0xd0, 0x7c, 0x61, 0x1d, 0xf8, 0xa8, 0x76, 0x58, 0xbd, 0x41, 0x98, 0x5e, 0x4f, 0xe7,
-	0x52, 0x81, 0xb1, 0x3b, 0xc0, 0xd6, 0xf1, 0x8f, 0x1a, 0x6c, 0x15, 0x5a, 0xcf, 0x8f, 0xae, 0xb8,
-	0x48, 0xb7, 0x09, 0xf2, 0xa0, 0xba, 0x41, 0x95, 0xf6, 0x8d, 0x3b, 0x3b, 0xd9, 0x01, 0x6a, 0xb1,
-	0x47, 0xef, 0xa7, 0x5a, 0x88, 0x71, 0x52, 0xa5, 0x6a, 0x57, 0x38, 0x16, 0x17, 0xfe, 0x6f, 0x1a,
-	0x5f, 0x1c, 0xef, 0x19, 0xf1, 0x63, 0xff, 0xa3, 0xa2, 0x9f, 0xa3, 0x06, 0x3b, 0x64, 0x2b, 0x63,
-	0x87, 0x84, 0x1a, 0xe7, 0x42, 0x14, 0x71, 0x09, 0xf3, 0x85, 0x11, 0x3e, 0x99, 0x7f, 0xaa, 0x77,
-	0x82, 0xe4, 0xd3, 0xc7, 0x4c, 0xfe, 0xf9, 0x66, 0xa7, 0x04, 0xdb, 0x79, 0x52, 0x55, 0x8f, 0x5a,
-	0x99, 0x3d, 0x20, 0x71, 0x78, 0x79, 0x37, 0x48, 0xaa, 0x5e, 0x7e, 0x01, 0xa8, 0xca, 0x98, 0x38,
-	0xbd, 0x2c, 0x45, 0xfc, 0x1e, 0xe0, 0x5c, 0x04, 0xa1, 0x96, 0x30, 0xd6, 0x96, 0x63, 0xf8, 0xe7,
-	0xda, 0x8c, 0xe1, 0x9f, 0x70, 0x3b, 0x97, 0xbc, 0x59, 0x24, 0x70, 0x87, 0x20, 0x66, 0x7c, 0xca,
-	0x6e, 0x1e, 0xc9, 0x28, 0x90, 0x5b, 0x33, 0x68, 0x17, 0xd9, 0x2e, 0xd3, 0xf9, 0x94, 0x6d, 0x28,
-	0x09, 0x9e, 0xd4, 0xf6, 0xfb, 0xd3, 0xf8, 0x5f, 0xd7, 0xa3, 0x5f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x2c, 0xf1, 0x21, 0xde, 0xd8, 0x1e, 0x00, 0x00,
+	// 4511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4d, 0x73, 0x1c, 0x49,
+	0x56, 0xd1, 0xdd, 0x6a, 0xa9, 0xfb, 0xb5, 0x3e, 0x4b, 0xb2, 0xd4, 0x6a, 0x5b, 0xb6, 0x26, 0x3d,
+	0x1f, 0x1a, 0xef, 0x8c, 0x35, 0xa3, 0xd9, 0x9d, 0x85, 0x61, 0x97, 0x88, 0x19, 0xd9, 0x68, 0xbc,
+	0xe1, 0x19, 0x1c, 0x25, 0xdb, 0x43, 0x10, 0xcc, 0xf6, 0x96, 0xaa, 0x52, 0xad, 0x1a, 0x57, 0x57,
+	0x35, 0x55, 0xd9, 0x92, 0xe5, 0x25, 0x96, 0x60, 0x0f, 0x7b, 0x82, 0x13, 0x11, 0x04, 0x07, 0xf6,
+	0x40, 0x00, 0x07, 0xf8, 0x0b, 0x9c, 0xb8, 0x72, 0xe5, 0x4e, 0x70, 0xe0, 0xc2, 0x81, 0xff, 0x40,
+	0xbc, 0x97, 0x1f, 0x95, 0x55, 0x5d, 0x25, 0xd9, 0x1b, 0x44, 0xec, 0xad, 0xdf, 0xcb, 0x97, 0xef,
+	0x65, 0xe6, 0xfb, 0xcc, 0x57, 0xd9, 0xd0, 0x4d, 0x27, 0xfe, 0xfd, 0x49, 0x9a, 0x88, 0xc4, 0x69,
+	0xa7, 0x13, 0x7f, 0x72, 0x32, 0xb8, 0x35, 0x4a, 0x92, 0x51, 0xc4, 0xf7, 0xbd, 0x49, 0xb8, 0xef,
+	0xc5, 0x71, 0x22, 0x3c, 0x11, 0x26, 0x71, 0x26, 0x89, 0xd8, 0x3d, 0x58, 0x3d, 0x9e, 0x9e, 0x64,
+	0x7e, 0x1a, 0x9e, 0x70, 0x97, 0xff, 0xe9, 0x94, 0x67, 0xc2, 0xd9, 0x84, 0x79, 0x91, 0x4c, 0x42,
+	0x3f, 0xeb, 0x37, 0x76, 0x5b, 0x7b, 0x5d, 0x57, 0x41, 0xec, 0xc7, 0xb0, 0x66, 0xd1, 0x66, 0x93,
+	0x24, 0xce, 0xb8, 0xb3, 0x01, 0x6d, 0x1a, 0xee, 0x37, 0x76, 0x1b, 0x7b, 0x5d, 0x57, 0x02, 0x8e,
+	0x03, 0x73, 0x81, 0x27, 0xbc, 0x7e, 0x93, 0x90, 0xf4, 0x9b, 0x39, 0xb0, 0xfa, 0x75, 0x12, 0x3f,
+	0xf1, 0x52, 0x6f, 0x9c, 0x29, 0x51, 0xec, 0x9f, 0x5b, 0x88, 0x0c, 0xf8, 0xa3, 0xf8, 0x34, 0x31,
+	0x2c, 0x97, 0xa1, 0x19, 0x06, 0x8a, 0x5f, 0x33, 0x0c, 0x9c, 0x6d, 0xe8, 0xf8, 0x67, 0x5e, 0x18,
+	0x0f, 0xc3, 0x80, 0x18, 0x2e, 0xb9, 0x0b, 0x04, 0x3f, 0x0a, 0x9c, 0x3e, 0x2c, 0x9c, 0xf3, 0x34,
+	0x0b, 0x93, 0xb8, 0xdf, 0x92, 0x23, 0x0a, 0x74, 0x76, 0x00, 0x26, 0x9c, 0xa7, 0x43, 0x3f, 0x99,
+	0xc6, 0xa2, 0x3f, 0x47, 0x83, 0x5d, 0xc4, 0x1c, 0x22, 0xc2, 0x61, 0xb0, 0x98, 0x5d, 0xc6, 0xfe,
+	0x59, 0x9a, 0xc4, 0xe1, 0x2b, 0x1e, 0xf4, 0xdb, 0xbb, 0x8d, 0xbd, 0x8e, 0x5b, 0xc0, 0x39, 0x77,
+	0xa0, 0x77, 0x32, 0xf5, 0x5f, 0x70, 0x31, 0xcc, 0xc2, 0x57, 0xbc, 0x3f, 0xbf, 0xdb, 0xd8, 0x6b,
+	0xbb, 0x20, 0x51, 0xc7, 0xe1, 0x2b, 0xee, 0xec, 0xc1, 0x6a, 0xca, 0x23, 0xef, 0x72, 0xe8, 0x7b,
+	0xfe, 0x19, 0x97, 0x54, 0x0b, 0x44, 0xb5, 0x4c, 0xf8, 0x43, 0x44, 0x13, 0xe5, 0x3d, 0x58, 0xcb,
+	0x44, 0xca, 0xbd, 0xf1, 0x30, 0x13, 0x49, 0xaa, 0x48, 0x3b, 0x44, 0xba, 0x22, 0x07, 0x8e, 0x11,
+	0x4f, 0xb4, 0x3f, 0x84, 0x7e, 0x81, 0x96, 0xbf, 0x14, 0x3c, 0x0e, 0xe4, 0x94, 0x2e, 0x4d, 0xb9,
+	0x61, 0x4d, 0x79, 0x48, 0xa3, 0x34, 0xf1, 0x7d, 0x58, 0x25, 0xa5, 0xfa, 0x49, 0x34, 0xd4, 0xa7,
+	0x02, 0x74, 0x8a, 0x2b, 0x1a, 0xff, 0x5c, 0x9d, 0xce, 0x01, 0xf4, 0xd2, 0x64, 0x2a, 0xf8, 0x50,
+	0x78, 0x27, 0x11, 0xef, 0xf7, 0x76, 0x5b, 0x7b, 0xbd, 0x83, 0xb5, 0xfb, 0x64, 0x31, 0xf7, 0x5d,
+	0x1c, 0x79, 0x8a, 0x03, 0x2e, 0xa4, 0xe6, 0x37, 0xfb, 0x05, 0x0c, 0x8e, 0xd1, 0x78, 0x32, 0x11,
+	0xfa, 0xd9, 0x8c, 0xd2, 0x36, 0x61, 0x9e, 0x70, 0x0f, 0x94, 0xe2, 0x14, 0x84, 0xf8, 0x2f, 0x79,
+	0x38, 0x3a, 0x13, 0xa4, 0xba, 0x39, 0x57, 0x41, 0x68, 0x21, 0x5f, 0x7a, 0xd9, 0x19, 0xa9, 0xad,
+	0xeb, 0xd2, 0x6f, 0xe7, 0x16, 0x74, 0x9f, 0x68, 0x0d, 0x69, 0x95, 0x19, 0x04, 0x8b, 0x60, 0xf3,
+	0x10, 0x45, 0xc5, 0xd9, 0x34, 0xc3, 0x85, 0x14, 0x6c, 0x30, 0x43, 0x84, 0xb6, 0x41, 0x02, 0x9c,
+	0x1f, 0x41, 0x4f, 0xa4, 0x5e, 0x9c, 0x85, 0x64, 0xef, 0xfd, 0x26, 0xed, 0x71, 0xa0, 0xf6, 0x68,
+	0x38, 0x3d, 0x35, 0x24, 0xae, 0x4d, 0xce, 0xc6, 0xb0, 0x5e, 0x41, 0x83, 0xcb, 0x3e, 0x4d, 0x93,
+	0xb1, 0x92, 0x44, 0xbf, 0xd1, 0x5e, 0x45, 0xa2, 0x4c, 0xbd, 0x29, 0x12, 0x5c, 0x0e, 0x3f, 0xe7,
+	0xb1, 0x50, 0x7b, 0x93, 0x00, 0x6e, 0x4e, 0x84, 0x63, 0x9e, 0x09, 0x6f, 0x3c, 0xa1, 0xcd, 0xb5,
+	0xdc, 0x1c, 0xc1, 0xfe, 0xad, 0x01, 0xab, 0x7f, 0x90, 0xa4, 0x2f, 0x9e, 0xa6, 0x3c, 0xdf, 0x97,
+	0x03, 0x73, 0xe9, 0x34, 0xd2, 0xdb, 0xa2, 0xdf, 0x0e, 0x83, 0x56, 0x14, 0x9e, 0x90, 0xb4, 0xde,
+	0xc1, 0xaa, 0xda, 0x0d, 0xce, 0xfc, 0x22, 0x4a, 0xfc, 0x17, 0x2e, 0x0e, 0x3a, 0x6f, 0xc3, 0x9c,
+	0xf0, 0xc2, 0xa8, 0xdf, 0xaa, 0x21, 0xa2, 0x51, 0x67, 0x0f, 0xe6, 0x4f, 0x10, 0xcc, 0xfa, 0x73,
+	0xbb, 0xad, 0x4a, 0x3a, 0x35, 0xee, 0xbc, 0x03, 0x6d, 0x9c, 0x91, 0xf5, 0xdb, 0x44, 0xb8, 0x62,
+	0x11, 0x3e, 0xf5, 0xc2, 0xc8, 0x95, 0xa3, 0xec, 0x5f, 0x1a, 0xd0, 0x35, 0x93, 0x71, 0xf1, 0x67,
+	0xa8, 0x60, 0xb5, 0x78, 0xfc, 0x8d, 0x1e, 0x35, 0xf1, 0x52, 0x1e, 0x8b, 0x21, 0x0d, 0xc9, 0x23,
+	0x03, 0x89, 0x22, 0x0b, 0xd8, 0x84, 0xf9, 0x33, 0x69, 0x2d, 0x2d, 0x69, 0x2d, 0x12, 0xc2, 0x23,
+	0x1d, 0x87, 0x31, 0x4f, 0xe9, 0xe0, 0xba, 0xae, 0x04, 0x8a, 0x47, 0xda, 0x2e, 0x1d, 0x29, 0x8e,
+	0xfa, 0x5e, 0x9c, 0xc4, 0xa1, 0xef, 0x45, 0xe4, 0xbc, 0x1d, 0x37, 0x47, 0xb0, 0x9f, 0x41, 0x47,
+	0xaf, 0xdf, 0x79, 0x17, 0xda, 0xb4, 0x53, 0x5a, 0x6b, 0xd5, 0x41, 0xc8, 0x61, 0xe7, 0x03, 0xe8,
+	0x78, 0xb1, 0xcf, 0xd1, 0x2d, 0x6b, 0x15, 0x60, 0x28, 0xd8, 0xa7, 0x00, 0xb9, 0x27, 0xcd, 0x04,
+	0xb5, 0x3e, 0x2c, 0x78, 0x41, 0x90, 0xf2, 0x4c, 0x5a, 0x66, 0xd7, 0xd5, 0x20, 0xfb, 0x75, 0x13,
+	0xd6, 0x8f, 0xb8, 0xf8, 0x9a, 0x9f, 0x14, 0xad, 0xdc, 0x0e, 0x83, 0x8d, 0x62, 0x18, 0x74, 0x94,
+	0xc2, 0x55, 0xb8, 0xc5, 0xdf, 0xb5, 0x47, 0x39, 0x80, 0x8e, 0x9f, 0x84, 0xf1, 0x89, 0x97, 0x71,
+	0x75, 0x9a, 0x06, 0x2e, 0x05, 0xcd, 0x76, 0x39, 0x68, 0xde, 0x84, 0x6e, 0x98, 0x0d, 0xc7, 0x61,
+	0x1c, 0xc6, 0x23, 0x75, 0xa2, 0x9d, 0x30, 0xfb, 0x8a, 0xe0, 0xca, 0xe8, 0xb3, 0x50, 0x1d, 0x7d,
+	0xca, 0xc1, 0xb7, 0x53, 0x11, 0x7c, 0xad, 0xc8, 0xde, 0x25, 0x2e, 0x1a, 0x64, 0x1f, 0xc1, 0xea,
+	0xe7, 0x3e, 0xad, 0x30, 0x33, 0x67, 0x73, 0x0b, 0xba, 0xea, 0xf8, 0xb8, 0xce, 0x5a, 0x39, 0x82,
+	0xfd, 0x04, 0x36, 0x8f, 0xb8, 0x50, 0x93, 0xd4, 0xa1, 0xca, 0x54, 0x67, 0x69, 0x41, 0xaa, 0x46,
+	0x83, 0xd6, 0xf1, 0x35, 0xed, 0xe3, 0x63, 0x8f, 0x60, 0x6b, 0x86, 0x97, 0x5a, 0x44, 0x1f, 0x16,
+	0x4e, 0xbc, 0x08, 0xf5, 0xaf, 0x99, 0x29, 0x10, 0xcd, 0x37, 0x4e, 0x10, 0x2f, 0x15, 0x24, 0x01,
+	0xf6, 0x53, 0xe8, 0xdb, 0xac, 0x92, 0xd4, 0x1b, 0xbd, 0xc6, 0xc2, 0x56, 0xa1, 0xf5, 0x82, 0x5f,
+	0x2a, 0x4e, 0xf8, 0xb3, 0x4e, 0xd3, 0xec, 0x63, 0xd8, 0xae, 0xe0, 0x9f, 0xc7, 0xcc, 0x73, 0x2f,
+	0x9a, 0x9a, 0x98, 0x49, 0x00, 0x7b, 0x17, 0x16, 0x0f, 0xbd, 0x28, 0xb2, 0xa3, 0x7a, 0xca, 0xb3,
+	0x69, 0x24, 0x74, 0x54, 0x97, 0x10, 0xbb, 0x0f, 0x1b, 0x5f, 0x5c, 0x92, 0xc1, 0xcb, 0x70, 0x6e,
+	0x95, 0x0e, 0x6a, 0x29, 0x8d, 0xc2, 0x52, 0x7c, 0xb8, 0x71, 0xc4, 0xc5, 0xa1, 0x17, 0x07, 0x61,
+	0xe0, 0x09, 0x9e, 0x2b, 0xee, 0x36, 0x80, 0x6f, 0xb0, 0x4a, 0x73, 0x16, 0xc6, 0xb9, 0x07, 0xed,
+	0x30, 0x3e, 0x4d, 0x74, 0xf8, 0xde, 0xd0, 0xe1, 0x5b, 0x53, 0x50, 0x0e, 0x92, 0x24, 0xec, 0x9f,
+	0x1a, 0xb0, 0x54, 0x18, 0xb8, 0xe2, 0x14, 0x1d, 0x98, 0x8b, 0xbd, 0xb1, 0x56, 0x08, 0xfd, 0xc6,
+	0x93, 0x9d, 0xa6, 0x91, 0x8a, 0xda, 0xf8, 0x13, 0x7d, 0x85, 0xc7, 0xc1, 0x24, 0x09, 0x55, 0x3e,
+	0xea, 0xba, 0x06, 0xa6, 0x95, 0x27, 0xe3, 0x71, 0x98, 0x91, 0x8d, 0x4a, 0x5f, 0xb1, 0x30, 0x28,
+	0x3b, 0xe0, 0x93, 0x24, 0x0b, 0x05, 0xb9, 0x4a, 0xd7, 0xd5, 0x20, 0xfb, 0x3e, 0x38, 0x47, 0x5c,
+	0x3c, 0xb8, 0x8c, 0xbd, 0x4c, 0x5c, 0xda, 0x27, 0x11, 0xf0, 0x88, 0x8f, 0x3c, 0xc1, 0xf3, 0x93,
+	0xc8, 0x31, 0xec, 0xe7, 0x70, 0xf3, 0x88, 0x8b, 0x27, 0x69, 0x32, 0x49, 0x32, 0x9e, 0x1e, 0xfb,
+	0x67, 0x3c, 0x98, 0x46, 0xb9, 0x3e, 0x3f, 0x82, 0x05, 0x7f, 0x9a, 0x62, 0x20, 0x55, 0x51, 0x6c,
+	0x53, 0x1d, 0x95, 0x92, 0x63, 0x26, 0x68, 0x32, 0xe7, 0x1e, 0xcc, 0xc5, 0xfc, 0xa5, 0xe8, 0x37,
+	0xaf, 0x24, 0x27, 0x1a, 0xf6, 0x0f, 0x0d, 0x58, 0x29, 0x8d, 0xa0, 0xae, 0x33, 0x9e, 0x86, 0x5e,
+	0x44, 0x02, 0x5b, 0xae, 0x82, 0x54, 0x36, 0x4e, 0x25, 0xe3, 0x96, 0x2b, 0x01, 0x3c, 0x5c, 0x1e,
+	0x07, 0x74, 0xb8, 0x2d, 0x17, 0x7f, 0x3a, 0xef, 0x43, 0x3b, 0x8b, 0x12, 0xa1, 0xd3, 0xcf, 0xba,
+	0x5a, 0x80, 0xd9, 0x61, 0x94, 0x08, 0x57, 0x52, 0x60, 0x02, 0xca, 0x84, 0x27, 0xca, 0x09, 0xe8,
+	0xab, 0x50, 0xba, 0xa0, 0x2b, 0x47, 0xd9, 0x97, 0xb0, 0x68, 0xcf, 0x2e, 0xe6, 0x87, 0x46, 0x39,
+	0x3f, 0x0c, 0xa0, 0x33, 0x51, 0xd4, 0xca, 0x0c, 0x0c, 0xcc, 0x04, 0x74, 0x34, 0x73, 0xe4, 0x72,
+	0xee, 0x45, 0x68, 0x54, 0x49, 0xaa, 0xcc, 0x28, 0x47, 0x90, 0x89, 0xbc, 0x9c, 0x70, 0x5f, 0xf0,
+	0x40, 0x6d, 0xd8, 0xc0, 0x78, 0x42, 0xe3, 0x30, 0x16, 0x5c, 0x6f, 0x5b, 0x41, 0x12, 0x9f, 0x65,
+	0x3c, 0x50, 0x75, 0x80, 0x82, 0xd8, 0x13, 0x0a, 0x08, 0x0f, 0x94, 0xce, 0x9f, 0x27, 0x82, 0xa7,
+	0xba, 0x52, 0xc6, 0x55, 0x18, 0x63, 0xd0, 0xab, 0x30, 0x88, 0xda, 0x68, 0xf5, 0x09, 0x6c, 0x57,
+	0x70, 0xcc, 0x9d, 0xfb, 0x9c, 0x30, 0xba, 0xce, 0x97, 0x10, 0xfb, 0x8a, 0x26, 0x1d, 0x46, 0x5e,
+	0x38, 0xa6, 0x22, 0x90, 0x5f, 0x78, 0x69, 0xf0, 0x9b, 0x47, 0xcc, 0xa7, 0x30, 0xa8, 0x62, 0x67,
+	0x47, 0x18, 0xc4, 0xe4, 0x11, 0x06, 0xa1, 0x92, 0x7b, 0x35, 0xcb, 0xee, 0xc5, 0xfe, 0x90, 0xdc,
+	0xe1, 0xb9, 0xd6, 0xc3, 0xe3, 0xf0, 0x9c, 0xc7, 0x3c, 0xcb, 0x7e, 0xf3, 0x65, 0xfe, 0x45, 0x03,
+	0x6e, 0x55, 0x73, 0x54, 0x2b, 0xdd, 0x87, 0x85, 0x94, 0xfb, 0x49, 0x1a, 0xc8, 0xf3, 0xea, 0x1d,
+	0xdc, 0x50, 0x66, 0x98, 0x53, 0xe2, 0xa8, 0xab, 0xa9, 0x50, 0xd2, 0x77, 0x5e, 0x18, 0x29, 0xc3,
+	0xe8, 0xb8, 0x0a, 0xc2, 0xb5, 0xa5, 0x3c, 0xe2, 0x98, 0x80, 0xa5, 0x5d, 0x68, 0x90, 0x9d, 0xc0,
+	0x72, 0x91, 0x19, 0xd2, 0x06, 0xd2, 0xef, 0x94, 0x01, 0x6b, 0xf0, 0x4a, 0xc3, 0x93, 0xa6, 0x1d,
+	0x4c, 0x7d, 0x63, 0x7a, 0x06, 0x66, 0xcf, 0xe8, 0xe0, 0x7e, 0x42, 0x4b, 0x31, 0x9b, 0xcd, 0x77,
+	0xf9, 0x29, 0x80, 0x31, 0x6e, 0xbd, 0x51, 0x1d, 0x1b, 0x4a, 0x93, 0x5c, 0x8b, 0x92, 0x3d, 0x84,
+	0x95, 0xd2, 0xf0, 0x15, 0x3a, 0xb0, 0x4e, 0xa0, 0x59, 0x3c, 0x81, 0x1f, 0x51, 0x6c, 0x94, 0x5e,
+	0xec, 0x45, 0x5a, 0x9b, 0xe5, 0xe2, 0xa9, 0x4e, 0x87, 0x7f, 0x25, 0x4b, 0xa7, 0x7c, 0x7a, 0xcd,
+	0x8d, 0xf2, 0x0a, 0xd7, 0xc7, 0xf0, 0x35, 0xc1, 0x3b, 0xaa, 0xae, 0xde, 0x09, 0xc8, 0xd3, 0xe5,
+	0x9c, 0x95, 0x2e, 0xb1, 0x5e, 0xc2, 0xab, 0x99, 0x5a, 0x4b, 0x9b, 0xd6, 0xd2, 0xe5, 0x71, 0xa0,
+	0xee, 0x38, 0xdf, 0x83, 0x35, 0xcf, 0x17, 0xe1, 0x39, 0xdd, 0xb8, 0x35, 0xd5, 0x3c, 0x51, 0xad,
+	0xe6, 0x03, 0x8a, 0x18, 0xc3, 0xa9, 0xf0, 0xc4, 0x34, 0x53, 0x55, 0x93, 0x82, 0x30, 0x70, 0x5e,
+	0xf2, 0x8c, 0x6a, 0xa4, 0xae, 0x8b, 0x3f, 0x71, 0x37, 0x71, 0xa2, 0xaa, 0xa2, 0x66, 0x9c, 0xd8,
+	0x99, 0x06, 0x8a, 0x99, 0xe6, 0xef, 0x1a, 0xe4, 0xca, 0x47, 0xc9, 0x39, 0x4f, 0x63, 0x1e, 0xe8,
+	0xbb, 0xb7, 0x3a, 0x95, 0x07, 0x30, 0x4f, 0x9b, 0xd3, 0x6a, 0xfe, 0x40, 0xa9, 0xb9, 0x76, 0xc6,
+	0x7d, 0x09, 0x3e, 0x8c, 0x45, 0x7a, 0xe9, 0xaa, 0xb9, 0x83, 0xdf, 0x85, 0x9e, 0x85, 0xd6, 0xe5,
+	0x49, 0x23, 0x2f, 0x4f, 0xcc, 0xd1, 0x35, 0xad, 0xa3, 0xfb, 0xac, 0xf9, 0x3b, 0x0d, 0xf6, 0x88,
+	0xaa, 0x82, 0xaf, 0xf9, 0xc9, 0x34, 0xf2, 0x32, 0xd7, 0x8b, 0x5f, 0xbc, 0x96, 0xf7, 0x4e, 0x78,
+	0x1a, 0x26, 0x81, 0xd6, 0xbc, 0x84, 0xd8, 0xbf, 0x36, 0x61, 0xb3, 0xcc, 0x2b, 0x8f, 0x30, 0x6a,
+	0x4a, 0xc3, 0x9e, 0x82, 0xf8, 0x8b, 0x30, 0x0e, 0x92, 0x0b, 0xcd, 0x4a, 0x42, 0xce, 0x5b, 0xb0,
+	0x48, 0x29, 0x6b, 0x58, 0x28, 0xaa, 0x7a, 0x84, 0x53, 0xba, 0x2a, 0xea, 0x7d, 0xae, 0xac, 0xf7,
+	0x6d, 0xe8, 0x84, 0xf1, 0x50, 0x6e, 0xba, 0x2d, 0xd7, 0x1f, 0xc6, 0xcf, 0x11, 0xc4, 0x12, 0x3a,
+	0x99, 0x0a, 0x35, 0x26, 0xeb, 0x82, 0x4e, 0x32, 0x15, 0x66, 0x30, 0x8c, 0x87, 0x01, 0x1f, 0xa5,
+	0x5c, 0x36, 0x12, 0x96, 0xdc, 0x4e, 0x18, 0x3f, 0x20, 0x18, 0x65, 0xe2, 0x4c, 0x35, 0xda, 0xa1,
+	0x51, 0xe4, 0xa5, 0x86, 0xdf, 0x81, 0xe5, 0x31, 0x0f, 0x42, 0x2f, 0x1e, 0xea, 0x1a, 0x54, 0x1a,
+	0xc8, 0x92, 0xc4, 0x7e, 0x91, 0x57, 0xa2, 0x99, 0x9f, 0xa4, 0x5c, 0x59, 0x8a, 0x04, 0xd8, 0x11,
+	0xb9, 0xcd, 0x83, 0x70, 0xf2, 0x06, 0xb1, 0xbe, 0x52, 0x0d, 0xbf, 0x6a, 0xc2, 0x46, 0x91, 0xd3,
+	0x6f, 0x4d, 0x09, 0x7d, 0x58, 0x98, 0xf0, 0x38, 0xc0, 0xab, 0x8a, 0x6c, 0xee, 0x68, 0x50, 0xb6,
+	0xac, 0x84, 0xba, 0x14, 0x52, 0xcb, 0x4a, 0x78, 0x11, 0x5d, 0x17, 0x93, 0x58, 0xa4, 0x9e, 0x2f,
+	0xd0, 0x05, 0xe9, 0x0a, 0x61, 0x10, 0xb4, 0x4e, 0x29, 0x48, 0x3a, 0xa2, 0x82, 0xac, 0xf4, 0xd5,
+	0xb5, 0xd3, 0x17, 0xfb, 0x98, 0xa2, 0xec, 0x21, 0xe6, 0x2b, 0x71, 0xc8, 0x53, 0x11, 0x9e, 0x86,
+	0xbe, 0x75, 0xef, 0xa8, 0xb8, 0x1c, 0xb3, 0x0b, 0xb8, 0x55, 0x3d, 0x25, 0x3f, 0xc2, 0xaa, 0xda,
+	0xda, 0xf0, 0x6a, 0xe6, 0xbc, 0x9c, 0xef, 0xc1, 0x02, 0xe5, 0x4a, 0x91, 0xf5, 0x5b, 0x85, 0xde,
+	0x8e, 0x64, 0x8f, 0x85, 0x80, 0xab, 0x29, 0x98, 0x0b, 0x90, 0xa3, 0xc9, 0x5d, 0xb1, 0x0e, 0x30,
+	0x17, 0x03, 0x04, 0xd0, 0xad, 0xbd, 0x68, 0xa4, 0xf2, 0x30, 0xfe, 0xc4, 0xf3, 0xca, 0xc2, 0x51,
+	0xec, 0x89, 0x69, 0xca, 0x55, 0xac, 0xcc, 0x11, 0xec, 0xbf, 0xe6, 0xc0, 0xa1, 0xb6, 0x09, 0xc6,
+	0xb9, 0x24, 0xb6, 0xf6, 0xfd, 0x3a, 0xed, 0x13, 0xe9, 0x1e, 0x2d, 0x3b, 0xd4, 0x9a, 0x2b, 0x94,
+	0x54, 0xb4, 0x04, 0xd0, 0x63, 0x46, 0x5e, 0x36, 0x9c, 0xa4, 0xa1, 0xaf, 0x5d, 0xad, 0x33, 0xf2,
+	0xb2, 0x27, 0x69, 0x98, 0x0f, 0x46, 0xe1, 0xd8, 0xd4, 0xe0, 0x38, 0xf8, 0x18, 0x61, 0xe7, 0x00,
+	0x3a, 0x5a, 0xbb, 0xe4, 0x6a, 0x79, 0x96, 0x3b, 0x54, 0x68, 0xb5, 0x66, 0xd7, 0xd0, 0x39, 0x3f,
+	0xa0, 0x8e, 0x82, 0xbc, 0x5f, 0x90, 0x1d, 0xf4, 0x0e, 0xb6, 0xca, 0x17, 0x12, 0x3d, 0x2b, 0xa7,
+	0x44, 0x51, 0xba, 0x52, 0xeb, 0x77, 0x0b, 0xa2, 0x74, 0x61, 0x66, 0x44, 0x69, 0x3a, 0x54, 0xf6,
+	0x49, 0x18, 0x7b, 0xe9, 0x25, 0x39, 0xea, 0xa2, 0xab, 0x20, 0x2c, 0x9a, 0x4f, 0x3c, 0xe1, 0x9f,
+	0xf5, 0x7b, 0xbb, 0x0d, 0xab, 0x68, 0xfe, 0x02, 0x71, 0x9a, 0x8b, 0xa4, 0x70, 0x3e, 0x00, 0x87,
+	0xf2, 0xf3, 0x70, 0x1a, 0x8b, 0x30, 0xd2, 0x7e, 0xb2, 0x28, 0xd3, 0x0f, 0x8d, 0x3c, 0xc3, 0x01,
+	0xe5, 0x2e, 0x07, 0x70, 0xc3, 0xa6, 0xce, 0xeb, 0xe6, 0x25, 0x4a, 0xd0, 0xeb, 0xf9, 0x84, 0xa7,
+	0x76, 0x05, 0xcd, 0xcf, 0xc3, 0x80, 0xa3, 0x5a, 0x96, 0xd5, 0xf5, 0x48, 0xc1, 0xa8, 0x2f, 0x1f,
+	0x4b, 0xbe, 0xfe, 0x0a, 0x39, 0x9f, 0x04, 0x70, 0x5b, 0xd3, 0x18, 0xcb, 0xa0, 0xfe, 0x2a, 0xa1,
+	0x15, 0x84, 0x47, 0x34, 0x51, 0x49, 0xbb, 0xbf, 0x56, 0x38, 0xa2, 0x52, 0x29, 0xe0, 0x1a, 0x3a,
+	0xf6, 0x0a, 0x56, 0x4a, 0xaa, 0x42, 0xf6, 0x59, 0x32, 0x4d, 0xcd, 0x05, 0x5c, 0x41, 0xd8, 0x77,
+	0x92, 0xbf, 0x86, 0xe2, 0x72, 0xa2, 0x13, 0x11, 0x48, 0xd4, 0xd3, 0xcb, 0x09, 0xc7, 0x9d, 0x9c,
+	0x4e, 0x63, 0x32, 0x55, 0x65, 0x76, 0x06, 0x46, 0x9b, 0xf5, 0xd2, 0x51, 0xa6, 0x32, 0x3f, 0xfd,
+	0x66, 0x7f, 0xd9, 0x80, 0xd5, 0xb2, 0xca, 0x51, 0xba, 0xb4, 0x76, 0x2d, 0x5d, 0x42, 0xd7, 0x95,
+	0xb2, 0xe6, 0x2e, 0xda, 0x9a, 0xbd, 0x8b, 0xce, 0x55, 0xdf, 0x45, 0xdb, 0xc5, 0xbb, 0x28, 0x5d,
+	0xcf, 0xca, 0x35, 0x53, 0xdd, 0x6a, 0x4c, 0x7d, 0xd3, 0xac, 0xac, 0x6f, 0x0a, 0x4e, 0x97, 0x87,
+	0x9c, 0xb9, 0x72, 0x0f, 0xc9, 0xa8, 0xab, 0x6d, 0xd7, 0x4f, 0x5e, 0x44, 0x49, 0x63, 0x32, 0x49,
+	0x93, 0x73, 0xae, 0x5a, 0x44, 0x1a, 0x64, 0xdf, 0xc2, 0x4a, 0xc9, 0xe0, 0x6b, 0x17, 0x59, 0xb8,
+	0xed, 0x34, 0xcb, 0xb7, 0x9d, 0xca, 0xc5, 0xb2, 0x1f, 0xc3, 0xa2, 0xed, 0x06, 0xce, 0x87, 0xd0,
+	0x0e, 0x05, 0x37, 0xd5, 0xcd, 0x96, 0xed, 0x2a, 0x8f, 0x04, 0x1f, 0x1b, 0x77, 0x21, 0x2a, 0x76,
+	0x06, 0xab, 0xe5, 0x21, 0x15, 0x9a, 0x1a, 0xb3, 0xa1, 0xc9, 0x2e, 0x65, 0xde, 0xd8, 0x78, 0xf6,
+	0x61, 0xfb, 0x98, 0xc7, 0x81, 0xeb, 0x5d, 0x54, 0x47, 0x48, 0xfa, 0x72, 0xd2, 0x20, 0xb7, 0xa7,
+	0xdf, 0xec, 0x4f, 0x60, 0x0b, 0x27, 0x14, 0xa8, 0xf3, 0xa4, 0x20, 0x5e, 0x5a, 0xa9, 0x44, 0x41,
+	0xd8, 0x8d, 0xd3, 0x61, 0x6b, 0x98, 0xf7, 0x19, 0xa9, 0x1b, 0xa7, 0xf1, 0x9f, 0x4b, 0x34, 0x7b,
+	0x4e, 0x55, 0x18, 0x35, 0x73, 0xbe, 0xb8, 0xc4, 0x2e, 0xec, 0x15, 0x49, 0x0a, 0xf9, 0x9e, 0x4e,
+	0xa3, 0x68, 0x28, 0xf2, 0xb5, 0xa8, 0xdb, 0xcd, 0x0a, 0xe2, 0xad, 0x25, 0xe2, 0xaa, 0x2d, 0xbe,
+	0xaf, 0xd3, 0x26, 0x7a, 0x13, 0xee, 0x32, 0xc1, 0x5a, 0x98, 0x6b, 0xd7, 0xce, 0xf6, 0x60, 0x95,
+	0x56, 0xf3, 0x60, 0x3a, 0x9e, 0x68, 0x3a, 0x0c, 0x53, 0xd4, 0xec, 0x6c, 0xd0, 0x97, 0x15, 0x09,
+	0xb0, 0xf7, 0x60, 0xcd, 0xa2, 0xcc, 0xbb, 0xf1, 0x46, 0x33, 0xfa, 0x9b, 0xd6, 0xbf, 0xb7, 0x60,
+	0x89, 0x28, 0x6d, 0xaa, 0xff, 0xd7, 0xb6, 0x77, 0x45, 0xd2, 0xb3, 0x3b, 0xb8, 0xed, 0x52, 0x07,
+	0xd7, 0x34, 0xca, 0xe7, 0x6b, 0x1b, 0xe5, 0x0b, 0xe5, 0x46, 0x88, 0xdd, 0x58, 0xee, 0x14, 0x1b,
+	0xcb, 0x3b, 0x00, 0xf4, 0x31, 0x65, 0x98, 0x26, 0x89, 0xe8, 0xf7, 0x54, 0x96, 0x47, 0x8c, 0x9b,
+	0x24, 0x54, 0xe8, 0x8a, 0x97, 0x99, 0x1c, 0x5c, 0x94, 0x15, 0xa2, 0x78, 0x99, 0xd1, 0xd0, 0x1d,
+	0xe8, 0xd1, 0x77, 0x0f, 0x35, 0xba, 0x24, 0xf7, 0x2c, 0x51, 0x44, 0xf0, 0x03, 0x58, 0x0c, 0x26,
+	0x49, 0x36, 0x44, 0x73, 0xc4, 0x36, 0xd4, 0x32, 0x85, 0x7d, 0x47, 0x67, 0xc6, 0x49, 0x92, 0x1d,
+	0xca, 0x11, 0xb7, 0x17, 0xe4, 0x00, 0x8a, 0xc4, 0xa4, 0x3e, 0xc5, 0xee, 0xc9, 0x8a, 0x14, 0x39,
+	0xf2, 0xb2, 0x67, 0x19, 0x0f, 0x9c, 0xdf, 0x87, 0x45, 0xcb, 0x70, 0xb2, 0x7e, 0x50, 0xf8, 0xe2,
+	0x53, 0xe1, 0x3d, 0x6e, 0x81, 0x9e, 0xfd, 0x4f, 0x03, 0x7a, 0x96, 0x5c, 0xac, 0x41, 0xd5, 0x65,
+	0x5b, 0xee, 0x41, 0xaa, 0xb4, 0xa7, 0x70, 0xb4, 0x89, 0x7b, 0xb0, 0x86, 0xfd, 0xb1, 0x61, 0x81,
+	0x4e, 0xf9, 0x19, 0x0e, 0x3c, 0xb0, 0x68, 0xef, 0xc2, 0x92, 0x0e, 0x61, 0x92, 0x4e, 0xc6, 0x8a,
+	0x45, 0x8d, 0x24, 0xa2, 0x77, 0x60, 0xd9, 0x14, 0x0e, 0x92, 0x4a, 0x46, 0x8e, 0x25, 0x83, 0x25,
+	0xb2, 0x9b, 0xd0, 0x3d, 0x4f, 0x34, 0x85, 0xb2, 0x81, 0xf3, 0x44, 0x0d, 0x32, 0x58, 0xc2, 0x46,
+	0xd3, 0xd0, 0x8f, 0x85, 0x24, 0x90, 0xb6, 0xd0, 0x43, 0xe4, 0x61, 0x2c, 0x90, 0x86, 0xfd, 0x6f,
+	0x0b, 0xd6, 0xab, 0xe2, 0x49, 0x95, 0xf9, 0xf6, 0x41, 0xdb, 0x43, 0xf9, 0xf3, 0xab, 0x2e, 0xe7,
+	0x5a, 0x33, 0xe5, 0xdc, 0xdc, 0x6c, 0xcc, 0x6c, 0x57, 0x96, 0x73, 0xf3, 0xb6, 0x65, 0x5f, 0x6d,
+	0xa7, 0xf8, 0x95, 0x03, 0xd3, 0xb7, 0xac, 0xc0, 0xe9, 0xb7, 0x71, 0xca, 0x6e, 0x1e, 0x2e, 0x8b,
+	0x45, 0x21, 0x5c, 0x55, 0x14, 0xf6, 0x4a, 0x45, 0x61, 0x55, 0xd4, 0x5c, 0xac, 0x8c, 0x9a, 0xd6,
+	0x75, 0x7d, 0x89, 0x22, 0x87, 0x82, 0x6a, 0xaa, 0xae, 0xe5, 0x37, 0xad, 0xba, 0x56, 0xea, 0xab,
+	0xae, 0x4f, 0xa8, 0x0f, 0xc5, 0xc3, 0x89, 0xa0, 0x22, 0xaa, 0x77, 0xb0, 0x5d, 0x65, 0xe1, 0x44,
+	0xe0, 0x6a, 0x4a, 0xf6, 0x9f, 0xcd, 0x52, 0x3d, 0x4e, 0x68, 0x6b, 0x17, 0x8d, 0xc2, 0x2e, 0x6c,
+	0x2f, 0x6b, 0x16, 0xbd, 0xec, 0x3e, 0xac, 0xfb, 0xd3, 0xf1, 0x34, 0xf2, 0x44, 0x78, 0xce, 0x87,
+	0x86, 0x4a, 0x9a, 0xc0, 0x5a, 0x3e, 0x74, 0xa4, 0xe8, 0xab, 0xce, 0x74, 0xae, 0xfa, 0x4c, 0xdf,
+	0x86, 0x79, 0x19, 0x20, 0x54, 0x9f, 0x77, 0x51, 0x6d, 0xec, 0x21, 0x22, 0x5d, 0x35, 0x46, 0x9f,
+	0x57, 0xd3, 0x34, 0x31, 0x21, 0x8e, 0x00, 0x8c, 0x54, 0xf4, 0x91, 0x4e, 0x86, 0x58, 0xd9, 0x42,
+	0xe9, 0x12, 0x86, 0x22, 0xec, 0x5d, 0x58, 0x52, 0x47, 0xa1, 0x02, 0x92, 0x34, 0xa2, 0x45, 0x8d,
+	0x24, 0xc7, 0xa9, 0x32, 0xa6, 0x77, 0xa1, 0x3d, 0x49, 0x93, 0xe4, 0xb4, 0x0f, 0x85, 0x8f, 0xa4,
+	0x4f, 0x10, 0x87, 0x9f, 0xb8, 0x5d, 0x39, 0xcc, 0x76, 0xa0, 0x6b, 0x70, 0x58, 0xbd, 0x9d, 0x53,
+	0x5f, 0xbc, 0xb5, 0xb7, 0xe8, 0xe2, 0x4f, 0xf6, 0x09, 0xac, 0x7d, 0xcd, 0x2f, 0xd4, 0xb7, 0x18,
+	0x9d, 0x7c, 0x6e, 0x03, 0x4c, 0xbc, 0x2c, 0x9b, 0x9c, 0xa5, 0x18, 0xca, 0x1b, 0x3a, 0x2d, 0x68,
+	0x0c, 0xbb, 0x0f, 0x8e, 0x3d, 0x29, 0xff, 0xcc, 0x54, 0x7d, 0x2b, 0x67, 0x11, 0x6c, 0x3c, 0x8b,
+	0x71, 0xcb, 0x25, 0x39, 0xb5, 0x33, 0x4a, 0x2b, 0x68, 0x96, 0x57, 0x80, 0xa9, 0x26, 0x98, 0xa6,
+	0x9e, 0x29, 0x6d, 0xe6, 0x5c, 0x03, 0xb3, 0x7d, 0xb8, 0x51, 0x92, 0x56, 0xf9, 0xd1, 0xa8, 0x63,
+	0x7d, 0x34, 0x72, 0x1e, 0xbf, 0xc1, 0xe2, 0xd8, 0x87, 0xb0, 0xfe, 0xf8, 0x0d, 0xd8, 0x7f, 0x08,
+	0x5b, 0xc7, 0xe1, 0x28, 0xae, 0x89, 0x6a, 0x33, 0x45, 0xd5, 0x9f, 0xc3, 0x6e, 0xa9, 0xa8, 0x7a,
+	0x62, 0xf6, 0xad, 0xd7, 0xf6, 0x7b, 0xea, 0x09, 0x81, 0x55, 0xa3, 0xd6, 0xb8, 0x1b, 0xd1, 0xbb,
+	0x36, 0xf5, 0x75, 0x67, 0xcb, 0xf6, 0x61, 0xf5, 0x48, 0x45, 0x25, 0xb3, 0xd0, 0x42, 0xe8, 0x6a,
+	0x14, 0x43, 0x17, 0xfb, 0x19, 0x0c, 0xf4, 0x84, 0xe3, 0xe9, 0x68, 0xc4, 0xb3, 0xf2, 0x1e, 0xb3,
+	0x28, 0xb9, 0xd0, 0x91, 0x1b, 0x7f, 0xa3, 0xfa, 0x32, 0xe1, 0xc5, 0x01, 0xf6, 0x27, 0x54, 0x9f,
+	0x53, 0xc3, 0x14, 0xbb, 0xbd, 0x4c, 0x98, 0xd8, 0xed, 0x65, 0x82, 0xbd, 0x05, 0xbd, 0xeb, 0x8a,
+	0xa8, 0x3b, 0xd0, 0x3b, 0xf2, 0xf2, 0x1e, 0xe2, 0x2a, 0xb4, 0x46, 0x9e, 0xd6, 0x1c, 0xfe, 0x64,
+	0x9f, 0xc2, 0xf2, 0x43, 0x99, 0xe5, 0x35, 0x4d, 0xee, 0xd6, 0x8d, 0x7a, 0xb7, 0x66, 0x1f, 0x43,
+	0x9b, 0x10, 0x6f, 0xf0, 0xa2, 0xe8, 0x6f, 0x1a, 0xb0, 0x7c, 0xc4, 0xc5, 0xe3, 0x64, 0x64, 0xfa,
+	0xfe, 0x77, 0xa0, 0x87, 0x59, 0x68, 0x58, 0x28, 0x2f, 0x01, 0x51, 0x2a, 0xe2, 0xde, 0x84, 0xae,
+	0x48, 0x86, 0x85, 0xee, 0x71, 0x47, 0x24, 0x79, 0xcf, 0x48, 0xdb, 0x62, 0x6b, 0xa6, 0xe1, 0xa5,
+	0xde, 0x44, 0xcd, 0xd9, 0x6f, 0xa2, 0x70, 0xb1, 0x32, 0x95, 0xc8, 0x0f, 0x80, 0x12, 0x60, 0x2e,
+	0xac, 0x98, 0x75, 0x99, 0xcf, 0x7b, 0x73, 0x51, 0x32, 0xd2, 0x47, 0x00, 0xfa, 0xd3, 0x41, 0x32,
+	0x72, 0x09, 0x8f, 0x0b, 0xa7, 0x4a, 0xa2, 0xb0, 0x32, 0x40, 0x94, 0x5c, 0x1b, 0xfb, 0xc7, 0x06,
+	0xb4, 0x1e, 0x27, 0x23, 0xac, 0x4a, 0x54, 0xa0, 0xb3, 0xb7, 0xd8, 0x3b, 0xc9, 0x3f, 0xc6, 0x96,
+	0x62, 0x61, 0xb3, 0x1c, 0x0b, 0xb7, 0x60, 0x41, 0xbc, 0x94, 0x63, 0x2d, 0x7d, 0x69, 0xf8, 0x52,
+	0x25, 0xfa, 0x62, 0x84, 0x36, 0xdb, 0x37, 0x3a, 0x69, 0x57, 0xe9, 0x64, 0xde, 0xd2, 0xc9, 0x0f,
+	0x61, 0xf3, 0xf0, 0xcc, 0x8b, 0x47, 0xfc, 0x6b, 0x2e, 0x2e, 0x92, 0xf4, 0xc5, 0xa3, 0x07, 0x5a,
+	0x35, 0x3b, 0x00, 0xb1, 0xc4, 0xe5, 0x2f, 0x18, 0xba, 0x0a, 0xf3, 0x08, 0x3b, 0x66, 0x5b, 0x33,
+	0x13, 0xaf, 0xf1, 0xf8, 0xef, 0x83, 0x73, 0x2c, 0xbc, 0x54, 0xc8, 0x17, 0x08, 0xaf, 0x1b, 0x55,
+	0xf7, 0x60, 0x59, 0x4f, 0xb8, 0x86, 0xff, 0xbb, 0xb0, 0xf8, 0x64, 0x92, 0x26, 0xa7, 0xd6, 0xb5,
+	0x25, 0x0a, 0x33, 0xc1, 0xcd, 0x6d, 0x55, 0x42, 0xec, 0x3d, 0x58, 0x52, 0x74, 0x57, 0x33, 0x3c,
+	0xf8, 0xfb, 0x9b, 0x00, 0x9f, 0x4f, 0xc2, 0x63, 0x9e, 0x9e, 0x63, 0x2d, 0xf2, 0x2d, 0xf4, 0xac,
+	0x87, 0x1e, 0x8e, 0xbe, 0xa1, 0x96, 0x5f, 0xc9, 0x0d, 0x06, 0x79, 0x63, 0xbe, 0xfc, 0x2a, 0x84,
+	0x6d, 0xff, 0xf2, 0x3f, 0xfe, 0xfb, 0xaf, 0x9b, 0xeb, 0xce, 0xda, 0xfe, 0xf9, 0xc7, 0xfb, 0xd3,
+	0x8c, 0xa7, 0xfb, 0x31, 0x3f, 0x91, 0x0f, 0xa0, 0xbe, 0x81, 0x8e, 0x7e, 0xa6, 0x55, 0xcf, 0x3b,
+	0x1f, 0x28, 0x3e, 0xe8, 0xaa, 0x62, 0x9c, 0x04, 0x1c, 0xbf, 0xb4, 0x3b, 0xdf, 0x42, 0xd7, 0x5c,
+	0x8f, 0x0c, 0xe7, 0xf2, 0xd5, 0x6a, 0xd0, 0x9f, 0x1d, 0x50, 0xac, 0x77, 0x88, 0xf5, 0x16, 0x73,
+	0x0c, 0x6b, 0x32, 0xce, 0x60, 0x3a, 0x9e, 0x7c, 0xd6, 0xb8, 0xe7, 0xfc, 0x14, 0xb6, 0x1e, 0x7b,
+	0x82, 0x67, 0xe2, 0x51, 0x9a, 0x72, 0x7a, 0xf5, 0x71, 0x12, 0x71, 0xe2, 0x52, 0xbf, 0x8d, 0x0d,
+	0x5b, 0x98, 0x11, 0xb4, 0x41, 0x82, 0x96, 0x9d, 0x45, 0x23, 0x08, 0x9f, 0x47, 0x7d, 0x03, 0x1d,
+	0xfd, 0x80, 0xe4, 0xfa, 0x73, 0x29, 0x3f, 0x35, 0xa9, 0x38, 0x17, 0x4f, 0x33, 0x4b, 0xc9, 0xed,
+	0xed, 0xb7, 0x21, 0xce, 0x4e, 0xae, 0xba, 0x8a, 0xf7, 0x27, 0x83, 0xdb, 0x75, 0xc3, 0x4a, 0xd8,
+	0x2e, 0x09, 0x1b, 0xb0, 0x1b, 0x33, 0xc2, 0x90, 0x0c, 0x0f, 0xeb, 0x15, 0xac, 0xcd, 0x3c, 0xf2,
+	0x70, 0xee, 0x54, 0xb0, 0xb5, 0x9f, 0x97, 0x0c, 0x76, 0xeb, 0x09, 0x94, 0x64, 0x46, 0x92, 0x6f,
+	0xb1, 0xad, 0x59, 0xc9, 0x44, 0x88, 0xb2, 0xc7, 0xb0, 0x52, 0x4a, 0xa1, 0x4e, 0x7d, 0x72, 0x34,
+	0x7b, 0xad, 0x69, 0x65, 0xb0, 0x3b, 0x24, 0x71, 0x9b, 0x6d, 0x18, 0x89, 0x56, 0x36, 0x45, 0x71,
+	0x4f, 0x60, 0x0e, 0x1f, 0xa7, 0x5c, 0x25, 0x63, 0xdd, 0xb4, 0x63, 0xf3, 0x47, 0x2c, 0xac, 0x4f,
+	0x8c, 0x1d, 0xb6, 0x64, 0x18, 0xfb, 0x5e, 0x14, 0xc9, 0xc3, 0x73, 0x66, 0x3b, 0x31, 0xce, 0xae,
+	0xb5, 0xd0, 0xca, 0x26, 0xcd, 0xb5, 0x5b, 0x99, 0x3d, 0xbc, 0xd4, 0xbb, 0x28, 0xed, 0xe6, 0x8c,
+	0x72, 0x97, 0xd5, 0x76, 0x71, 0x6e, 0xe5, 0x4a, 0x99, 0xed, 0xc6, 0xd4, 0x58, 0xf8, 0xac, 0xa4,
+	0x51, 0x61, 0x36, 0x4a, 0x8a, 0x61, 0xb5, 0xdc, 0x88, 0x71, 0x6e, 0xcf, 0xca, 0xb2, 0x3b, 0x34,
+	0x35, 0xd2, 0xde, 0x26, 0x69, 0xb7, 0xd9, 0x76, 0x95, 0x34, 0x9a, 0x8f, 0xf2, 0x7e, 0xd9, 0xa0,
+	0x8e, 0x52, 0xc5, 0x75, 0x83, 0xe5, 0x52, 0xeb, 0x3a, 0x37, 0x83, 0x2b, 0xee, 0xeb, 0xec, 0x7d,
+	0x92, 0x7f, 0x97, 0xdd, 0xb6, 0xe5, 0xcf, 0xca, 0xc1, 0x45, 0x0c, 0xa1, 0x6b, 0x1e, 0x2b, 0x1b,
+	0x2f, 0x2f, 0x3f, 0x75, 0x1e, 0xf4, 0x67, 0x07, 0x6a, 0x63, 0x54, 0xa6, 0x69, 0x3e, 0x6b, 0xdc,
+	0xfb, 0xa8, 0xa1, 0x82, 0xb7, 0x2e, 0xc8, 0xae, 0x0f, 0x24, 0xe5, 0x5a, 0x8f, 0xdd, 0x22, 0x09,
+	0x9b, 0xce, 0x86, 0xbd, 0x19, 0xc3, 0xef, 0xe7, 0x74, 0x86, 0xb3, 0xf5, 0x5e, 0xbd, 0xa0, 0xb7,
+	0x4a, 0x82, 0x66, 0x6b, 0x44, 0xf6, 0x2e, 0x89, 0xdc, 0x75, 0x6e, 0x57, 0x89, 0xb4, 0x64, 0x7c,
+	0x0b, 0xbd, 0x87, 0x99, 0x08, 0xc7, 0x9e, 0xc0, 0x6b, 0xdc, 0x55, 0x0e, 0xe7, 0xe4, 0x42, 0xaf,
+	0x70, 0x64, 0x9e, 0x33, 0x43, 0xdd, 0x3c, 0x05, 0x90, 0x7b, 0xa3, 0x0b, 0xa2, 0x66, 0x61, 0x1b,
+	0x41, 0x15, 0xdb, 0xdb, 0xc4, 0xb6, 0xcf, 0xd6, 0x4b, 0x8b, 0x47, 0x26, 0xc8, 0xd5, 0xa3, 0xe8,
+	0x2b, 0x6b, 0x4f, 0xe5, 0x51, 0x55, 0xac, 0x6f, 0xd8, 0xd5, 0x67, 0xce, 0xfd, 0x2e, 0x71, 0xdf,
+	0x61, 0x7d, 0x9b, 0xbb, 0xcd, 0x0c, 0x45, 0x3c, 0x83, 0x05, 0x55, 0xd7, 0x39, 0x37, 0x72, 0x53,
+	0xb6, 0xea, 0xcf, 0xc1, 0x66, 0x19, 0xad, 0xd8, 0xdf, 0x24, 0xf6, 0x37, 0xd8, 0xaa, 0xcd, 0x1e,
+	0x29, 0xe4, 0xca, 0x21, 0x7f, 0x10, 0xe6, 0xdc, 0xd4, 0xae, 0x57, 0xf1, 0xc0, 0x6e, 0xb0, 0x9d,
+	0xf3, 0x2f, 0x3d, 0x20, 0xab, 0x10, 0xa1, 0xba, 0x52, 0x28, 0xe2, 0xcf, 0x60, 0xbd, 0xe2, 0xf5,
+	0xd8, 0xd5, 0xb2, 0x2c, 0x6f, 0xad, 0x7b, 0x76, 0x56, 0x11, 0x11, 0x26, 0x25, 0x52, 0x94, 0xfe,
+	0x1d, 0x2c, 0x15, 0x9e, 0xff, 0x5d, 0x2d, 0xd7, 0x8a, 0x83, 0xb3, 0x2f, 0x06, 0x2b, 0xcc, 0x20,
+	0x7f, 0x2e, 0x98, 0x27, 0xc4, 0xe2, 0x93, 0x27, 0x3b, 0x21, 0x56, 0x3e, 0xaf, 0x1a, 0xec, 0xd6,
+	0x13, 0xd4, 0x46, 0xda, 0xa0, 0x40, 0x88, 0xb2, 0x7f, 0x01, 0xce, 0xec, 0x53, 0x27, 0xc7, 0xe2,
+	0x5d, 0xfd, 0xa8, 0x6a, 0xf0, 0xd6, 0x15, 0x14, 0xb5, 0xf6, 0xe9, 0x17, 0x29, 0x51, 0xfe, 0xaf,
+	0x1a, 0xf4, 0xf9, 0x7d, 0xe6, 0x0d, 0x93, 0x1d, 0x78, 0xeb, 0x9e, 0x4c, 0x0d, 0xee, 0x5e, 0x49,
+	0xa3, 0x96, 0xf1, 0x0e, 0x2d, 0xe3, 0x0e, 0x1b, 0x98, 0x65, 0x9c, 0x97, 0x69, 0x73, 0x73, 0x2b,
+	0x3f, 0x32, 0x7a, 0x6d, 0x73, 0xab, 0x7b, 0x9d, 0x54, 0x61, 0x6e, 0xdf, 0x95, 0x48, 0x51, 0xfa,
+	0x09, 0xf4, 0x8c, 0xcd, 0x7a, 0x79, 0xbd, 0x30, 0xfb, 0xb0, 0x68, 0x30, 0xa8, 0x1a, 0x2a, 0xc6,
+	0x67, 0xb6, 0x56, 0x32, 0x6d, 0x8f, 0x4a, 0x87, 0x73, 0x58, 0x9b, 0x79, 0x27, 0x73, 0xf5, 0xfe,
+	0x76, 0xaf, 0x7b, 0x5e, 0x53, 0x95, 0xcc, 0x0b, 0x84, 0x32, 0x99, 0x2f, 0x17, 0xdf, 0xb9, 0xd8,
+	0x65, 0xc3, 0xec, 0x53, 0x9a, 0xc1, 0x4e, 0xcd, 0x68, 0x6d, 0xac, 0x8e, 0x73, 0x2a, 0x94, 0x77,
+	0x0a, 0x8b, 0xf6, 0x83, 0x0e, 0xc7, 0x3a, 0xb1, 0xf2, 0x7b, 0x91, 0xc1, 0xcd, 0xca, 0xb1, 0xda,
+	0x84, 0x1a, 0x68, 0x1a, 0xcb, 0x74, 0x67, 0x9e, 0x3f, 0xd8, 0xa6, 0x5b, 0xf7, 0x9c, 0x62, 0x70,
+	0xf7, 0x4a, 0x9a, 0x5a, 0xd3, 0xf5, 0xcb, 0xb4, 0x9f, 0x35, 0xee, 0x1d, 0xfc, 0x1a, 0x60, 0xf1,
+	0xf3, 0x60, 0x1c, 0xc6, 0xfa, 0x96, 0xe6, 0x03, 0xe4, 0x5d, 0x38, 0x47, 0x57, 0x0c, 0x33, 0xdd,
+	0xbc, 0xc1, 0x76, 0xc5, 0x48, 0x55, 0x19, 0xef, 0x21, 0x73, 0x5d, 0x4d, 0xef, 0xc7, 0xfc, 0x02,
+	0xb7, 0x9f, 0xc0, 0x52, 0xa1, 0x99, 0x66, 0x4c, 0xa9, 0xaa, 0xa1, 0x37, 0xb8, 0x55, 0x3d, 0x58,
+	0x15, 0x2a, 0x8a, 0xd2, 0xa6, 0x34, 0x01, 0x05, 0x8e, 0xa0, 0x67, 0x35, 0xd7, 0x8c, 0x8f, 0xcc,
+	0x36, 0xe8, 0x06, 0x83, 0xaa, 0x21, 0x25, 0xea, 0x2d, 0x12, 0x75, 0x93, 0x6d, 0xce, 0x8a, 0xca,
+	0x05, 0xad, 0x94, 0xda, 0x72, 0xaf, 0x75, 0x49, 0xa8, 0xee, 0xe4, 0xe9, 0xdb, 0x17, 0x5b, 0xce,
+	0x05, 0xe2, 0xa3, 0x13, 0x14, 0xf4, 0xb7, 0x0d, 0xd8, 0x29, 0x15, 0xe4, 0xdf, 0x84, 0xe2, 0x2c,
+	0xef, 0xea, 0x39, 0xef, 0x55, 0x97, 0xed, 0x33, 0x7d, 0xbf, 0x6b, 0xeb, 0xfb, 0xfb, 0xb4, 0x8a,
+	0x3d, 0x76, 0x37, 0x5f, 0x85, 0xa8, 0x93, 0x8a, 0x4b, 0xbb, 0x00, 0x67, 0xf6, 0xaf, 0x53, 0xd7,
+	0x57, 0x72, 0xf5, 0x7f, 0xb7, 0xd2, 0xc6, 0xec, 0xec, 0x58, 0xe7, 0x60, 0xa8, 0xf7, 0x63, 0x45,
+	0xee, 0xbc, 0x80, 0xe5, 0xe2, 0x7f, 0xa6, 0xea, 0x85, 0xee, 0x94, 0xff, 0x19, 0x55, 0xbc, 0x89,
+	0x2a, 0x4d, 0x3b, 0xdb, 0xb9, 0x40, 0x5f, 0x53, 0xee, 0xcb, 0x7e, 0xc3, 0x1f, 0xa9, 0xbf, 0xd4,
+	0xa4, 0xfc, 0x35, 0xca, 0xe1, 0xf2, 0x9f, 0x9d, 0xd8, 0x80, 0x04, 0x6c, 0x38, 0x4e, 0x2e, 0xe0,
+	0x54, 0x73, 0x3b, 0x87, 0x95, 0x52, 0x6f, 0xc8, 0x5c, 0xac, 0xab, 0x9b, 0x4d, 0x83, 0xdb, 0x75,
+	0xc3, 0x55, 0x89, 0x44, 0x6d, 0xa7, 0x48, 0x2a, 0x0b, 0xb3, 0x9e, 0xd5, 0x60, 0x32, 0x76, 0x3b,
+	0xdb, 0x74, 0x32, 0x55, 0x65, 0xb1, 0xb3, 0x54, 0xe5, 0xf8, 0x59, 0x3e, 0x19, 0x45, 0xfc, 0x31,
+	0xc0, 0xb1, 0x48, 0x26, 0x4a, 0x42, 0xed, 0xb1, 0xd5, 0xf0, 0x2f, 0xdc, 0x21, 0x34, 0x7f, 0xc3,
+	0xed, 0x18, 0x79, 0x7b, 0xa9, 0xa0, 0xe6, 0x94, 0x63, 0x1e, 0xd8, 0x5b, 0x2d, 0xad, 0xc1, 0x46,
+	0x11, 0x59, 0xd4, 0x05, 0x5b, 0xc9, 0xd9, 0xe2, 0x1b, 0x8e, 0xd3, 0xcf, 0x1a, 0xf7, 0x4e, 0xe6,
+	0xe9, 0xdf, 0x3c, 0x9f, 0xfc, 0xdf, 0x00, 0x35, 0x93, 0xd6, 0x1e, 0x6a, 0x3a, 0x00, 0x00,
 }
@@ -10,7 +10,11 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/consensus/finality"
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/dip"
+	"github.com/nebulasio/go-nebulas/light"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	nebnet "github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
@@ -21,7 +25,8 @@ import (
 
 // Errors
 var (
-	ErrEmptyRPCListenList = errors.New("empty rpc listen list")
+	ErrEmptyRPCListenList      = errors.New("empty rpc listen list")
+	ErrNotSupportedInLightMode = errors.New("rpc method is not supported in light mode")
 )
 
 // Const
@@ -32,12 +37,16 @@ const (
 // Neblet interface breaks cycle import dependency and hides unused services.
 type Neblet interface {
 	Config() *nebletpb.Config
+	Genesis() *corepb.Genesis
 	StartPprof(string) error
 	BlockChain() *core.BlockChain
 	AccountManager() *account.Manager
 	NetService() nebnet.Service
 	EventEmitter() *core.EventEmitter
 	Consensus() consensus.Consensus
+	Dip() *dip.DIP
+	Finality() *finality.Gadget
+	LightClient() *light.Client
 }
 
 // GRPCServer server interface for api & management etc.
@@ -67,10 +76,11 @@ type Server struct {
 func NewServer(neblet Neblet) *Server {
 	cfg := neblet.Config().Rpc
 
-	rpc := grpc.NewServer(grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(loggingStream)),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(loggingUnary)))
+	srv := &Server{neblet: neblet, rpcConfig: cfg}
+	rpc := grpc.NewServer(grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(loggingStream, srv.lightStream)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(loggingUnary, srv.lightUnary)))
+	srv.rpcServer = rpc
 
-	srv := &Server{neblet: neblet, rpcServer: rpc, rpcConfig: cfg}
 	api := &APIService{server: srv}
 	admin := &AdminService{server: srv}
 